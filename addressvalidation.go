@@ -0,0 +1,181 @@
+package geo
+
+import "context"
+
+const (
+	GranularityPremise          = "PREMISE"
+	GranularitySubPremise       = "SUB_PREMISE"
+	GranularityPremiseProximity = "PREMISE_PROXIMITY"
+	GranularityBlock            = "BLOCK"
+	GranularityRoute            = "ROUTE"
+	GranularityOther            = "OTHER"
+
+	ConfirmationConfirmed             = "CONFIRMED"
+	ConfirmationUnconfirmedPlausible  = "UNCONFIRMED_BUT_PLAUSIBLE"
+	ConfirmationUnconfirmedSuspicious = "UNCONFIRMED_AND_SUSPICIOUS"
+)
+
+type (
+	// AddressValidationRequest is the address to validate. RegionCode is a
+	// CLDR region such as "US", and may be omitted if AddressLines includes
+	// the country.
+	AddressValidationRequest struct {
+		RegionCode     string
+		Locality       string
+		PostalCode     string
+		AddressLines   []string
+		EnableUSPSCASS bool
+	}
+
+	// AddressValidation is the result of validating an address.
+	AddressValidation struct {
+		Verdict          AddressVerdict
+		FormattedAddress string
+		PostalAddress    PostalAddress
+		Components       []ValidatedComponent
+		MissingTypes     []string
+		UnconfirmedTypes []string
+		UnresolvedTokens []string
+		Location         LatLng
+		PlaceID          string
+		ResponseID       string
+	}
+
+	// AddressVerdict summarizes how well the input address was resolved.
+	AddressVerdict struct {
+		InputGranularity         string `json:"inputGranularity"`
+		ValidationGranularity    string `json:"validationGranularity"`
+		GeocodeGranularity       string `json:"geocodeGranularity"`
+		AddressComplete          bool   `json:"addressComplete"`
+		HasUnconfirmedComponents bool   `json:"hasUnconfirmedComponents"`
+		HasInferredComponents    bool   `json:"hasInferredComponents"`
+		HasReplacedComponents    bool   `json:"hasReplacedComponents"`
+	}
+
+	// PostalAddress is the corrected, standardized form of the address.
+	PostalAddress struct {
+		RegionCode         string   `json:"regionCode"`
+		LanguageCode       string   `json:"languageCode"`
+		PostalCode         string   `json:"postalCode"`
+		AdministrativeArea string   `json:"administrativeArea"`
+		Locality           string   `json:"locality"`
+		AddressLines       []string `json:"addressLines"`
+	}
+
+	// ValidatedComponent is one part of the address and how confidently it
+	// was confirmed, e.g. ConfirmationConfirmed.
+	ValidatedComponent struct {
+		Name              string
+		Type              string
+		ConfirmationLevel string
+		Inferred          bool
+		SpellCorrected    bool
+		Replaced          bool
+		Unexpected        bool
+	}
+
+	addressValidationBody struct {
+		Address struct {
+			RegionCode   string   `json:"regionCode,omitempty"`
+			Locality     string   `json:"locality,omitempty"`
+			PostalCode   string   `json:"postalCode,omitempty"`
+			AddressLines []string `json:"addressLines"`
+		} `json:"address"`
+		EnableUSPSCASS bool `json:"enableUspsCass,omitempty"`
+	}
+
+	addressValidationResponse struct {
+		Error      *googleAPIError `json:"error"`
+		ResponseID string          `json:"responseId"`
+		Result     struct {
+			Verdict AddressVerdict `json:"verdict"`
+			Address struct {
+				FormattedAddress  string        `json:"formattedAddress"`
+				PostalAddress     PostalAddress `json:"postalAddress"`
+				AddressComponents []struct {
+					ComponentName struct {
+						Text string `json:"text"`
+					} `json:"componentName"`
+					ComponentType     string `json:"componentType"`
+					ConfirmationLevel string `json:"confirmationLevel"`
+					Inferred          bool   `json:"inferred"`
+					SpellCorrected    bool   `json:"spellCorrected"`
+					Replaced          bool   `json:"replaced"`
+					Unexpected        bool   `json:"unexpected"`
+				} `json:"addressComponents"`
+				MissingComponentTypes     []string `json:"missingComponentTypes"`
+				UnconfirmedComponentTypes []string `json:"unconfirmedComponentTypes"`
+				UnresolvedTokens          []string `json:"unresolvedTokens"`
+			} `json:"address"`
+			Geocode struct {
+				Location struct {
+					Latitude  float64 `json:"latitude"`
+					Longitude float64 `json:"longitude"`
+				} `json:"location"`
+				PlaceID string `json:"placeId"`
+			} `json:"geocode"`
+		} `json:"result"`
+	}
+)
+
+// ValidateAddress checks an address for deliverability using the Google
+// Address Validation API.
+func (c *Client) ValidateAddress(ctx context.Context, req AddressValidationRequest) (*AddressValidation, error) {
+	var body addressValidationBody
+	body.Address.RegionCode = req.RegionCode
+	body.Address.Locality = req.Locality
+	body.Address.PostalCode = req.PostalCode
+	body.Address.AddressLines = req.AddressLines
+	body.EnableUSPSCASS = req.EnableUSPSCASS
+
+	var r addressValidationResponse
+	if err := c.postJSON(ctx, addressValidationAPIHost, "/v1:validateAddress", body, &r); err != nil {
+		return nil, err
+	}
+	if err := r.Error.err(); err != nil {
+		return nil, err
+	}
+	a := r.Result.Address
+	res := &AddressValidation{
+		Verdict:          r.Result.Verdict,
+		FormattedAddress: a.FormattedAddress,
+		PostalAddress:    a.PostalAddress,
+		MissingTypes:     a.MissingComponentTypes,
+		UnconfirmedTypes: a.UnconfirmedComponentTypes,
+		UnresolvedTokens: a.UnresolvedTokens,
+		Location:         LatLng{Lat: r.Result.Geocode.Location.Latitude, Lng: r.Result.Geocode.Location.Longitude},
+		PlaceID:          r.Result.Geocode.PlaceID,
+		ResponseID:       r.ResponseID,
+	}
+	for _, comp := range a.AddressComponents {
+		res.Components = append(res.Components, ValidatedComponent{
+			Name:              comp.ComponentName.Text,
+			Type:              comp.ComponentType,
+			ConfirmationLevel: comp.ConfirmationLevel,
+			Inferred:          comp.Inferred,
+			SpellCorrected:    comp.SpellCorrected,
+			Replaced:          comp.Replaced,
+			Unexpected:        comp.Unexpected,
+		})
+	}
+	return res, nil
+}
+
+// Deliverable reports whether the address was resolved completely, down to
+// the premise, without any suspicious or missing components.
+func (v *AddressValidation) Deliverable() bool {
+	switch v.Verdict.ValidationGranularity {
+	case GranularityPremise, GranularitySubPremise:
+	default:
+		return false
+	}
+	if !v.Verdict.AddressComplete || len(v.MissingTypes) > 0 {
+		return false
+	}
+	for _, comp := range v.Components {
+		if comp.ConfirmationLevel == ConfirmationUnconfirmedSuspicious {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidateAddress(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1:validateAddress" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{
+			"result": {
+				"verdict": {"inputGranularity": "PREMISE", "validationGranularity": "PREMISE", "geocodeGranularity": "PREMISE", "addressComplete": true, "hasInferredComponents": true},
+				"address": {
+					"formattedAddress": "1600 Amphitheatre Parkway, Mountain View, CA 94043-1351, USA",
+					"postalAddress": {"regionCode": "US", "postalCode": "94043-1351", "administrativeArea": "CA", "locality": "Mountain View", "addressLines": ["1600 Amphitheatre Pkwy"]},
+					"addressComponents": [
+						{"componentName": {"text": "1600"}, "componentType": "street_number", "confirmationLevel": "CONFIRMED"},
+						{"componentName": {"text": "Amphitheatre Parkway"}, "componentType": "route", "confirmationLevel": "CONFIRMED", "spellCorrected": true}
+					]
+				},
+				"geocode": {"location": {"latitude": 37.4225, "longitude": -122.0847}, "placeId": "ChIJF4Yf2Ry7j4AR__1AkytDyAE"}
+			},
+			"responseId": "abc"
+		}`))
+	}))
+	defer srv.Close()
+	c := NewClient("k", WithBaseURL(srv.URL))
+
+	v, err := c.ValidateAddress(context.Background(), AddressValidationRequest{
+		RegionCode:   "US",
+		AddressLines: []string{"1600 Amphitheatre Pkwy", "Mountain View, CA"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := got["address"].(map[string]interface{})
+	if addr["regionCode"] != "US" || len(addr["addressLines"].([]interface{})) != 2 {
+		t.Errorf("Unexpected request body: %v", got)
+	}
+	if !v.Deliverable() || v.PostalAddress.PostalCode != "94043-1351" || v.Location.Lat != 37.4225 {
+		t.Errorf("Unexpected validation: %+v", v)
+	}
+	if len(v.Components) != 2 || !v.Components[1].SpellCorrected || v.Components[0].ConfirmationLevel != ConfirmationConfirmed {
+		t.Errorf("Unexpected components: %+v", v.Components)
+	}
+
+	v.Verdict.ValidationGranularity = GranularityRoute
+	if v.Deliverable() {
+		t.Errorf("Expected a route-level match not to be deliverable")
+	}
+}
+
+func TestValidateAddressError(t *testing.T) {
+	c, _ := newTestClient(t, `{"error": {"code": 403, "message": "denied", "status": "PERMISSION_DENIED"}}`)
+	if _, err := c.ValidateAddress(context.Background(), AddressValidationRequest{AddressLines: []string{"x"}}); err == nil {
+		t.Errorf("Expected an error")
+	}
+}
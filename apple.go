@@ -0,0 +1,274 @@
+package geo
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const appleMapsAPIHost = "https://maps-api.apple.com"
+
+var InvalidAppleKeyError = errors.New("Invalid Apple Maps private key.")
+
+type (
+	// AppleGeocoder geocodes with the Apple Maps Server API, sending
+	// requests with Client. It signs a JWT with the developer's Maps private
+	// key to obtain short-lived access tokens, refreshing them before they
+	// expire or when a request is rejected as unauthorized. Of the Client's
+	// configuration, its HTTPClient, Retry, Limiter, InFlight, Hooks, Debug,
+	// Fallback, UserAgent, Header, Normalizer and Validity apply as they do
+	// to Google requests. Its API key, key pool, quota, Cache,
+	// ParseCoordinates and Lean are not used. WithLanguage and
+	// WithCountries are honored. It is safe for concurrent use.
+	AppleGeocoder struct {
+		Client     *Client
+		TeamID     string
+		KeyID      string
+		PrivateKey *ecdsa.PrivateKey
+		Now        func() time.Time
+
+		mu      sync.Mutex
+		token   string
+		expires time.Time
+	}
+
+	appleCoordinate struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}
+
+	appleResponse struct {
+		Results []struct {
+			Coordinate       appleCoordinate `json:"coordinate"`
+			DisplayMapRegion struct {
+				SouthLatitude float64 `json:"southLatitude"`
+				WestLongitude float64 `json:"westLongitude"`
+				NorthLatitude float64 `json:"northLatitude"`
+				EastLongitude float64 `json:"eastLongitude"`
+			} `json:"displayMapRegion"`
+			Name                  string   `json:"name"`
+			FormattedAddressLines []string `json:"formattedAddressLines"`
+			StructuredAddress     struct {
+				AdministrativeArea     string `json:"administrativeArea"`
+				AdministrativeAreaCode string `json:"administrativeAreaCode"`
+				Locality               string `json:"locality"`
+				PostCode               string `json:"postCode"`
+				SubLocality            string `json:"subLocality"`
+				Thoroughfare           string `json:"thoroughfare"`
+				SubThoroughfare        string `json:"subThoroughfare"`
+			} `json:"structuredAddress"`
+			Country     string `json:"country"`
+			CountryCode string `json:"countryCode"`
+		} `json:"results"`
+	}
+)
+
+// tokenRefreshMargin is how long before expiry an access token is replaced.
+const tokenRefreshMargin = time.Minute
+
+// NewAppleGeocoder returns an AppleGeocoder that sends requests with c,
+// using the Maps key with keyID in the team teamID. privateKeyPEM is the
+// contents of the key's .p8 file.
+func NewAppleGeocoder(c *Client, teamID, keyID string, privateKeyPEM []byte) (*AppleGeocoder, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, InvalidAppleKeyError
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, InvalidAppleKeyError
+	}
+	ek, ok := k.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, InvalidAppleKeyError
+	}
+	return &AppleGeocoder{Client: c, TeamID: teamID, KeyID: keyID, PrivateKey: ek}, nil
+}
+
+func (a *AppleGeocoder) now() time.Time {
+	if a.Now != nil {
+		return a.Now()
+	}
+	return time.Now()
+}
+
+// Geocode looks up q, after running it through the Client's Normalizer.
+func (a *AppleGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	addr, err := a.geocodeQuery(ctx, q, opts)
+	if a.Client.useFallback(ctx, "geocode", err) {
+		return a.Client.Fallback.Geocode(ctx, q, opts...)
+	}
+	return addr, err
+}
+
+func (a *AppleGeocoder) geocodeQuery(ctx context.Context, q string, opts []RequestOption) (*Address, error) {
+	q = a.Client.normalizeQuery(q)
+	o := newRequestOptions(opts)
+	if err := validateQuery(q, o); err != nil {
+		return nil, err
+	}
+	params := appleParams(o)
+	params.Set("q", q)
+	return a.geocode(ctx, "/v1/geocode", params)
+}
+
+// ReverseGeocode looks up the address at ll.
+func (a *AppleGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	addr, err := a.reverseGeocode(ctx, ll, opts)
+	if a.Client.useFallback(ctx, "reverse", err) {
+		return a.Client.Fallback.ReverseGeocode(ctx, ll, opts...)
+	}
+	return addr, err
+}
+
+func (a *AppleGeocoder) reverseGeocode(ctx context.Context, ll LatLng, opts []RequestOption) (*Address, error) {
+	if err := validateLatLng("latlng", ll); err != nil {
+		return nil, err
+	}
+	params := appleParams(newRequestOptions(opts))
+	params.Set("loc", latLngParam(ll))
+	return a.geocode(ctx, "/v1/reverseGeocode", params)
+}
+
+func appleParams(o *requestOptions) url.Values {
+	params := url.Values{}
+	if lang := o.params.Get("language"); lang != "" {
+		params.Set("lang", lang)
+	}
+	if countries := o.countries(); len(countries) > 0 {
+		params.Set("limitToCountries", strings.Join(countries, ","))
+	}
+	return params
+}
+
+func (a *AppleGeocoder) geocode(ctx context.Context, path string, params url.Values) (*Address, error) {
+	var r appleResponse
+	if err := a.get(ctx, path, params, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	g := &Response{Status: StatusOk, Results: make([]Result, len(r.Results))}
+	for i, ar := range r.Results {
+		res := &g.Results[i]
+		sa := ar.StructuredAddress
+		res.FormattedAddress = strings.Join(ar.FormattedAddressLines, ", ")
+		res.Geometry.Location = LatLng{ar.Coordinate.Latitude, ar.Coordinate.Longitude}
+		res.Geometry.Viewport = BoundingBox{
+			Southwest: LatLng{ar.DisplayMapRegion.SouthLatitude, ar.DisplayMapRegion.WestLongitude},
+			Northeast: LatLng{ar.DisplayMapRegion.NorthLatitude, ar.DisplayMapRegion.EastLongitude},
+		}
+		for _, c := range []AddressComponent{
+			{sa.SubThoroughfare, sa.SubThoroughfare, []string{"street_number"}},
+			{sa.Thoroughfare, sa.Thoroughfare, []string{"route"}},
+			{sa.SubLocality, sa.SubLocality, []string{"sublocality", "political"}},
+			{sa.Locality, sa.Locality, []string{"locality", "political"}},
+			{sa.AdministrativeArea, sa.AdministrativeAreaCode, []string{"administrative_area_level_1", "political"}},
+			{sa.PostCode, sa.PostCode, []string{"postal_code"}},
+			{ar.Country, ar.CountryCode, []string{"country", "political"}},
+		} {
+			if c.LongName != "" || c.ShortName != "" {
+				res.AddressComponents = append(res.AddressComponents, c)
+			}
+		}
+		// Apple doesn't type its results, so infer the most specific type
+		// from the address fields present.
+		for _, t := range []struct{ field, typ string }{
+			{sa.SubThoroughfare, "street_address"},
+			{sa.Thoroughfare, "route"},
+			{sa.Locality, "locality"},
+			{sa.AdministrativeArea, "administrative_area_level_1"},
+			{ar.Country, "country"},
+		} {
+			if t.field != "" {
+				res.Types = []string{t.typ}
+				break
+			}
+		}
+		res.scorePrecision(typePrecision(res.Types))
+	}
+	first := g.Results[0]
+	addr := &Address{Lat: first.Geometry.Location.Lat, Lng: first.Geometry.Location.Lng, Address: first.FormattedAddress, Response: g}
+	addr.stamp(a.now(), a.Client.Validity)
+	return addr, nil
+}
+
+// get sends an authorized GET, refreshing the access token and retrying
+// once if it has been revoked.
+func (a *AppleGeocoder) get(ctx context.Context, path string, params url.Values, out interface{}) error {
+	for attempt := 0; ; attempt++ {
+		token, err := a.accessToken(ctx, attempt > 0)
+		if err != nil {
+			return err
+		}
+		err = a.Client.getJSONKeyless(ctx, appleMapsAPIHost, path, params, bearer(token), out)
+		if he, ok := err.(*HTTPError); ok && he.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			continue
+		}
+		return err
+	}
+}
+
+// accessToken returns a current access token, exchanging a freshly signed
+// JWT for a new one if needed or forced.
+func (a *AppleGeocoder) accessToken(ctx context.Context, force bool) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !force && a.token != "" && a.now().Add(tokenRefreshMargin).Before(a.expires) {
+		return a.token, nil
+	}
+	jwt, err := a.signJWT()
+	if err != nil {
+		return "", err
+	}
+	var r struct {
+		AccessToken      string `json:"accessToken"`
+		ExpiresInSeconds int    `json:"expiresInSeconds"`
+	}
+	if err := a.Client.getJSONKeyless(ctx, appleMapsAPIHost, "/v1/token", url.Values{}, bearer(jwt), &r); err != nil {
+		return "", err
+	}
+	a.token = r.AccessToken
+	a.expires = a.now().Add(time.Duration(r.ExpiresInSeconds) * time.Second)
+	return a.token, nil
+}
+
+// bearer returns the header authorizing a request with token.
+func bearer(token string) http.Header {
+	return http.Header{"Authorization": {"Bearer " + token}}
+}
+
+// signJWT returns the ES256-signed token Apple exchanges for access tokens.
+func (a *AppleGeocoder) signJWT() (string, error) {
+	if a.PrivateKey == nil {
+		return "", InvalidAppleKeyError
+	}
+	now := a.now()
+	header, _ := json.Marshal(map[string]string{"alg": "ES256", "kid": a.KeyID, "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{"iss": a.TeamID, "iat": now.Unix(), "exp": now.Add(time.Hour).Unix()})
+	enc := base64.RawURLEncoding
+	signed := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, a.PrivateKey, digest[:])
+	if err != nil {
+		return "", err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return signed + "." + enc.EncodeToString(sig), nil
+}
+
+var _ Geocoder = (*AppleGeocoder)(nil)
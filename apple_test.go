@@ -0,0 +1,149 @@
+package geo
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// verifyJWT checks an ES256 token against pub.
+func verifyJWT(t *testing.T, token string, pub *ecdsa.PublicKey) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return false
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	return ecdsa.Verify(pub, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]))
+}
+
+func TestAppleGeocoder(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	p8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	tokens, revoked := 0, false
+	var lastQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if r.URL.Path == "/v1/token" {
+			if !verifyJWT(t, auth, &key.PublicKey) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			tokens++
+			w.Write([]byte(`{"accessToken": "access-` + string(rune('0'+tokens)) + `", "expiresInSeconds": 1800}`))
+			return
+		}
+		if !strings.HasPrefix(auth, "access-") || (revoked && auth == "access-1") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		lastQuery = r.URL.RawQuery
+		w.Write([]byte(`{"results": [{
+			"coordinate": {"latitude": 37.3349, "longitude": -122.009},
+			"displayMapRegion": {"southLatitude": 37.33, "westLongitude": -122.01, "northLatitude": 37.34, "eastLongitude": -122.0},
+			"formattedAddressLines": ["1 Apple Park Way", "Cupertino, CA 95014", "United States"],
+			"structuredAddress": {"administrativeArea": "California", "administrativeAreaCode": "CA", "locality": "Cupertino", "postCode": "95014", "thoroughfare": "Apple Park Way", "subThoroughfare": "1"},
+			"country": "United States", "countryCode": "US"
+		}]}`))
+	}))
+	defer srv.Close()
+
+	a, err := NewAppleGeocoder(NewClient("google-key", WithBaseURL(srv.URL)), "TEAM123456", "KEY1234567", p8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := a.Geocode(context.Background(), "1 Apple Park Way", WithCountries("us"), WithLanguage("en-US"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.Address != "1 Apple Park Way, Cupertino, CA 95014, United States" || addr.Lat != 37.3349 {
+		t.Errorf("Unexpected address: %v", addr)
+	}
+	r := addr.Response.Results[0]
+	if r.component("administrative_area_level_1") != "CA" || addr.CountryCode() != "US" || addr.Precision() != PrecisionRooftop {
+		t.Errorf("Unexpected result: %+v", r)
+	}
+	if lastQuery != "lang=en-US&limitToCountries=US&q=1+Apple+Park+Way" {
+		t.Errorf("Unexpected query: %s", lastQuery)
+	}
+
+	if _, err := a.ReverseGeocode(context.Background(), LatLng{37.3349, -122.009}); err != nil || tokens != 1 {
+		t.Errorf("Expected: the access token reused, Got: %d tokens (%v)", tokens, err)
+	}
+	revoked = true
+	if _, err := a.ReverseGeocode(context.Background(), LatLng{37.3349, -122.009}); err != nil || tokens != 2 {
+		t.Errorf("Expected: a refresh after a 401, Got: %d tokens (%v)", tokens, err)
+	}
+}
+
+func TestAppleGeocoderUsesClient(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	p8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	attempts := 0
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/token" {
+			w.Write([]byte(`{"accessToken": "access", "expiresInSeconds": 1800}`))
+			return
+		}
+		last = r
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"results": [{"coordinate": {"latitude": 37.3349, "longitude": -122.009}, "formattedAddressLines": ["1 Apple Park Way"]}]}`))
+	}))
+	defer srv.Close()
+
+	retries := 0
+	c := NewClient("google-key", WithBaseURL(srv.URL), WithUserAgent("geo-test/1"), WithValidity(time.Hour),
+		WithRetry(RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}),
+		WithHooks(Hooks{OnRetry: func(RetryEvent) { retries++ }}),
+		WithQueryNormalization(func(q string) string { return strings.ToUpper(strings.TrimSpace(q)) }))
+	a, err := NewAppleGeocoder(c, "TEAM123456", "KEY1234567", p8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := a.Geocode(context.Background(), " 1 apple park way ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := last.URL.Query()
+	if attempts != 2 || retries != 1 || last.Header.Get("User-Agent") != "geo-test/1" || q.Get("q") != "1 APPLE PARK WAY" || q.Has("key") {
+		t.Errorf("Unexpected request after %d attempts and %d retries: %s %v", attempts, retries, last.URL, last.Header)
+	}
+	if addr.ValidUntil.Sub(addr.FetchedAt) != time.Hour {
+		t.Errorf("Expected: valid for %v, Got: %v", time.Hour, addr.ValidUntil.Sub(addr.FetchedAt))
+	}
+
+	fallback := NewOfflineGeocoder([]OfflineEntry{{Address: "1 Apple Park Way", Lat: 37.3349, Lng: -122.009}})
+	a, _ = NewAppleGeocoder(NewClient("", WithBaseURL(srv.URL), WithFallback(fallback)), "TEAM123456", "KEY1234567", p8)
+	attempts = 0
+	if addr, err := a.Geocode(context.Background(), "1 Apple Park Way"); err != nil || attempts != 1 || addr.Lat != 37.3349 {
+		t.Errorf("Expected: the fallback's answer after one attempt, Got: %v (%v) after %d", addr, err, attempts)
+	}
+}
+
+func TestNewAppleGeocoderBadKey(t *testing.T) {
+	if _, err := NewAppleGeocoder(NewClient(""), "t", "k", []byte("not a key")); err != InvalidAppleKeyError {
+		t.Errorf("Expected: %v, Got: %v", InvalidAppleKeyError, err)
+	}
+}
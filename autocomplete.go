@@ -0,0 +1,99 @@
+package geo
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+type (
+	// AutocompletePrediction is a suggested place for a partial input.
+	AutocompletePrediction struct {
+		Description    string   `json:"description"`
+		PlaceID        string   `json:"place_id"`
+		Types          []string `json:"types"`
+		DistanceMeters float64  `json:"distance_meters"`
+		Formatting     struct {
+			MainText      string `json:"main_text"`
+			SecondaryText string `json:"secondary_text"`
+		} `json:"structured_formatting"`
+	}
+
+	// AutocompleteSession ties a sequence of Autocomplete calls and the
+	// place lookup that ends them to a single session token, so they are
+	// billed as one session. It is safe for concurrent use.
+	AutocompleteSession struct {
+		client *Client
+		mu     sync.Mutex
+		token  string
+	}
+
+	autocompleteResponse struct {
+		Status      string                   `json:"status"`
+		Predictions []AutocompletePrediction `json:"predictions"`
+	}
+)
+
+// Autocomplete returns place predictions for a partial input using the
+// Places Autocomplete API.
+func (c *Client) Autocomplete(ctx context.Context, input string, opts ...RequestOption) ([]AutocompletePrediction, error) {
+	params := url.Values{}
+	newRequestOptions(opts).apply(params)
+	params.Set("input", input)
+	var r autocompleteResponse
+	if err := c.getJSON(ctx, "/maps/api/place/autocomplete/json", params, &r); err != nil {
+		return nil, err
+	}
+	if r.Status == StatusZeroResults {
+		return []AutocompletePrediction{}, nil
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+	return r.Predictions, nil
+}
+
+// NewAutocompleteSession starts a session with a fresh token.
+func (c *Client) NewAutocompleteSession() *AutocompleteSession {
+	return &AutocompleteSession{client: c, token: NewSessionToken()}
+}
+
+// Token returns the session's current token.
+func (s *AutocompleteSession) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// Autocomplete calls Client.Autocomplete with the session's token.
+func (s *AutocompleteSession) Autocomplete(ctx context.Context, input string, opts ...RequestOption) ([]AutocompletePrediction, error) {
+	return s.client.Autocomplete(ctx, input, append(opts[:len(opts):len(opts)], WithSessionToken(s.Token()))...)
+}
+
+// Select geocodes the chosen prediction's place ID and ends the session,
+// so the next Autocomplete call starts a new one.
+func (s *AutocompleteSession) Select(ctx context.Context, placeID string, opts ...RequestOption) (*Address, error) {
+	defer s.Reset()
+	return s.client.GeocodeByPlaceID(ctx, placeID, opts...)
+}
+
+// Reset ends the session and starts a new one with a fresh token.
+func (s *AutocompleteSession) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = NewSessionToken()
+}
+
+// NewSessionToken returns a random version 4 UUID suitable for use as a
+// Places session token.
+func NewSessionToken() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,68 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAutocomplete(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "predictions": [{
+		"description": "Paris, France",
+		"place_id": "ChIJD7fiBh9u5kcRYJSMaMOCCwQ",
+		"types": ["locality", "political", "geocode"],
+		"structured_formatting": {"main_text": "Paris", "secondary_text": "France"}
+	}]}`)
+	preds, err := c.Autocomplete(context.Background(), "Pari",
+		WithLocationRestriction(LatLng{48.85, 2.35}, 50000), WithTypes("(cities)"), WithCountries("FR", "BE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	for k, v := range map[string]string{
+		"input":        "Pari",
+		"location":     "48.85,2.35",
+		"radius":       "50000",
+		"strictbounds": "true",
+		"types":        "(cities)",
+		"components":   "country:fr|country:be",
+	} {
+		if q.Get(k) != v {
+			t.Errorf("%s: Expected: %s, Got: %s", k, v, q.Get(k))
+		}
+	}
+	if len(preds) != 1 || preds[0].PlaceID != "ChIJD7fiBh9u5kcRYJSMaMOCCwQ" || preds[0].Formatting.MainText != "Paris" {
+		t.Errorf("Unexpected predictions: %+v", preds)
+	}
+}
+
+func TestAutocompleteSession(t *testing.T) {
+	var tokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "autocomplete") {
+			tokens = append(tokens, r.URL.Query().Get("sessiontoken"))
+			w.Write([]byte(`{"status": "ZERO_RESULTS", "predictions": []}`))
+			return
+		}
+		w.Write([]byte(`{"status": "OK", "results": [{"formatted_address": "Paris, France", "geometry": {"location": {"lat": 48.85, "lng": 2.35}}}]}`))
+	}))
+	defer srv.Close()
+
+	s := NewClient("", WithBaseURL(srv.URL)).NewAutocompleteSession()
+	ctx := context.Background()
+	s.Autocomplete(ctx, "P")
+	s.Autocomplete(ctx, "Pa")
+	if _, err := s.Select(ctx, "ChIJD7fiBh9u5kcRYJSMaMOCCwQ"); err != nil {
+		t.Fatal(err)
+	}
+	s.Autocomplete(ctx, "L")
+	if len(tokens) != 3 || tokens[0] != tokens[1] || tokens[1] == tokens[2] {
+		t.Errorf("Expected one token per session, Got: %v", tokens)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(tokens[0]) {
+		t.Errorf("Expected a UUID, Got: %s", tokens[0])
+	}
+}
@@ -0,0 +1,60 @@
+package geo
+
+// BoundingBox is a rectangular area described by its south-west and
+// north-east corners. A box whose Southwest.Lng is greater than its
+// Northeast.Lng crosses the antimeridian.
+type BoundingBox struct {
+	Southwest LatLng `json:"southwest"`
+	Northeast LatLng `json:"northeast"`
+}
+
+func (b BoundingBox) crossesAntimeridian() bool {
+	return b.Southwest.Lng > b.Northeast.Lng
+}
+
+// Contains reports whether ll lies inside the box (edges included).
+func (b BoundingBox) Contains(ll LatLng) bool {
+	if ll.Lat < b.Southwest.Lat || ll.Lat > b.Northeast.Lat {
+		return false
+	}
+	if b.crossesAntimeridian() {
+		return ll.Lng >= b.Southwest.Lng || ll.Lng <= b.Northeast.Lng
+	}
+	return ll.Lng >= b.Southwest.Lng && ll.Lng <= b.Northeast.Lng
+}
+
+// Center returns the midpoint of the box.
+func (b BoundingBox) Center() LatLng {
+	lng := (b.Southwest.Lng + b.Northeast.Lng) / 2
+	if b.crossesAntimeridian() {
+		lng += 180
+		if lng > 180 {
+			lng -= 360
+		}
+	}
+	return LatLng{Lat: (b.Southwest.Lat + b.Northeast.Lat) / 2, Lng: lng}
+}
+
+// Intersects reports whether b and o overlap.
+func (b BoundingBox) Intersects(o BoundingBox) bool {
+	if b.Southwest.Lat > o.Northeast.Lat || o.Southwest.Lat > b.Northeast.Lat {
+		return false
+	}
+	for _, x := range b.lngRanges() {
+		for _, y := range o.lngRanges() {
+			if x[0] <= y[1] && y[0] <= x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lngRanges splits the longitude span of b into ranges that don't cross the
+// antimeridian.
+func (b BoundingBox) lngRanges() [][2]float64 {
+	if b.crossesAntimeridian() {
+		return [][2]float64{{b.Southwest.Lng, 180}, {-180, b.Northeast.Lng}}
+	}
+	return [][2]float64{{b.Southwest.Lng, b.Northeast.Lng}}
+}
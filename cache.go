@@ -0,0 +1,432 @@
+package geo
+
+import (
+	"container/list"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Cache holds geocoding results in memory for TTL, evicting the least
+	// recently used entry once it holds MaxEntries (zero means unbounded).
+	// It is safe for concurrent use.
+	Cache struct {
+		TTL        time.Duration
+		MaxEntries int
+		Now        func() time.Time
+
+		mu         sync.Mutex
+		entries    map[string]*list.Element
+		lru        *list.List
+		refreshing map[string]bool
+	}
+
+	cacheEntry struct {
+		key    string
+		addr   *Address
+		stored time.Time
+	}
+
+	// cacheRecord is one line of the Export format.
+	cacheRecord struct {
+		Key     string    `json:"key"`
+		Stored  time.Time `json:"stored"`
+		Address *Address  `json:"address"`
+	}
+
+	// CachedGeocoder answers from Cache when it can and from Geocoder
+	// otherwise, keyed as described by GeocodeCacheKey. Queries are
+	// normalized with Normalizer, or DefaultQueryNormalizers if it is nil.
+	// Provider namespaces the keys when one Cache serves several providers.
+	//
+	// With MaxStale set, an expired entry up to MaxStale past its TTL is
+	// returned immediately while a background request refreshes it, so
+	// known addresses never wait on the upstream geocoder. Hooks'
+	// OnCacheHit and OnCacheMiss are called for every lookup.
+	CachedGeocoder struct {
+		Geocoder   Geocoder
+		Cache      *Cache
+		Normalizer QueryNormalizer
+		Provider   string
+		MaxStale   time.Duration
+		Hooks      Hooks
+	}
+)
+
+// WithCache makes the Client answer Geocode and ReverseGeocode from c when
+// it can, as a CachedGeocoder would.
+func WithCache(c *Cache) ClientOption {
+	return func(cl *Client) {
+		cl.Cache = c
+	}
+}
+
+// WithMaxStale lets the Client's Cache serve entries up to d past their TTL
+// while it refreshes them in the background.
+func WithMaxStale(d time.Duration) ClientOption {
+	return func(cl *Client) {
+		cl.MaxStale = d
+	}
+}
+
+// uncachedClient is a Client that ignores its Cache, for the CachedGeocoder
+// in front of it to fetch through.
+type uncachedClient Client
+
+func (u *uncachedClient) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	return (*Client)(u).geocodeQuery(ctx, q, opts)
+}
+
+func (u *uncachedClient) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	return (*Client)(u).reverseGeocode(ctx, ll, opts)
+}
+
+// cached returns a CachedGeocoder over c that bypasses c.Cache when it
+// fetches.
+func (c *Client) cached() CachedGeocoder {
+	return CachedGeocoder{Geocoder: (*uncachedClient)(c), Cache: c.Cache, Normalizer: c.Normalizer, Provider: ProviderGoogle, MaxStale: c.MaxStale, Hooks: c.Hooks}
+}
+
+// NewCache returns a Cache whose entries live for ttl.
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{TTL: ttl, MaxEntries: maxEntries, Now: time.Now}
+}
+
+func (c *Cache) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// Get returns the unexpired entry for key.
+func (c *Cache) Get(key string) (*Address, bool) {
+	a, age, ok := c.lookup(key)
+	if !ok || (c.TTL > 0 && age > c.TTL) {
+		return nil, false
+	}
+	return a, true
+}
+
+// lookup returns the entry for key, expired or not, along with its age.
+func (c *Cache) lookup(key string) (*Address, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+	c.lru.MoveToFront(el)
+	e := el.Value.(*cacheEntry)
+	return e.addr, c.now().Sub(e.stored), true
+}
+
+// Set stores a under key.
+func (c *Cache) Set(key string, a *Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]*list.Element{}
+		c.lru = list.New()
+	}
+	if el, ok := c.entries[key]; ok {
+		el.Value = &cacheEntry{key: key, addr: a, stored: c.now()}
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, addr: a, stored: c.now()})
+	if c.MaxEntries > 0 && c.lru.Len() > c.MaxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Delete removes the entry for key.
+func (c *Cache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet
+// evicted.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Export writes every entry to w as JSON, one object per line sorted by key,
+// so that the output is stable and diffs cleanly.
+func (c *Cache) Export(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, r := range c.records() {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportCSV is Export as CSV, with a header row and the columns key, stored,
+// lat, lng, address, fetched_at, valid_until and response. Times are
+// RFC 3339 and empty when unset, and response holds the full provider
+// response as JSON.
+func (c *Cache) ExportCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(cacheCSVColumns)
+	for _, r := range c.records() {
+		a := r.Address
+		var resp []byte
+		if a.Response != nil {
+			var err error
+			if resp, err = json.Marshal(a.Response); err != nil {
+				return err
+			}
+		}
+		cw.Write([]string{
+			r.Key,
+			csvTime(r.Stored),
+			strconv.FormatFloat(a.Lat, 'f', -1, 64),
+			strconv.FormatFloat(a.Lng, 'f', -1, 64),
+			a.Address,
+			csvTime(a.FetchedAt),
+			csvTime(a.ValidUntil),
+			string(resp),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Import loads entries written by Export, replacing any with the same key.
+// Entries keep the time they were stored; those without one count as stored
+// now.
+func (c *Cache) Import(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	for {
+		var rec cacheRecord
+		if err := dec.Decode(&rec); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := c.restore(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// ImportCSV is Import for CSV written by ExportCSV. Only the key, lat, lng
+// and address columns are required, in any order and ignoring case, so a
+// hand-made dictionary of addresses can be loaded too.
+func (c *Cache) ImportCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	cols := map[string]int{}
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"key", "lat", "lng", "address"} {
+		if _, ok := cols[name]; !ok {
+			return InvalidDatasetError
+		}
+	}
+	field := func(row []string, name string) string {
+		if i, ok := cols[name]; ok {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		a := &Address{Address: field(row, "address")}
+		lat, err1 := strconv.ParseFloat(field(row, "lat"), 64)
+		lng, err2 := strconv.ParseFloat(field(row, "lng"), 64)
+		stored, err3 := parseCSVTime(field(row, "stored"))
+		fetched, err4 := parseCSVTime(field(row, "fetched_at"))
+		valid, err5 := parseCSVTime(field(row, "valid_until"))
+		if err1 != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil {
+			return InvalidDatasetError
+		}
+		a.Lat, a.Lng, a.FetchedAt, a.ValidUntil = lat, lng, fetched, valid
+		if resp := field(row, "response"); resp != "" {
+			a.Response = new(Response)
+			if err := json.Unmarshal([]byte(resp), a.Response); err != nil {
+				return InvalidDatasetError
+			}
+		}
+		if err := c.restore(cacheRecord{Key: field(row, "key"), Stored: stored, Address: a}); err != nil {
+			return err
+		}
+	}
+}
+
+// cacheCSVColumns are the columns ExportCSV writes.
+var cacheCSVColumns = []string{"key", "stored", "lat", "lng", "address", "fetched_at", "valid_until", "response"}
+
+// records returns every entry, sorted by key.
+func (c *Cache) records() []cacheRecord {
+	c.mu.Lock()
+	records := make([]cacheRecord, 0, len(c.entries))
+	for _, el := range c.entries {
+		e := el.Value.(*cacheEntry)
+		records = append(records, cacheRecord{Key: e.key, Stored: e.stored, Address: e.addr})
+	}
+	c.mu.Unlock()
+	sort.Slice(records, func(i, j int) bool { return records[i].Key < records[j].Key })
+	return records
+}
+
+// restore stores an imported entry, keeping its stored time if it has one.
+func (c *Cache) restore(rec cacheRecord) error {
+	if rec.Key == "" || rec.Address == nil {
+		return InvalidDatasetError
+	}
+	c.Set(rec.Key, rec.Address)
+	if !rec.Stored.IsZero() {
+		c.mu.Lock()
+		c.entries[rec.Key].Value.(*cacheEntry).stored = rec.Stored
+		c.mu.Unlock()
+	}
+	return nil
+}
+
+func csvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseCSVTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// Geocode geocodes q, consulting the cache first.
+func (g *CachedGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	var nq string
+	if g.Normalizer != nil {
+		nq = g.Normalizer(q)
+	} else {
+		nq = defaultNormalize(q)
+	}
+	key := cacheKey(g.Provider, "geocode", nq, opts)
+	if a, stale, ok := g.lookup("geocode", key); ok {
+		if stale {
+			geocoder := g.Geocoder
+			g.refresh(key, func(ctx context.Context) (*Address, error) {
+				return geocoder.Geocode(ctx, q, opts...)
+			})
+		}
+		return a, nil
+	}
+	a, err := g.Geocoder.Geocode(ctx, q, opts...)
+	return g.store(key, a, err)
+}
+
+// ReverseGeocode reverse geocodes ll, consulting the cache first.
+func (g *CachedGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	key := cacheKey(g.Provider, "reverse", latLngParam(ll), opts)
+	if a, stale, ok := g.lookup("reverse", key); ok {
+		if stale {
+			geocoder := g.Geocoder
+			g.refresh(key, func(ctx context.Context) (*Address, error) {
+				return geocoder.ReverseGeocode(ctx, ll, opts...)
+			})
+		}
+		return a, nil
+	}
+	a, err := g.Geocoder.ReverseGeocode(ctx, ll, opts...)
+	return g.store(key, a, err)
+}
+
+// lookup returns the entry for key if it may be served, and whether it is
+// stale and should be refreshed. Hits are on the hot path, so nothing here
+// or in the callers' hit branches allocates beyond the key itself.
+func (g *CachedGeocoder) lookup(kind, key string) (a *Address, stale, ok bool) {
+	a, stale, ok = g.servable(key)
+	if ok && g.Hooks.OnCacheHit != nil {
+		g.Hooks.OnCacheHit(CacheEvent{Kind: kind, Key: key, Stale: stale})
+	} else if !ok && g.Hooks.OnCacheMiss != nil {
+		g.Hooks.OnCacheMiss(CacheEvent{Kind: kind, Key: key})
+	}
+	return a, stale, ok
+}
+
+func (g *CachedGeocoder) servable(key string) (a *Address, stale, ok bool) {
+	a, age, ok := g.Cache.lookup(key)
+	if !ok {
+		return nil, false, false
+	}
+	ttl := g.Cache.TTL
+	if ttl <= 0 || age <= ttl {
+		return a, false, true
+	}
+	if g.MaxStale > 0 && age <= ttl+g.MaxStale {
+		return a, true, true
+	}
+	return nil, false, false
+}
+
+// store caches a successful answer under key.
+func (g *CachedGeocoder) store(key string, a *Address, err error) (*Address, error) {
+	if err != nil {
+		return nil, err
+	}
+	g.Cache.Set(key, a)
+	return a, nil
+}
+
+// refresh fetches key in the background unless a refresh is already under
+// way. The caller's context isn't used, since the caller has its answer.
+func (g *CachedGeocoder) refresh(key string, fetch func(context.Context) (*Address, error)) {
+	c := g.Cache
+	c.mu.Lock()
+	if c.refreshing[key] {
+		c.mu.Unlock()
+		return
+	}
+	if c.refreshing == nil {
+		c.refreshing = map[string]bool{}
+	}
+	c.refreshing[key] = true
+	c.mu.Unlock()
+
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+		if a, err := fetch(context.Background()); err == nil {
+			c.Set(key, a)
+		}
+	}()
+}
+
+var _ Geocoder = (*CachedGeocoder)(nil)
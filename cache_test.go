@@ -0,0 +1,285 @@
+package geo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheExpiryAndEviction(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCache(time.Hour, 2)
+	c.Now = func() time.Time { return now }
+	c.Set("a", &Address{})
+	c.Set("b", &Address{})
+	c.Get("a")
+	c.Set("c", &Address{})
+	if _, ok := c.Get("b"); ok {
+		t.Error("Expected: least recently used entry evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("Expected: a to be cached")
+	}
+	now = now.Add(2 * time.Hour)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Expected: a to have expired")
+	}
+}
+
+func TestCachedGeocoderNormalizesKeys(t *testing.T) {
+	var calls int32
+	g := &CachedGeocoder{
+		Geocoder: funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+			atomic.AddInt32(&calls, 1)
+			return &Address{}, nil
+		}),
+		Cache: NewCache(time.Hour, 0),
+	}
+	g.Geocode(context.Background(), "1 Main  St")
+	g.Geocode(context.Background(), " 1 main st ")
+	g.Geocode(context.Background(), "1 Main St", WithRegion("ca"))
+	if calls != 2 {
+		t.Errorf("Expected: 2, Got: %d", calls)
+	}
+}
+
+func TestCachedGeocoderStaleWhileRevalidate(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(time.Hour, 0)
+	cache.Now = func() time.Time { return now }
+	refreshed := make(chan struct{}, 1)
+	version := "old"
+	g := &CachedGeocoder{
+		Geocoder: funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+			a := &Address{Address: version}
+			if version == "new" {
+				refreshed <- struct{}{}
+			}
+			return a, nil
+		}),
+		Cache:    cache,
+		MaxStale: time.Hour,
+	}
+	g.Geocode(context.Background(), "x")
+	version = "new"
+	now = now.Add(90 * time.Minute)
+	a, err := g.Geocode(context.Background(), "x")
+	if err != nil || a.Address != "old" {
+		t.Errorf("Expected: stale entry, Got: %v, %v", a, err)
+	}
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("Expected: background refresh")
+	}
+	for i := 0; i < 100; i++ {
+		if a, _, _ := cache.lookup(GeocodeCacheKey("", "x")); a.Address == "new" {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Error("Expected: refreshed entry to be stored")
+}
+
+func TestCachedGeocoderMaxStale(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(time.Hour, 0)
+	cache.Now = func() time.Time { return now }
+	var calls int32
+	g := &CachedGeocoder{
+		Geocoder: funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+			atomic.AddInt32(&calls, 1)
+			return &Address{}, nil
+		}),
+		Cache:    cache,
+		MaxStale: time.Hour,
+	}
+	g.Geocode(context.Background(), "x")
+	now = now.Add(3 * time.Hour)
+	g.Geocode(context.Background(), "x")
+	if calls != 2 {
+		t.Errorf("Expected: synchronous fetch past MaxStale, Got: %d calls", calls)
+	}
+}
+
+func TestCacheExportImport(t *testing.T) {
+	stored := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	src := NewCache(time.Hour, 0)
+	src.Now = func() time.Time { return stored }
+	src.Set("geocode:b?", &Address{Address: "B", Lat: 2, Lng: 3})
+	src.Set("geocode:a?", &Address{Address: "A", Lat: 1})
+
+	var buf bytes.Buffer
+	if err := src.Export(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"key":"geocode:a?"`) {
+		t.Errorf("Expected: entries sorted by key, Got: %s", buf.String())
+	}
+
+	dst := NewCache(time.Hour, 0)
+	dst.Now = func() time.Time { return stored.Add(30 * time.Minute) }
+	if err := dst.Import(&buf); err != nil {
+		t.Fatal(err)
+	}
+	a, ok := dst.Get("geocode:b?")
+	if !ok || a.Address != "B" || a.Lng != 3 {
+		t.Errorf("Expected: B, Got: %v", a)
+	}
+	dst.Now = func() time.Time { return stored.Add(2 * time.Hour) }
+	if _, ok := dst.Get("geocode:a?"); ok {
+		t.Error("Expected: imported entry to keep its stored time")
+	}
+
+	if err := dst.Import(strings.NewReader(`{"key":""}`)); err != InvalidDatasetError {
+		t.Errorf("Expected: %v, Got: %v", InvalidDatasetError, err)
+	}
+}
+
+func TestCacheExportImportCSV(t *testing.T) {
+	stored := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	src := NewCache(time.Hour, 0)
+	src.Now = func() time.Time { return stored }
+	src.Set("geocode:b?", &Address{Address: "B, \"quoted\"", Lat: 2, Lng: 3, FetchedAt: stored,
+		Response: &Response{Status: StatusOk, Results: []Result{{FormattedAddress: "B", Precision: PrecisionRooftop}}}})
+	src.Set("geocode:a?", &Address{Address: "A", Lat: 1})
+
+	var buf bytes.Buffer
+	if err := src.ExportCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || lines[0] != "key,stored,lat,lng,address,fetched_at,valid_until,response" || !strings.HasPrefix(lines[1], "geocode:a?,") {
+		t.Errorf("Expected: a header and entries sorted by key, Got: %s", buf.String())
+	}
+
+	dst := NewCache(time.Hour, 0)
+	dst.Now = func() time.Time { return stored.Add(30 * time.Minute) }
+	if err := dst.ImportCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	a, ok := dst.Get("geocode:b?")
+	if !ok || a.Address != `B, "quoted"` || a.Lng != 3 || !a.FetchedAt.Equal(stored) || a.Precision() != PrecisionRooftop {
+		t.Errorf("Expected: B, Got: %+v", a)
+	}
+	dst.Now = func() time.Time { return stored.Add(2 * time.Hour) }
+	if _, ok := dst.Get("geocode:a?"); ok {
+		t.Error("Expected: imported entry to keep its stored time")
+	}
+
+	if err := dst.ImportCSV(strings.NewReader("Address,LAT,lng,key\nC,4,5,geocode:c?\n")); err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := dst.Get("geocode:c?"); !ok || a.Address != "C" || a.Lat != 4 || a.Response != nil {
+		t.Errorf("Expected: C from the minimal columns, Got: %+v", a)
+	}
+	for _, in := range []string{"key,lat,lng\nx,1,2\n", "key,lat,lng,address\nx,north,2,X\n", "key,lat,lng,address\n,1,2,X\n"} {
+		if err := dst.ImportCSV(strings.NewReader(in)); err != InvalidDatasetError {
+			t.Errorf("%q Expected: %v, Got: %v", in, InvalidDatasetError, err)
+		}
+	}
+}
+
+func TestClientCache(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	WithCache(NewCache(time.Hour, 0))(c)
+	if _, err := c.Geocode(context.Background(), "1 Main St"); err != nil {
+		t.Fatal(err)
+	}
+	*last = nil
+	if _, err := c.Geocode(context.Background(), "1 main st"); err != nil {
+		t.Fatal(err)
+	}
+	if *last != nil || c.Cache.Len() != 1 {
+		t.Errorf("Expected: second lookup served from cache")
+	}
+}
+
+func TestClientCacheMaxStale(t *testing.T) {
+	var calls int32
+	refreshed := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addr := "old"
+		if atomic.AddInt32(&calls, 1) > 1 {
+			addr = "new"
+			defer func() { refreshed <- struct{}{} }()
+		}
+		fmt.Fprintf(w, `{"status": "OK", "results": [{"formatted_address": %q}]}`, addr)
+	}))
+	defer srv.Close()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(time.Hour, 0)
+	cache.Now = func() time.Time { return now }
+	c := NewClient("k", WithBaseURL(srv.URL), WithCache(cache), WithMaxStale(time.Hour))
+
+	c.Geocode(context.Background(), "x")
+	now = now.Add(90 * time.Minute)
+	a, err := c.Geocode(context.Background(), "x")
+	if err != nil || a.Address != "old" {
+		t.Errorf("Expected: stale entry, Got: %v, %v", a, err)
+	}
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("Expected: background refresh")
+	}
+}
+
+func BenchmarkCacheGet(b *testing.B) {
+	c := NewCache(time.Hour, 0)
+	c.Set("google/geocode:1600 amphitheatre parkway?", &Address{})
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, ok := c.Get("google/geocode:1600 amphitheatre parkway?"); !ok {
+			b.Fatal("miss")
+		}
+	}
+}
+
+func benchmarkCachedGeocoderHit(b *testing.B, q string, opts ...RequestOption) {
+	g := &CachedGeocoder{Geocoder: slowGeocoder("x", 0, nil), Cache: NewCache(time.Hour, 0)}
+	ctx := context.Background()
+	if _, err := g.Geocode(ctx, q, opts...); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := g.Geocode(ctx, q, opts...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCachedGeocoderHit(b *testing.B) {
+	benchmarkCachedGeocoderHit(b, "1600 Amphitheatre Parkway, Mountain View")
+}
+
+func BenchmarkCachedGeocoderHitNormalized(b *testing.B) {
+	benchmarkCachedGeocoderHit(b, "  1600 Amphitheatre Pkwy,  Mountain View")
+}
+
+func BenchmarkCachedGeocoderHitWithOptions(b *testing.B) {
+	benchmarkCachedGeocoderHit(b, "1600 Amphitheatre Parkway, Mountain View", WithRegion("US"), WithLanguage("en"))
+}
+
+func BenchmarkClientCacheHit(b *testing.B) {
+	c, _ := newTestClient(b, fullGeocodeBody)
+	c.Cache = NewCache(time.Hour, 0)
+	ctx := context.Background()
+	if _, err := c.Geocode(ctx, "1600 Amphitheatre Parkway, Mountain View"); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Geocode(ctx, "1600 Amphitheatre Parkway, Mountain View"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
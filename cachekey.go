@@ -0,0 +1,95 @@
+package geo
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ProviderGoogle names the Google Geocoding API in cache keys.
+const ProviderGoogle = "google"
+
+// canonicalParams are request parameters whose values are compared without
+// regard to case.
+var canonicalParams = map[string]bool{"components": true, "language": true, "region": true}
+
+// GeocodeCacheKey returns the key under which a CachedGeocoder for provider
+// stores the answer to Geocode(q, opts...), so that external caches such as
+// a CDN or a shared Redis can key consistently with this package. The query
+// is normalized with DefaultQueryNormalizers and lowercased; component
+// filters, language and region are lowercased; and options are encoded in
+// sorted order, so equivalent requests share a key. Keys are otherwise
+// opaque.
+func GeocodeCacheKey(provider, q string, opts ...RequestOption) string {
+	return cacheKey(provider, "geocode", defaultNormalize(q), opts)
+}
+
+// ReverseGeocodeCacheKey is GeocodeCacheKey for ReverseGeocode(ll, opts...).
+func ReverseGeocodeCacheKey(provider string, ll LatLng, opts ...RequestOption) string {
+	return cacheKey(provider, "reverse", latLngParam(ll), opts)
+}
+
+// cacheKey builds a key in a single allocation when there are no options,
+// since it is computed on every cached lookup. q is lowercased as it is
+// written.
+func cacheKey(provider, kind, q string, opts []RequestOption) string {
+	var sb strings.Builder
+	sb.Grow(len(provider) + len(kind) + len(q) + 3)
+	writeLower(&sb, provider)
+	sb.WriteByte('/')
+	sb.WriteString(kind)
+	sb.WriteByte(':')
+	writeLower(&sb, q)
+	sb.WriteByte('?')
+	if len(opts) > 0 {
+		o := newRequestOptions(opts)
+		writeParams(&sb, o.params, canonicalParams)
+		sb.WriteString(o.filterKey())
+	}
+	return sb.String()
+}
+
+// writeLower writes s to sb as strings.ToLower would return it, without
+// allocating when s is ASCII.
+func writeLower(sb *strings.Builder, s string) {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			sb.WriteString(strings.ToLower(s))
+			return
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		sb.WriteByte(c)
+	}
+}
+
+// writeParams writes params to sb as url.Values.Encode would, lowercasing
+// the values of the keys in lower.
+func writeParams(sb *strings.Builder, params url.Values, lower map[string]bool) {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	first := true
+	for _, k := range keys {
+		ek := url.QueryEscape(k)
+		for _, v := range params[k] {
+			if !first {
+				sb.WriteByte('&')
+			}
+			first = false
+			sb.WriteString(ek)
+			sb.WriteByte('=')
+			if lower[k] {
+				v = strings.ToLower(v)
+			}
+			sb.WriteString(url.QueryEscape(v))
+		}
+	}
+}
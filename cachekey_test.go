@@ -0,0 +1,35 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGeocodeCacheKey(t *testing.T) {
+	a := GeocodeCacheKey("Google", " 1 Main  St ", WithComponents(ComponentFilter{Country: "CA"}), WithLanguage("FR"), WithRegion("ca"))
+	b := GeocodeCacheKey("google", "1 main st", WithRegion("CA"), WithLanguage("fr"), WithComponents(ComponentFilter{Country: "ca"}))
+	if a != b {
+		t.Errorf("Expected: %s, Got: %s", a, b)
+	}
+	if want := "google/geocode:1 main street?components=country%3Aca&language=fr&region=ca"; a != want {
+		t.Errorf("Expected: %s, Got: %s", want, a)
+	}
+	if GeocodeCacheKey("google", "1 Main St") == GeocodeCacheKey("nominatim", "1 Main St") {
+		t.Error("Expected: providers to have distinct keys")
+	}
+	if got := ReverseGeocodeCacheKey("google", LatLng{1.5, -2}); got != "google/reverse:1.5,-2?" {
+		t.Errorf("Expected: google/reverse:1.5,-2?, Got: %s", got)
+	}
+}
+
+func TestCacheKeyMatchesClient(t *testing.T) {
+	c, _ := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	WithCache(NewCache(time.Hour, 0))(c)
+	if _, err := c.Geocode(context.Background(), "1 Main St", WithLanguage("en")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.Cache.Get(GeocodeCacheKey(ProviderGoogle, "1 main st", WithLanguage("EN"))); !ok {
+		t.Error("Expected: the public key to find the client's entry")
+	}
+}
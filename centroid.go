@@ -0,0 +1,23 @@
+package geo
+
+import "math"
+
+// Centroid returns the spherical centroid of points: the mean of their unit
+// vectors projected back onto the sphere. Unlike averaging latitudes and
+// longitudes it behaves correctly across the antimeridian and near the poles.
+// It returns the zero LatLng for an empty slice, and the first point when the
+// points cancel out (e.g. two antipodes).
+func Centroid(points []LatLng) LatLng {
+	if len(points) == 0 {
+		return LatLng{}
+	}
+	var sum [3]float64
+	for _, ll := range points {
+		p := latLngToXYZ(ll)
+		sum[0], sum[1], sum[2] = sum[0]+p[0], sum[1]+p[1], sum[2]+p[2]
+	}
+	if math.Abs(sum[0])+math.Abs(sum[1])+math.Abs(sum[2]) < 1e-12 {
+		return points[0]
+	}
+	return xyzToLatLng(sum)
+}
@@ -0,0 +1,20 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCentroid(t *testing.T) {
+	got := Centroid([]LatLng{{0, 179}, {0, -179}})
+	if math.Abs(got.Lat) > 1e-9 || math.Abs(math.Abs(got.Lng)-180) > 1e-9 {
+		t.Errorf("Expected: 0,180, Got: %v", got)
+	}
+	got = Centroid([]LatLng{{10, 0}, {-10, 0}, {0, 10}, {0, -10}})
+	if math.Abs(got.Lat) > 1e-9 || math.Abs(got.Lng) > 1e-9 {
+		t.Errorf("Expected: 0,0, Got: %v", got)
+	}
+	if got := Centroid(nil); got != (LatLng{}) {
+		t.Errorf("Expected: zero LatLng, Got: %v", got)
+	}
+}
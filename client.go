@@ -0,0 +1,395 @@
+package geo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	mapsAPIHost  = "https://maps.googleapis.com"
+	roadsAPIHost = "https://roads.googleapis.com"
+
+	addressValidationAPIHost = "https://addressvalidation.googleapis.com"
+	geolocationAPIHost       = "https://www.googleapis.com"
+)
+
+type (
+	// Client talks to the Google Maps web service APIs using a single API key
+	// and HTTP configuration. Other providers' geocoders send their requests
+	// through one too. The zero value of every field but APIKey leaves its
+	// feature off; NewClient and the ClientOptions set them.
+	Client struct {
+		APIKey     string
+		HTTPClient *http.Client
+
+		// BaseURL replaces the host of every API the Client calls, e.g. with
+		// a proxy or a test server.
+		BaseURL string
+
+		// Normalizer rewrites free-text queries before they are sent.
+		Normalizer QueryNormalizer
+
+		// RetainRaw keeps undecoded geocoding responses in Response.Raw.
+		RetainRaw bool
+
+		// Lean decodes only the essentials of geocoding responses (see
+		// WithLeanDecoding).
+		Lean bool
+
+		// Quota is charged for every request made with the API key.
+		Quota *QuotaTracker
+
+		// Keys supplies the key for each request in place of APIKey.
+		Keys *KeyPool
+
+		// Retry decides which failed requests are retried and when.
+		Retry RetryStrategy
+
+		// UserAgent and Header are sent with every request.
+		UserAgent string
+		Header    http.Header
+
+		// Cache serves repeated geocoding requests. Entries up to MaxStale
+		// past their TTL are served while they are refreshed, as with
+		// CachedGeocoder.
+		Cache    *Cache
+		MaxStale time.Duration
+
+		// Limiter paces requests, and InFlight caps how many are under way
+		// at once.
+		Limiter  *RateLimiter
+		InFlight *InFlightLimiter
+
+		// Validity is how long the addresses the Client returns stay valid.
+		Validity time.Duration
+
+		// Debug records every request.
+		Debug *DebugRecorder
+
+		// Fallback answers geocoding requests that fail.
+		Fallback Geocoder
+
+		// Hooks are called as requests progress.
+		Hooks Hooks
+
+		// ParseCoordinates answers coordinate queries without a request (see
+		// WithCoordinateQueries).
+		ParseCoordinates bool
+	}
+
+	// ClientOption configures a Client.
+	ClientOption func(*Client)
+)
+
+// NewClient returns a Client authenticated with apiKey.
+func NewClient(apiKey string, opts ...ClientOption) *Client {
+	c := &Client{APIKey: strings.TrimSpace(apiKey), HTTPClient: sharedHTTPClient}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithHTTPClient makes the Client send requests through hc.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = hc
+	}
+}
+
+// WithRawResponses keeps the undecoded body of geocoding responses in
+// Response.Raw.
+func WithRawResponses() ClientOption {
+	return func(c *Client) {
+		c.RetainRaw = true
+	}
+}
+
+// WithBaseURL points the Client at a different host, e.g. a proxy or a test
+// server.
+func WithBaseURL(u string) ClientOption {
+	return func(c *Client) {
+		c.BaseURL = strings.TrimRight(u, "/")
+	}
+}
+
+// WithValidity sets how long the addresses the Client returns stay valid,
+// e.g. the caching period allowed by the provider's terms.
+func WithValidity(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.Validity = d
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request. Some
+// services, such as Nominatim, require one that identifies the application.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = ua
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		if c.Header == nil {
+			c.Header = http.Header{}
+		}
+		c.Header.Add(key, value)
+	}
+}
+
+// getJSON issues a GET for a Maps API path with params and decodes the body
+// into out.
+func (c *Client) getJSON(ctx context.Context, path string, params url.Values, out interface{}) error {
+	return c.getJSONFrom(ctx, mapsAPIHost, path, params, out)
+}
+
+func (c *Client) getJSONFrom(ctx context.Context, host, path string, params url.Values, out interface{}) error {
+	return c.doJSON(ctx, "GET", host, path, params, nil, out, true, nil)
+}
+
+// getJSONKeyless is getJSONFrom for providers that authenticate with their
+// own parameters or header, such as Smarty and Apple. The Client's API key
+// is not sent to them, and neither its key pool nor its quota is used.
+func (c *Client) getJSONKeyless(ctx context.Context, host, path string, params url.Values, header http.Header, out interface{}) error {
+	return c.doJSON(ctx, "GET", host, path, params, nil, out, false, header)
+}
+
+// postJSON sends in as a JSON body to host and decodes the response into
+// out.
+func (c *Client) postJSON(ctx context.Context, host, path string, in, out interface{}) error {
+	return c.doJSON(ctx, "POST", host, path, url.Values{}, in, out, true, nil)
+}
+
+// doJSON makes a request and decodes the response into out. withKey says
+// whether host takes the Client's API key; if not, no key is sent or
+// drawn from the pool and no quota is charged. header is added to the
+// Client's own, e.g. for a bearer token. With a key pool, every
+// attempt draws its own key and reports how it fared, so a retry after a
+// key is rejected goes out with another.
+func (c *Client) doJSON(ctx context.Context, method, host, path string, params url.Values, in, out interface{}, withKey bool, header http.Header) error {
+	if c.BaseURL != "" {
+		host = c.BaseURL
+	}
+	var payload []byte
+	if in != nil {
+		var err error
+		if payload, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	attempt := func() ([]byte, error) {
+		key, err := c.requestKey(path, withKey)
+		if err != nil {
+			return nil, err
+		}
+		if key != "" {
+			params.Set("key", key)
+		}
+		if c.InFlight != nil {
+			release, err := c.InFlight.Acquire(ctx, host, key)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+		}
+		b, err := c.send(ctx, method, path, requestURL(host, path, params), payload, header)
+		if withKey && c.Keys != nil {
+			c.Keys.Report(key, responseStatus(b))
+		}
+		if c.Hooks.OnRateLimited != nil {
+			c.throttled(path, b, err)
+		}
+		return b, err
+	}
+	b, err := attempt()
+	for n := 0; err != nil && c.Retry != nil; n++ {
+		d, ok := c.Retry.NextDelay(n, err)
+		if !ok {
+			break
+		}
+		if c.Hooks.OnRetry != nil {
+			c.Hooks.OnRetry(RetryEvent{API: apiName(path), Attempt: n + 1, Delay: d, Err: err})
+		}
+		if serr := sleep(ctx, d); serr != nil {
+			return serr
+		}
+		b, err = attempt()
+	}
+	if err != nil {
+		return err
+	}
+	if g, ok := out.(*Response); ok {
+		if c.Lean {
+			return g.decodeLean(b, c.RetainRaw)
+		}
+		return g.decode(b, c.RetainRaw)
+	}
+	return json.Unmarshal(b, out)
+}
+
+// requestKey returns the key for one attempt at a request to path, from
+// the pool if the Client has one, and charges it to the quota.
+func (c *Client) requestKey(path string, withKey bool) (string, error) {
+	if !withKey {
+		return "", nil
+	}
+	key := c.APIKey
+	if c.Keys != nil {
+		var err error
+		if key, err = c.Keys.Acquire(); err != nil {
+			return "", err
+		}
+	}
+	if c.Quota != nil {
+		if err := c.Quota.Charge(key, apiName(path)); err != nil {
+			return "", err
+		}
+	}
+	return key, nil
+}
+
+// send makes a single attempt at a request, returning the body of a 2xx
+// response.
+func (c *Client) send(ctx context.Context, method, path, u string, payload []byte, header http.Header) ([]byte, error) {
+	if c.Limiter != nil {
+		wait, err := c.Limiter.wait(ctx)
+		if wait > 0 && c.Hooks.OnRateLimited != nil {
+			c.Hooks.OnRateLimited(RateLimitEvent{API: apiName(path), Wait: wait})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.Header {
+		req.Header[k] = v
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = sharedHTTPClient
+	}
+	start := time.Now()
+	resp, err := hc.Do(req)
+	if err != nil {
+		if c.Debug != nil {
+			c.record(req, payload, start, nil, nil, err)
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, RemoteServerError
+	}
+	defer resp.Body.Close()
+	b, err := readBody(resp)
+	if c.Debug != nil {
+		c.record(req, payload, start, resp, b, err)
+	}
+	if err != nil {
+		return nil, BodyReadError
+	}
+	if he := newHTTPError(resp, b); he != nil {
+		return b, he
+	}
+	return b, nil
+}
+
+// maxPreallocBody caps the buffer readBody allocates on the word of a
+// Content-Length header.
+const maxPreallocBody = 8 << 20
+
+// readBody reads resp's body into a single buffer when its length is known.
+func readBody(resp *http.Response) ([]byte, error) {
+	if n := resp.ContentLength; n > 0 && n <= maxPreallocBody {
+		b := make([]byte, n)
+		if _, err := io.ReadFull(resp.Body, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// googleAPIError is the error body returned by the newer Google APIs, which
+// report failures this way rather than with a status field.
+type googleAPIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+	Errors  []struct {
+		Reason string `json:"reason"`
+	} `json:"errors"`
+}
+
+func (e *googleAPIError) err() error {
+	if e == nil {
+		return nil
+	}
+	status := e.Status
+	if status == "" && len(e.Errors) > 0 {
+		status = e.Errors[0].Reason
+	}
+	return &GeocoderError{Status: status}
+}
+
+// checkStatus converts a non-OK API status into a GeocoderError.
+func checkStatus(status string) error {
+	if status != StatusOk {
+		return &GeocoderError{Status: status}
+	}
+	return nil
+}
+
+// requestURL joins host, path and the encoded params in one allocation
+// for the common case where no value needs escaping.
+func requestURL(host, path string, params url.Values) string {
+	var sb strings.Builder
+	n := len(host) + len(path) + 1
+	for k, vs := range params {
+		for _, v := range vs {
+			n += len(k) + len(v) + 2
+		}
+	}
+	sb.Grow(n)
+	sb.WriteString(host)
+	sb.WriteString(path)
+	sb.WriteByte('?')
+	writeParams(&sb, params, nil)
+	return sb.String()
+}
+
+func latLngParam(ll LatLng) string {
+	return strconv.FormatFloat(ll.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(ll.Lng, 'f', -1, 64)
+}
+
+func latLngsParam(points []LatLng) string {
+	parts := make([]string, len(points))
+	for i, p := range points {
+		parts[i] = latLngParam(p)
+	}
+	return strings.Join(parts, "|")
+}
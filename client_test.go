@@ -0,0 +1,79 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newTestClient returns a Client pointed at a test server that serves body
+// for every request, recording the last request it saw.
+func newTestClient(t testing.TB, body string) (*Client, **http.Request) {
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient("test-key", WithBaseURL(srv.URL)), &last
+}
+
+func TestClientSendsKey(t *testing.T) {
+	c, last := newTestClient(t, `{"status":"OK"}`)
+	var out struct{ Status string }
+	if err := c.getJSON(context.Background(), "/maps/api/test/json", map[string][]string{"a": {"b"}}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if got := (*last).URL.Query().Get("key"); got != "test-key" {
+		t.Errorf("Expected: test-key, Got: %s", got)
+	}
+	if out.Status != StatusOk {
+		t.Errorf("Expected: OK, Got: %s", out.Status)
+	}
+}
+
+func TestClientContextCanceled(t *testing.T) {
+	c, _ := newTestClient(t, `{"status":"OK"}`)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var out struct{}
+	if err := c.getJSON(ctx, "/", map[string][]string{}, &out); err != context.Canceled {
+		t.Errorf("Expected: %v, Got: %v", context.Canceled, err)
+	}
+}
+
+func TestClientHeaders(t *testing.T) {
+	c, last := newTestClient(t, `{}`)
+	WithUserAgent("geo-test/1.0 (ops@example.com)")(c)
+	WithHeader("X-Team", "maps")(c)
+	WithHeader("User-Agent", "ignored")(c)
+	var out struct{}
+	if err := c.getJSON(context.Background(), "/", map[string][]string{}, &out); err != nil {
+		t.Fatal(err)
+	}
+	h := (*last).Header
+	if got := h.Get("User-Agent"); got != "geo-test/1.0 (ops@example.com)" {
+		t.Errorf("Expected: geo-test/1.0 (ops@example.com), Got: %s", got)
+	}
+	if got := h.Get("X-Team"); got != "maps" {
+		t.Errorf("Expected: maps, Got: %s", got)
+	}
+}
+
+func TestRequestURL(t *testing.T) {
+	params := url.Values{"address": {"1 Main St & 2nd"}, "key": {"k"}, "components": {"country:CA", "locality:Zürich"}}
+	if got, expected := requestURL(mapsAPIHost, "/maps/api/geocode/json", params), mapsAPIHost+"/maps/api/geocode/json?"+params.Encode(); got != expected {
+		t.Errorf("Expected: %s, Got: %s", expected, got)
+	}
+}
+
+func BenchmarkRequestURL(b *testing.B) {
+	params := url.Values{"address": {"1600 Amphitheatre Pkwy, Mountain View"}, "key": {"test-key"}}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		requestURL(mapsAPIHost, "/maps/api/geocode/json", params)
+	}
+}
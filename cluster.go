@@ -0,0 +1,98 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// Cluster is a group of points produced by GridClusters or DistanceClusters.
+// Members holds indices into the slice that was clustered.
+type Cluster struct {
+	Center  LatLng
+	Members []int
+}
+
+// GridClusters buckets points into square grid cells roughly size on a side
+// and returns one cluster per non-empty cell, largest first.
+func GridClusters(points []LatLng, size Distance) []Cluster {
+	if size <= 0 {
+		return singletonClusters(points)
+	}
+	latStep := toDegrees(size.Meters() / EarthRadius)
+	type cell struct{ row, col int }
+	buckets := map[cell][]int{}
+	order := []cell{}
+	for i, p := range points {
+		row := int(math.Floor((p.Lat + 90) / latStep))
+		// Columns narrow towards the poles so that cells stay square.
+		rowLat := math.Min(89.9, math.Abs(-90+(float64(row)+0.5)*latStep))
+		lngStep := latStep / math.Cos(toRadians(rowLat))
+		c := cell{row, int(math.Floor((p.Lng + 180) / lngStep))}
+		if _, ok := buckets[c]; !ok {
+			order = append(order, c)
+		}
+		buckets[c] = append(buckets[c], i)
+	}
+	clusters := make([]Cluster, 0, len(order))
+	for _, c := range order {
+		clusters = append(clusters, newCluster(points, buckets[c]))
+	}
+	sortClusters(clusters)
+	return clusters
+}
+
+// DistanceClusters groups points so that every member lies within
+// threshold of the point that seeded its cluster. Points are taken as
+// seeds in input order, and clusters are returned largest first.
+func DistanceClusters(points []LatLng, threshold Distance) []Cluster {
+	if threshold <= 0 {
+		return singletonClusters(points)
+	}
+	items := make([]IndexedPoint, len(points))
+	for i, p := range points {
+		items[i] = IndexedPoint{LatLng: p, Value: i}
+	}
+	ix := NewPointIndex(items)
+
+	assigned := make([]bool, len(points))
+	clusters := []Cluster{}
+	for i, p := range points {
+		if assigned[i] {
+			continue
+		}
+		members := []int{}
+		for _, n := range ix.WithinRadius(p, threshold) {
+			j := n.Value.(int)
+			if !assigned[j] {
+				assigned[j] = true
+				members = append(members, j)
+			}
+		}
+		sort.Ints(members)
+		clusters = append(clusters, newCluster(points, members))
+	}
+	sortClusters(clusters)
+	return clusters
+}
+
+func singletonClusters(points []LatLng) []Cluster {
+	clusters := make([]Cluster, len(points))
+	for i, p := range points {
+		clusters[i] = Cluster{Center: p, Members: []int{i}}
+	}
+	return clusters
+}
+
+func newCluster(points []LatLng, members []int) Cluster {
+	pts := make([]LatLng, len(members))
+	for i, m := range members {
+		pts[i] = points[m]
+	}
+	return Cluster{Center: Centroid(pts), Members: members}
+}
+
+func sortClusters(clusters []Cluster) {
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return len(clusters[i].Members) > len(clusters[j].Members)
+	})
+}
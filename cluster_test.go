@@ -0,0 +1,43 @@
+package geo
+
+import "testing"
+
+var clusterPoints = []LatLng{
+	{40.7453, -74.0078}, {40.7455, -74.0080}, {40.7451, -74.0076}, // Chelsea
+	{51.5074, -0.1278}, {51.5076, -0.1280}, // London
+	{-33.8568, 151.2153}, // Sydney
+}
+
+func TestDistanceClusters(t *testing.T) {
+	clusters := DistanceClusters(clusterPoints, 1000)
+	if len(clusters) != 3 {
+		t.Fatalf("Expected: 3 clusters, Got: %v", clusters)
+	}
+	sizes := []int{len(clusters[0].Members), len(clusters[1].Members), len(clusters[2].Members)}
+	if sizes[0] != 3 || sizes[1] != 2 || sizes[2] != 1 {
+		t.Errorf("Expected: [3 2 1], Got: %v", sizes)
+	}
+	if d := clusters[0].Center.DistanceTo(LatLng{40.7453, -74.0078}); d > 10 {
+		t.Errorf("Expected center near Chelsea, Got: %v (%fm)", clusters[0].Center, d)
+	}
+	if got := DistanceClusters(clusterPoints, 0); len(got) != len(clusterPoints) {
+		t.Errorf("Expected one cluster per point, Got: %d", len(got))
+	}
+}
+
+func TestGridClusters(t *testing.T) {
+	clusters := GridClusters(clusterPoints, 5000)
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Members)
+	}
+	if total != len(clusterPoints) {
+		t.Errorf("Expected every point to be clustered, Got: %d", total)
+	}
+	if len(clusters) < 3 || len(clusters) > 4 {
+		t.Errorf("Expected 3 or 4 clusters, Got: %v", clusters)
+	}
+	if got := GridClusters(clusterPoints, 100000000); len(got) > 2 {
+		t.Errorf("Expected huge cells to merge points, Got: %v", got)
+	}
+}
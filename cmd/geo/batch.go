@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/reillywatson/geo"
+)
+
+type (
+	// batchConfig controls a batch run. Mapping picks the address and
+	// output columns. Checkpoint is the file progress is recorded in, every
+	// CheckpointEvery rows.
+	batchConfig struct {
+		Mapping         geo.CSVMapping
+		Checkpoint      string
+		CheckpointEvery int
+	}
+
+	// checkpoint records how many input rows have been written to the
+	// output, and how long the output was at that point, so a resumed run
+	// can discard any rows written after the last checkpoint.
+	checkpoint struct {
+		Rows   int   `json:"rows"`
+		Offset int64 `json:"offset"`
+	}
+)
+
+// defaultOutputColumns are appended to each input row unless the mapping
+// names others.
+var defaultOutputColumns = []string{geo.CSVLat, geo.CSVLng, geo.CSVFormattedAddress, geo.CSVStatus, geo.CSVError}
+
+// runBatch geocodes every row of in as described by cfg.Mapping and writes
+// the rows, with the output columns added, to outPath. If cfg.Checkpoint names an existing
+// checkpoint the run resumes after the rows it records. It returns the
+// number of rows written by this run.
+func runBatch(ctx context.Context, g geo.Geocoder, in io.Reader, outPath string, cfg batchConfig) (int, error) {
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return 0, err
+	}
+	schema, err := cfg.Mapping.Bind(header)
+	if err != nil {
+		return 0, err
+	}
+
+	cp, err := readCheckpoint(cfg.Checkpoint)
+	if err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(outPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if err := f.Truncate(cp.Offset); err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(cp.Offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	w := csv.NewWriter(f)
+	if cp.Offset == 0 {
+		w.Write(schema.Header())
+	}
+
+	save := func() error {
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+		off, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		cp.Offset = off
+		return writeCheckpoint(cfg.Checkpoint, cp)
+	}
+
+	written := 0
+	for row := 0; ; row++ {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			save()
+			return written, err
+		}
+		if row < cp.Rows {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			save()
+			return written, err
+		}
+		a, err := g.Geocode(ctx, schema.Query(rec))
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			// The row was interrupted rather than failed: leave it for the
+			// resumed run instead of recording the cancellation.
+			save()
+			return written, ctxErr
+		}
+		w.Write(schema.Row(rec, a, err))
+		written++
+		cp.Rows = row + 1
+		if cfg.CheckpointEvery <= 1 || written%cfg.CheckpointEvery == 0 {
+			if err := save(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, save()
+}
+
+func readCheckpoint(path string) (checkpoint, error) {
+	var cp checkpoint
+	if path == "" {
+		return cp, nil
+	}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return cp, err
+	}
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return cp, fmt.Errorf("bad checkpoint %s: %v", path, err)
+	}
+	return cp, nil
+}
+
+// writeCheckpoint replaces the checkpoint atomically, so an interrupted
+// write never leaves a truncated one behind.
+func writeCheckpoint(path string, cp checkpoint) error {
+	if path == "" {
+		return nil
+	}
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
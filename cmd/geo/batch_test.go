@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reillywatson/geo"
+	"github.com/reillywatson/geo/geotest"
+)
+
+const batchInput = "id,address\n1,Ottawa\n2,Nowhere\n3,Broken\n4,Ottawa\n"
+
+var testMapping = geo.CSVMapping{AddressColumns: []string{"address"}, OutputColumns: defaultOutputColumns}
+
+func newBatchGeocoder() *geotest.Geocoder {
+	g := geotest.New()
+	g.OnGeocode("Ottawa").Return(geotest.Address("Ottawa, ON, Canada", 45.42, -75.69))
+	g.OnGeocode("Broken").ReturnError(errors.New("connection reset"))
+	return g
+}
+
+func TestRunBatch(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out.csv")
+	cfg := batchConfig{Mapping: testMapping, Checkpoint: filepath.Join(dir, "cp"), CheckpointEvery: 1}
+	n, err := runBatch(context.Background(), newBatchGeocoder(), strings.NewReader(batchInput), out, cfg)
+	if err != nil || n != 4 {
+		t.Fatalf("Expected: 4 rows, Got: %d (%v)", n, err)
+	}
+	b, _ := os.ReadFile(out)
+	want := "id,address,lat,lng,formatted_address,status,error\n" +
+		"1,Ottawa,45.42,-75.69,\"Ottawa, ON, Canada\",OK,\n" +
+		"2,Nowhere,,,,ZERO_RESULTS,\n" +
+		"3,Broken,,,,ERROR,connection reset\n" +
+		"4,Ottawa,45.42,-75.69,\"Ottawa, ON, Canada\",OK,\n"
+	if string(b) != want {
+		t.Errorf("Expected: %s, Got: %s", want, b)
+	}
+}
+
+func TestRunBatchResumes(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out.csv")
+	cfg := batchConfig{Mapping: testMapping, Checkpoint: filepath.Join(dir, "cp"), CheckpointEvery: 2}
+
+	// Cancel while the fourth row is in flight; it must be left for the
+	// resumed run rather than recorded as an error.
+	g := newBatchGeocoder()
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	counting := geocoderFunc(func(ctx context.Context, q string) (*geo.Address, error) {
+		calls++
+		if calls == 4 {
+			cancel()
+			return nil, ctx.Err()
+		}
+		return g.Geocode(ctx, q)
+	})
+	if _, err := runBatch(ctx, counting, strings.NewReader(batchInput), out, cfg); err != context.Canceled {
+		t.Fatalf("Expected: %v, Got: %v", context.Canceled, err)
+	}
+	cp, _ := readCheckpoint(cfg.Checkpoint)
+	if cp.Rows != 3 {
+		t.Errorf("Expected: checkpoint at row 3, Got: %d", cp.Rows)
+	}
+
+	if b, _ := os.ReadFile(out); strings.Contains(string(b), "canceled") {
+		t.Errorf("Unexpected canceled row in output: %s", b)
+	}
+
+	// Simulate a crash that wrote a row without checkpointing it.
+	f, _ := os.OpenFile(out, os.O_APPEND|os.O_WRONLY, 0644)
+	f.WriteString("4,Ottawa,partial\n")
+	f.Close()
+
+	n, err := runBatch(context.Background(), newBatchGeocoder(), strings.NewReader(batchInput), out, cfg)
+	if err != nil || n != 1 {
+		t.Fatalf("Expected: 1 row, Got: %d (%v)", n, err)
+	}
+	b, _ := os.ReadFile(out)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[4], "4,Ottawa,45.42") || strings.Contains(string(b), "partial") {
+		t.Errorf("Unexpected output after resume: %s", b)
+	}
+}
+
+func TestRunBatchMissingColumn(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out.csv")
+	if _, err := runBatch(context.Background(), geotest.New(), strings.NewReader("id,street\n"), out, batchConfig{Mapping: testMapping}); err == nil {
+		t.Error("Expected an error for a missing column")
+	}
+}
+
+// geocoderFunc adapts a function to geo.Geocoder.
+type geocoderFunc func(ctx context.Context, q string) (*geo.Address, error)
+
+func (f geocoderFunc) Geocode(ctx context.Context, q string, opts ...geo.RequestOption) (*geo.Address, error) {
+	return f(ctx, q)
+}
+
+func (f geocoderFunc) ReverseGeocode(ctx context.Context, ll geo.LatLng, opts ...geo.RequestOption) (*geo.Address, error) {
+	return nil, errors.New("not implemented")
+}
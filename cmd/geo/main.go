@@ -0,0 +1,126 @@
+// Command geo geocodes addresses from the command line using the Google
+// Geocoding API.
+//
+// Usage:
+//
+//	geo geocode <address>
+//	geo reverse <lat,lng>
+//	geo batch -in addresses.csv -out results.csv [-column street,city,zip] [-output lat,lng,postal_code] [-checkpoint file]
+//
+// The API key is read from -key or the GOOGLE_MAPS_API_KEY environment
+// variable.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/reillywatson/geo"
+)
+
+func main() {
+	key := flag.String("key", os.Getenv("GOOGLE_MAPS_API_KEY"), "Google Maps API key")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := geo.NewClient(*key)
+	var err error
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "geocode":
+		err = geocode(ctx, c, args)
+	case "reverse":
+		err = reverse(ctx, c, args)
+	case "batch":
+		err = batch(ctx, c, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "geo:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: geo [-key key] geocode <address> | reverse <lat,lng> | batch -in file -out file [flags]")
+	flag.PrintDefaults()
+}
+
+func geocode(ctx context.Context, g geo.Geocoder, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("geocode takes one address")
+	}
+	a, err := g.Geocode(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Println(a)
+	return nil
+}
+
+func reverse(ctx context.Context, g geo.Geocoder, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("reverse takes one lat,lng pair")
+	}
+	ll, ok := geo.TryParseCoordinates(args[0])
+	if !ok {
+		return fmt.Errorf("can't parse coordinates %q", args[0])
+	}
+	a, err := g.ReverseGeocode(ctx, ll)
+	if err != nil {
+		return err
+	}
+	fmt.Println(a)
+	return nil
+}
+
+func batch(ctx context.Context, g geo.Geocoder, args []string) error {
+	fs := flag.NewFlagSet("batch", flag.ContinueOnError)
+	in := fs.String("in", "", "input CSV file, with a header row")
+	out := fs.String("out", "", "output CSV file")
+	columns := fs.String("column", "address", "comma-separated names of the columns that form the address")
+	output := fs.String("output", strings.Join(defaultOutputColumns, ","), "comma-separated output columns: "+strings.Join(geo.DefaultCSVOutputColumns, ", "))
+	cfg := batchConfig{}
+	fs.StringVar(&cfg.Checkpoint, "checkpoint", "", "checkpoint file for resuming interrupted runs (default <out>.checkpoint)")
+	fs.IntVar(&cfg.CheckpointEvery, "checkpoint-every", 100, "rows between checkpoints")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" || *out == "" {
+		return fmt.Errorf("batch needs -in and -out")
+	}
+	cfg.Mapping = geo.CSVMapping{AddressColumns: splitList(*columns), OutputColumns: splitList(*output)}
+	if cfg.Checkpoint == "" {
+		cfg.Checkpoint = *out + ".checkpoint"
+	}
+	f, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	n, err := runBatch(ctx, g, f, *out, cfg)
+	fmt.Fprintf(os.Stderr, "geo: %d rows written to %s\n", n, *out)
+	return err
+}
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
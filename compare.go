@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+// AddressDiff describes how a geocode changed between two lookups of the
+// same address, e.g. a stored result and a fresh one. Components are taken
+// from each address's first result; postal codes are compared ignoring case
+// and spacing, and localities ignoring case.
+type AddressDiff struct {
+	Distance          Distance
+	PostalCodeChanged bool
+	OldPostalCode     string
+	NewPostalCode     string
+	LocalityChanged   bool
+	OldLocality       string
+	NewLocality       string
+	CountryChanged    bool
+	OldCountryCode    string
+	NewCountryCode    string
+}
+
+// CompareAddresses compares a, typically a stored geocode, with b, a fresh
+// one; the Old fields describe a and the New fields b. Distance is how far
+// the location moved.
+func CompareAddresses(a, b *Address) AddressDiff {
+	o, n := addressParts(a), addressParts(b)
+	d := AddressDiff{
+		Distance:       LatLng{a.Lat, a.Lng}.Distance(LatLng{b.Lat, b.Lng}),
+		OldPostalCode:  o.PostalCode,
+		NewPostalCode:  n.PostalCode,
+		OldLocality:    o.City,
+		NewLocality:    n.City,
+		OldCountryCode: a.CountryCode(),
+		NewCountryCode: b.CountryCode(),
+	}
+	d.PostalCodeChanged = comparablePostalCode(o.PostalCode) != comparablePostalCode(n.PostalCode)
+	d.LocalityChanged = !strings.EqualFold(o.City, n.City)
+	d.CountryChanged = d.OldCountryCode != d.NewCountryCode
+	return d
+}
+
+// Moved reports whether the location moved more than threshold.
+func (d AddressDiff) Moved(threshold Distance) bool {
+	return d.Distance > threshold || math.IsNaN(d.Distance.Meters())
+}
+
+// Changed reports whether the geocode drifted materially: it moved more
+// than threshold, or its postal code, locality or country changed.
+func (d AddressDiff) Changed(threshold Distance) bool {
+	return d.Moved(threshold) || d.PostalCodeChanged || d.LocalityChanged || d.CountryChanged
+}
+
+func addressParts(a *Address) ParsedAddress {
+	if a.Response == nil || len(a.Response.Results) == 0 {
+		return ParsedAddress{}
+	}
+	return a.Response.Results[0].ParsedAddress()
+}
+
+func comparablePostalCode(s string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(s), ""))
+}
@@ -0,0 +1,45 @@
+package geo
+
+import "testing"
+
+func compareTestAddress(ll LatLng, city, postalCode, country string) *Address {
+	r := Result{
+		AddressComponents: []AddressComponent{
+			{LongName: city, ShortName: city, Types: []string{"locality", "political"}},
+			{LongName: postalCode, ShortName: postalCode, Types: []string{"postal_code"}},
+			{LongName: country, ShortName: country, Types: []string{"country", "political"}},
+		},
+		Geometry: GeometryData{Location: ll},
+	}
+	return newAddress(&Response{Status: StatusOk, Results: []Result{r}})
+}
+
+func TestCompareAddresses(t *testing.T) {
+	stored := compareTestAddress(LatLng{45.4215, -75.6972}, "Ottawa", "K1P 1J1", "CA")
+	tests := []struct {
+		name       string
+		fresh      *Address
+		moved      bool
+		postalCode bool
+		locality   bool
+	}{
+		{"same", compareTestAddress(LatLng{45.4215, -75.6972}, "ottawa", "k1p1j1", "CA"), false, false, false},
+		{"nudged", compareTestAddress(LatLng{45.4216, -75.6972}, "Ottawa", "K1P 1J1", "CA"), false, false, false},
+		{"moved", compareTestAddress(LatLng{45.4315, -75.6972}, "Ottawa", "K1P 1J1", "CA"), true, false, false},
+		{"recoded", compareTestAddress(LatLng{45.4215, -75.6972}, "Gatineau", "J8X 1A1", "CA"), false, true, true},
+	}
+	for _, test := range tests {
+		d := CompareAddresses(stored, test.fresh)
+		if d.Moved(50) != test.moved || d.PostalCodeChanged != test.postalCode || d.LocalityChanged != test.locality {
+			t.Errorf("%s: Unexpected diff: %+v", test.name, d)
+		}
+		if changed := test.moved || test.postalCode || test.locality; d.Changed(50) != changed {
+			t.Errorf("%s: Expected: %v, Got: %v", test.name, changed, d.Changed(50))
+		}
+	}
+
+	d := CompareAddresses(stored, &Address{Lat: 45.4215, Lng: -75.6972})
+	if !d.PostalCodeChanged || d.NewPostalCode != "" || !d.CountryChanged || d.OldCountryCode != "CA" {
+		t.Errorf("Expected: components to have been lost, Got: %+v", d)
+	}
+}
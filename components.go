@@ -0,0 +1,160 @@
+package geo
+
+import (
+	"regexp"
+	"strings"
+)
+
+// postalFormat describes a country's postal codes. full matches a complete
+// code and partial a prefix that is still useful as a filter, such as a UK
+// outward code or a Canadian FSA, both after normalization. Codes are
+// normalized by uppercasing, dropping spaces and, when inward is set,
+// putting a single space before the last inward characters.
+type postalFormat struct {
+	full    *regexp.Regexp
+	partial *regexp.Regexp
+	inward  int
+}
+
+var postalFormats = map[string]postalFormat{
+	"GB": {
+		full:    regexp.MustCompile(`^(GIR 0AA|[A-Z]{1,2}[0-9][0-9A-Z]? [0-9][A-Z]{2})$`),
+		partial: regexp.MustCompile(`^[A-Z]{1,2}[0-9][0-9A-Z]?$`),
+		inward:  3,
+	},
+	"CA": {
+		full:    regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z] [0-9][ABCEGHJ-NPRSTV-Z][0-9]$`),
+		partial: canadianFSAPattern,
+		inward:  3,
+	},
+	"IE": {
+		full:    regexp.MustCompile(`^([AC-FHKNPRTV-Y][0-9]{2}|D6W) [0-9AC-FHKNPRTV-Y]{4}$`),
+		partial: regexp.MustCompile(`^([AC-FHKNPRTV-Y][0-9]{2}|D6W)$`),
+		inward:  4,
+	},
+	"NL": {
+		full:    regexp.MustCompile(`^[1-9][0-9]{3} [A-Z]{2}$`),
+		partial: regexp.MustCompile(`^[1-9][0-9]{3}$`),
+		inward:  2,
+	},
+	"US": {full: regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`)},
+	"JP": {full: regexp.MustCompile(`^[0-9]{3}-?[0-9]{4}$`)},
+	"AU": {full: regexp.MustCompile(`^[0-9]{4}$`)},
+	"DE": {full: regexp.MustCompile(`^[0-9]{5}$`)},
+	"ES": {full: regexp.MustCompile(`^[0-9]{5}$`)},
+	"FR": {full: regexp.MustCompile(`^[0-9]{5}$`)},
+	"IT": {full: regexp.MustCompile(`^[0-9]{5}$`)},
+}
+
+var canadianFSAPattern = regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z]$`)
+
+// normalize returns pc in its canonical form, or false if it is neither a
+// full nor a partial code.
+func (f postalFormat) normalize(pc string) (string, bool) {
+	pc = strings.ToUpper(strings.Join(strings.Fields(pc), ""))
+	if f.inward > 0 && len(pc) > f.inward {
+		if full := pc[:len(pc)-f.inward] + " " + pc[len(pc)-f.inward:]; f.full.MatchString(full) {
+			return full, true
+		}
+	}
+	if f.full.MatchString(pc) || (f.partial != nil && f.partial.MatchString(pc)) {
+		return pc, true
+	}
+	return "", false
+}
+
+// Validate checks the filter for mistakes that would otherwise silently
+// match nothing or the wrong place: a country that isn't an ISO 3166-1
+// name or alpha-2 code, and a postal code that doesn't fit the country's
+// format, where this package knows it. Partial postal codes such as UK
+// outward codes and Canadian FSAs are accepted.
+func (c *ComponentFilter) Validate() error {
+	country := ""
+	if c.Country != "" {
+		cc, ok := LookupCountry(c.Country)
+		if !ok {
+			return &InvalidInputError{Field: "components", Reason: "unknown country " + c.Country}
+		}
+		if _, byName := CountryByName(c.Country); !byName && len(strings.TrimSpace(c.Country)) != 2 {
+			return &InvalidInputError{Field: "components", Reason: "country must be a name or two-letter code, such as " + cc.Alpha2}
+		}
+		country = cc.Alpha2
+	}
+	if f, ok := postalFormats[country]; ok && c.PostalCode != "" {
+		if _, ok := f.normalize(c.PostalCode); !ok {
+			return &InvalidInputError{Field: "components", Reason: "postal code " + c.PostalCode + " is not valid in " + country}
+		}
+	}
+	return nil
+}
+
+// Warnings describes combinations of components that are allowed but
+// unlikely to do what was meant.
+func (c *ComponentFilter) Warnings() []string {
+	var w []string
+	if c.PostalCode != "" && c.Country == "" {
+		w = append(w, "postal_code without country can match a code in another country")
+	}
+	if (c.Route != "" || c.Locality != "" || c.AdministrativeArea != "") && c.Country == "" && c.PostalCode == "" {
+		w = append(w, "route, locality and administrative_area only bias results; add country or postal_code to restrict them")
+	}
+	if c.Route != "" && c.Locality == "" && c.PostalCode == "" {
+		w = append(w, "route without locality or postal_code can match a street of the same name anywhere")
+	}
+	return w
+}
+
+// CountryFilter returns a filter restricting results to country, given as a
+// name or any ISO 3166-1 code, using the alpha-2 code the API expects.
+func CountryFilter(country string) (ComponentFilter, error) {
+	cc, ok := LookupCountry(country)
+	if !ok {
+		return ComponentFilter{}, &InvalidInputError{Field: "components", Reason: "unknown country " + country}
+	}
+	return ComponentFilter{Country: cc.Alpha2}, nil
+}
+
+// PostalCodeFilter returns a filter restricting results to postalCode in
+// country. The code is checked against the country's format and
+// normalized, e.g. "sw1a1aa" becomes "SW1A 1AA", where this package knows
+// the format; other countries' codes are passed through trimmed.
+func PostalCodeFilter(country, postalCode string) (ComponentFilter, error) {
+	f, err := CountryFilter(country)
+	if err != nil {
+		return f, err
+	}
+	f.PostalCode = strings.TrimSpace(postalCode)
+	if f.PostalCode == "" {
+		return ComponentFilter{}, &InvalidInputError{Field: "components", Reason: "postal code must not be empty"}
+	}
+	if format, ok := postalFormats[f.Country]; ok {
+		pc, ok := format.normalize(postalCode)
+		if !ok {
+			return ComponentFilter{}, &InvalidInputError{Field: "components", Reason: "postal code " + f.PostalCode + " is not valid in " + f.Country}
+		}
+		f.PostalCode = pc
+	}
+	return f, nil
+}
+
+// UKPostcode returns a filter for a UK postcode, or just its outward code
+// such as "SW1A".
+func UKPostcode(postcode string) (ComponentFilter, error) {
+	return PostalCodeFilter("GB", postcode)
+}
+
+// CanadianFSA returns a filter for the forward sortation area, the first
+// three characters, of a Canadian postal code, e.g. "K1A" for "K1A 0B1".
+// FSAs cover a neighbourhood or town, which suits coarse lookups.
+func CanadianFSA(postalCode string) (ComponentFilter, error) {
+	pc, ok := postalFormats["CA"].normalize(postalCode)
+	if !ok {
+		return ComponentFilter{}, &InvalidInputError{Field: "components", Reason: "postal code " + postalCode + " is not valid in CA"}
+	}
+	return ComponentFilter{Country: "CA", PostalCode: pc[:3]}, nil
+}
+
+// USZIPCode returns a filter for a US ZIP or ZIP+4 code.
+func USZIPCode(zip string) (ComponentFilter, error) {
+	return PostalCodeFilter("US", zip)
+}
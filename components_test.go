@@ -0,0 +1,90 @@
+package geo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestComponentFilterValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		f     ComponentFilter
+		valid bool
+	}{
+		{"empty", ComponentFilter{}, true},
+		{"alpha-2", ComponentFilter{Country: "ca"}, true},
+		{"name", ComponentFilter{Country: "United Kingdom"}, true},
+		{"unknown", ComponentFilter{Country: "XX"}, false},
+		{"alpha-3", ComponentFilter{Country: "CAN"}, false},
+		{"UK postcode", ComponentFilter{Country: "GB", PostalCode: "sw1a 1aa"}, true},
+		{"UK outward code", ComponentFilter{Country: "GB", PostalCode: "SW1A"}, true},
+		{"bad UK postcode", ComponentFilter{Country: "GB", PostalCode: "12345"}, false},
+		{"Canadian FSA", ComponentFilter{Country: "CA", PostalCode: "K1A"}, true},
+		{"bad Canadian code", ComponentFilter{Country: "CA", PostalCode: "D1A 0B1"}, false},
+		{"ZIP+4", ComponentFilter{Country: "US", PostalCode: "10011-1234"}, true},
+		{"unknown format", ComponentFilter{Country: "BR", PostalCode: "01310-100"}, true},
+		{"no country", ComponentFilter{PostalCode: "anything"}, true},
+	}
+	for _, test := range tests {
+		if err := test.f.Validate(); (err == nil) != test.valid {
+			t.Errorf("%s: Expected valid: %v, Got: %v", test.name, test.valid, err)
+		}
+	}
+}
+
+func TestComponentFilterWarnings(t *testing.T) {
+	if w := (&ComponentFilter{Country: "US", PostalCode: "10011"}).Warnings(); len(w) != 0 {
+		t.Errorf("Expected: no warnings, Got: %v", w)
+	}
+	if w := (&ComponentFilter{PostalCode: "10011"}).Warnings(); len(w) != 1 {
+		t.Errorf("Expected: a warning about the missing country, Got: %v", w)
+	}
+	if w := (&ComponentFilter{Route: "Main Street"}).Warnings(); len(w) != 2 {
+		t.Errorf("Expected: warnings about an unrestricted route, Got: %v", w)
+	}
+}
+
+func TestComponentPresets(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(string) (ComponentFilter, error)
+		in       string
+		expected ComponentFilter
+	}{
+		{"UK", UKPostcode, "sw1a1aa", ComponentFilter{Country: "GB", PostalCode: "SW1A 1AA"}},
+		{"UK outward", UKPostcode, " ec1a ", ComponentFilter{Country: "GB", PostalCode: "EC1A"}},
+		{"FSA", CanadianFSA, "k1a 0b1", ComponentFilter{Country: "CA", PostalCode: "K1A"}},
+		{"ZIP", USZIPCode, "10011", ComponentFilter{Country: "US", PostalCode: "10011"}},
+		{"country name", CountryFilter, "Germany", ComponentFilter{Country: "DE"}},
+		{"alpha-3", CountryFilter, "CAN", ComponentFilter{Country: "CA"}},
+	}
+	for _, test := range tests {
+		got, err := test.f(test.in)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s: Expected: %+v, Got: %+v", test.name, test.expected, got)
+		}
+	}
+	for _, bad := range []func() (ComponentFilter, error){
+		func() (ComponentFilter, error) { return UKPostcode("90210") },
+		func() (ComponentFilter, error) { return CanadianFSA("Z1A") },
+		func() (ComponentFilter, error) { return PostalCodeFilter("Atlantis", "1") },
+		func() (ComponentFilter, error) { return PostalCodeFilter("US", " ") },
+	} {
+		if f, err := bad(); err == nil {
+			t.Errorf("Expected: an error, Got: %+v", f)
+		}
+	}
+}
+
+func TestGeocodeValidatesComponents(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	_, err := c.Geocode(context.Background(), "1 Main St", WithComponents(ComponentFilter{Country: "XX"}))
+	if _, ok := err.(*InvalidInputError); !ok || *last != nil {
+		t.Errorf("Expected: InvalidInputError without a request, Got: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package geo
+
+import "strings"
+
+// Precision is how exactly a result's coordinates pin down its address,
+// ordered from least to most precise so that levels can be compared.
+type Precision int
+
+const (
+	PrecisionUnknown Precision = iota
+	PrecisionApproximate
+	PrecisionCentroid
+	PrecisionInterpolated
+	PrecisionRooftop
+)
+
+const (
+	LocationTypeRooftop           = "ROOFTOP"
+	LocationTypeRangeInterpolated = "RANGE_INTERPOLATED"
+	LocationTypeGeometricCenter   = "GEOMETRIC_CENTER"
+	LocationTypeApproximate       = "APPROXIMATE"
+)
+
+// precisionLocationTypes maps each Precision to its Geocoding API
+// location_type.
+var precisionLocationTypes = map[Precision]string{
+	PrecisionApproximate:  LocationTypeApproximate,
+	PrecisionCentroid:     LocationTypeGeometricCenter,
+	PrecisionInterpolated: LocationTypeRangeInterpolated,
+	PrecisionRooftop:      LocationTypeRooftop,
+}
+
+var precisionNames = []string{"unknown", "approximate", "centroid", "interpolated", "rooftop"}
+
+func (p Precision) String() string {
+	if p < 0 || int(p) >= len(precisionNames) {
+		return precisionNames[0]
+	}
+	return precisionNames[p]
+}
+
+// MarshalText encodes p by name, so stored results stay readable.
+func (p Precision) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes a name written by MarshalText. Unknown names decode
+// as PrecisionUnknown.
+func (p *Precision) UnmarshalText(b []byte) error {
+	*p = PrecisionUnknown
+	for i, name := range precisionNames {
+		if strings.EqualFold(string(b), name) {
+			*p = Precision(i)
+		}
+	}
+	return nil
+}
+
+// scoreGoogle sets Confidence and Precision from the Geocoding API's
+// location_type, partial_match and result types. Each provider maps its own
+// quality signals onto the same 0–1 scale, so results from different
+// providers can be compared and thresholded together.
+func (r *Result) scoreGoogle() {
+	p, _ := locationTypePrecision(r.Geometry.LocationType)
+	r.scorePrecision(p)
+}
+
+// precisionConfidence is the base Confidence of a result at each Precision.
+var precisionConfidence = []float64{0.2, 0.4, 0.6, 0.8, 1}
+
+// scorePrecision sets Precision to p and Confidence to match, discounting
+// approximate matches to large areas and partial matches.
+func (r *Result) scorePrecision(p Precision) {
+	r.Precision, r.Confidence = p, precisionConfidence[p]
+	// Approximate matches to large areas say little about the address.
+	if r.Precision == PrecisionApproximate {
+		switch {
+		case hasType(r.Types, "country"):
+			r.Confidence = 0.1
+		case hasType(r.Types, "administrative_area_level_1"):
+			r.Confidence = 0.2
+		case hasType(r.Types, "locality", "postal_code", "administrative_area_level_2"):
+			r.Confidence = 0.3
+		}
+	}
+	if r.PartialMatch {
+		r.Confidence *= 0.75
+	}
+}
+
+// Precision returns how precisely a's best result is located, for routing
+// low-precision results to review. The result's location_type decides when
+// present; otherwise its types do, so results from providers that don't
+// report a location_type are still classified.
+func (a *Address) Precision() Precision {
+	if a.Response == nil || len(a.Response.Results) == 0 {
+		return PrecisionUnknown
+	}
+	r := &a.Response.Results[0]
+	if r.Precision != PrecisionUnknown {
+		return r.Precision
+	}
+	if p, ok := locationTypePrecision(r.Geometry.LocationType); ok {
+		return p
+	}
+	return typePrecision(r.Types)
+}
+
+func locationTypePrecision(lt string) (Precision, bool) {
+	for p, name := range precisionLocationTypes {
+		if name == lt {
+			return p, true
+		}
+	}
+	return PrecisionUnknown, false
+}
+
+// typePrecision guesses a precision from result types alone.
+func typePrecision(types []string) Precision {
+	switch {
+	case hasType(types, "premise", "subpremise", "street_address"):
+		return PrecisionRooftop
+	case hasType(types, "route", "intersection", "establishment", "point_of_interest"):
+		return PrecisionCentroid
+	case len(types) > 0:
+		return PrecisionApproximate
+	}
+	return PrecisionUnknown
+}
+
+func hasType(types []string, want ...string) bool {
+	for _, t := range types {
+		for _, w := range want {
+			if t == w {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScoreGoogle(t *testing.T) {
+	tests := []struct {
+		json       string
+		precision  Precision
+		confidence float64
+	}{
+		{`{"types": ["street_address"], "geometry": {"location_type": "ROOFTOP"}}`, PrecisionRooftop, 1},
+		{`{"types": ["street_address"], "partial_match": true, "geometry": {"location_type": "ROOFTOP"}}`, PrecisionRooftop, 0.75},
+		{`{"types": ["route"], "geometry": {"location_type": "GEOMETRIC_CENTER"}}`, PrecisionCentroid, 0.6},
+		{`{"types": ["street_address"], "geometry": {"location_type": "RANGE_INTERPOLATED"}}`, PrecisionInterpolated, 0.8},
+		{`{"types": ["locality", "political"], "geometry": {"location_type": "APPROXIMATE"}}`, PrecisionApproximate, 0.3},
+		{`{"types": ["country", "political"], "geometry": {"location_type": "APPROXIMATE"}}`, PrecisionApproximate, 0.1},
+		{`{"types": ["establishment"], "geometry": {}}`, PrecisionUnknown, 0.2},
+	}
+	for _, test := range tests {
+		var r Result
+		if err := json.Unmarshal([]byte(test.json), &r); err != nil {
+			t.Fatal(err)
+		}
+		r.scoreGoogle()
+		if r.Precision != test.precision || r.Confidence != test.confidence {
+			t.Errorf("%s: Expected: %v %v, Got: %v %v", test.json, test.precision, test.confidence, r.Precision, r.Confidence)
+		}
+	}
+}
+
+func TestPrecisionJSON(t *testing.T) {
+	b, err := json.Marshal(Result{Precision: PrecisionInterpolated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r Result
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Precision != PrecisionInterpolated {
+		t.Errorf("Expected: %v, Got: %v", PrecisionInterpolated, r.Precision)
+	}
+	if !(PrecisionRooftop > PrecisionInterpolated && PrecisionCentroid > PrecisionApproximate) {
+		t.Errorf("Expected precision levels to be ordered")
+	}
+}
+
+func TestAddressPrecision(t *testing.T) {
+	addr := func(r Result) *Address {
+		return &Address{Response: &Response{Results: []Result{r}}}
+	}
+	scored := Result{Geometry: GeometryData{LocationType: LocationTypeRooftop}}
+	scored.scoreGoogle()
+	for _, c := range []struct {
+		addr *Address
+		want Precision
+	}{
+		{&Address{}, PrecisionUnknown},
+		{addr(scored), PrecisionRooftop},
+		{addr(Result{Geometry: GeometryData{LocationType: LocationTypeRangeInterpolated}}), PrecisionInterpolated},
+		{addr(Result{Types: []string{"street_address"}}), PrecisionRooftop},
+		{addr(Result{Types: []string{"route"}}), PrecisionCentroid},
+		{addr(Result{Types: []string{"locality", "political"}}), PrecisionApproximate},
+		{addr(Result{}), PrecisionUnknown},
+	} {
+		if got := c.addr.Precision(); got != c.want {
+			t.Errorf("Expected: %v, Got: %v", c.want, got)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package geo
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+type (
+	// ConsensusProvider is one of the geocoders a ConsensusGeocoder asks.
+	// Name identifies it in diagnostics.
+	ConsensusProvider struct {
+		Name     string
+		Geocoder Geocoder
+	}
+
+	// ConsensusGeocoder asks every provider in parallel and answers only
+	// when at least Quorum of them agree: their locations are all within
+	// Tolerance of one of the answers. Quorum defaults to a majority of the
+	// providers, so two providers must both agree. It suits records where a
+	// wrong coordinate costs more than the extra requests.
+	ConsensusGeocoder struct {
+		Providers []ConsensusProvider
+		Tolerance Distance
+		Quorum    int
+	}
+
+	// ConsensusAnswer is one provider's part in a consensus. Distance and
+	// Diff compare its answer with the consensus address; they are unset if
+	// the provider failed or there was no consensus.
+	ConsensusAnswer struct {
+		Provider string
+		Address  *Address
+		Err      error
+		Agrees   bool
+		Distance Distance
+		Diff     AddressDiff
+	}
+
+	// ConsensusResult is the outcome of asking every provider. Address is
+	// the answer of the agreeing provider listed first, and Location the
+	// centroid of all agreeing answers. Answers holds every provider's
+	// answer in the order the providers are listed.
+	ConsensusResult struct {
+		Address  *Address
+		Location LatLng
+		Agreeing int
+		Answers  []ConsensusAnswer
+	}
+
+	// DisagreementError is returned when fewer than Quorum providers agree.
+	// Result holds their answers for diagnosis.
+	DisagreementError struct {
+		Quorum int
+		Result *ConsensusResult
+	}
+)
+
+func (e *DisagreementError) Error() string {
+	return "No consensus: " + strconv.Itoa(e.Result.Agreeing) + " of " + strconv.Itoa(len(e.Result.Answers)) + " providers agree, " + strconv.Itoa(e.Quorum) + " needed."
+}
+
+// Consensus geocodes q with every provider and returns the consensus with
+// each provider's answer. If there is none, the result is still returned,
+// along with a *DisagreementError.
+func (g *ConsensusGeocoder) Consensus(ctx context.Context, q string, opts ...RequestOption) (*ConsensusResult, error) {
+	return g.consensus(ctx, func(ctx context.Context, p Geocoder) (*Address, error) {
+		return p.Geocode(ctx, q, opts...)
+	})
+}
+
+// ReverseConsensus is Consensus for reverse geocoding ll.
+func (g *ConsensusGeocoder) ReverseConsensus(ctx context.Context, ll LatLng, opts ...RequestOption) (*ConsensusResult, error) {
+	return g.consensus(ctx, func(ctx context.Context, p Geocoder) (*Address, error) {
+		return p.ReverseGeocode(ctx, ll, opts...)
+	})
+}
+
+// Geocode returns the consensus address for q.
+func (g *ConsensusGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	r, err := g.Consensus(ctx, q, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return r.Address, nil
+}
+
+// ReverseGeocode returns the consensus address for ll.
+func (g *ConsensusGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	r, err := g.ReverseConsensus(ctx, ll, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return r.Address, nil
+}
+
+func (g *ConsensusGeocoder) quorum() int {
+	if g.Quorum > 0 {
+		return g.Quorum
+	}
+	return len(g.Providers)/2 + 1
+}
+
+func (g *ConsensusGeocoder) consensus(ctx context.Context, call func(context.Context, Geocoder) (*Address, error)) (*ConsensusResult, error) {
+	r := &ConsensusResult{Answers: make([]ConsensusAnswer, len(g.Providers))}
+	var wg sync.WaitGroup
+	for i, p := range g.Providers {
+		r.Answers[i].Provider = p.Name
+		wg.Add(1)
+		go func(a *ConsensusAnswer, p Geocoder) {
+			defer wg.Done()
+			a.Address, a.Err = call(ctx, p)
+		}(&r.Answers[i], p.Geocoder)
+	}
+	wg.Wait()
+
+	// The consensus forms around the answer with the most others within
+	// Tolerance of it, the first listed winning ties.
+	seed, best := -1, 0
+	for i, a := range r.Answers {
+		if !a.answered() {
+			continue
+		}
+		n := 0
+		for _, b := range r.Answers {
+			if b.answered() && addressLatLng(a.Address).Distance(addressLatLng(b.Address)) <= g.Tolerance {
+				n++
+			}
+		}
+		if n > best {
+			seed, best = i, n
+		}
+	}
+	if seed >= 0 && best >= g.quorum() {
+		origin := addressLatLng(r.Answers[seed].Address)
+		var points []LatLng
+		for i := range r.Answers {
+			a := &r.Answers[i]
+			if a.answered() && origin.Distance(addressLatLng(a.Address)) <= g.Tolerance {
+				a.Agrees = true
+				if r.Address == nil {
+					r.Address = a.Address
+				}
+				points = append(points, addressLatLng(a.Address))
+			}
+		}
+		r.Agreeing = len(points)
+		r.Location = Centroid(points)
+		for i := range r.Answers {
+			if a := &r.Answers[i]; a.answered() {
+				a.Distance = r.Location.Distance(addressLatLng(a.Address))
+				a.Diff = CompareAddresses(r.Address, a.Address)
+			}
+		}
+		return r, nil
+	}
+	r.Agreeing = best
+	if ctx.Err() != nil {
+		return r, ctx.Err()
+	}
+	return r, &DisagreementError{Quorum: g.quorum(), Result: r}
+}
+
+func (a *ConsensusAnswer) answered() bool {
+	return a.Err == nil && a.Address != nil
+}
+
+func addressLatLng(a *Address) LatLng {
+	return LatLng{a.Lat, a.Lng}
+}
+
+var _ Geocoder = (*ConsensusGeocoder)(nil)
@@ -0,0 +1,68 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fixedGeocoder answers every request with an address called name at ll.
+func fixedGeocoder(name string, ll LatLng) funcGeocoder {
+	return func(ctx context.Context, q string) (*Address, error) {
+		return &Address{Address: name, Lat: ll.Lat, Lng: ll.Lng}, nil
+	}
+}
+
+func TestConsensusGeocoder(t *testing.T) {
+	ctx := context.Background()
+	here := LatLng{40.7453, -74.0078}
+	down := errors.New("down")
+	g := &ConsensusGeocoder{
+		Providers: []ConsensusProvider{
+			{"far", fixedGeocoder("far", LatLng{40.8, -74.0078})},
+			{"a", fixedGeocoder("a", here)},
+			{"b", fixedGeocoder("b", here.destination(90, 20))},
+			{"failed", slowGeocoder("", 0, down)},
+		},
+		Tolerance: 50 * Meter,
+		Quorum:    2,
+	}
+	r, err := g.Consensus(ctx, "555 W 18th St")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Address.Address != "a" || r.Agreeing != 2 {
+		t.Errorf("Expected: a with 2 agreeing, Got: %s with %d", r.Address.Address, r.Agreeing)
+	}
+	if d := r.Location.Distance(here); d < 9*Meter || d > 11*Meter {
+		t.Errorf("Expected: the centroid 10m from a, Got: %v", d)
+	}
+	far, failed := r.Answers[0], r.Answers[3]
+	if far.Agrees || far.Distance < 5*Kilometer || !r.Answers[1].Agrees || !r.Answers[2].Agrees {
+		t.Errorf("Expected: far to disagree by kilometers, Got: %+v", far)
+	}
+	if failed.Provider != "failed" || failed.Err != down || failed.Agrees {
+		t.Errorf("Expected: the failure recorded, Got: %+v", failed)
+	}
+
+	g.Quorum = 0
+	_, err = g.ReverseGeocode(ctx, here)
+	var de *DisagreementError
+	if !errors.As(err, &de) || de.Quorum != 3 || de.Result.Agreeing != 2 || len(de.Result.Answers) != 4 {
+		t.Errorf("Expected: a disagreement short of a majority of 3, Got: %v", err)
+	}
+}
+
+func TestConsensusGeocoderTwoProviders(t *testing.T) {
+	g := &ConsensusGeocoder{
+		Providers: []ConsensusProvider{{"a", fixedGeocoder("a", LatLng{1, 1})}, {"b", fixedGeocoder("b", LatLng{1, 1.01})}},
+		Tolerance: 100 * Meter,
+	}
+	if _, err := g.Geocode(context.Background(), "q"); err == nil {
+		t.Error("Expected: no consensus when the only two providers disagree")
+	}
+	g.Tolerance = 2 * Kilometer
+	if a, err := g.Geocode(context.Background(), "q"); err != nil || a.Address != "a" {
+		t.Errorf("Expected: a, Got: %v, %v", a, err)
+	}
+}
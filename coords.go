@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"strings"
+	"time"
+)
+
+// WithCoordinateQueries makes the Client's Geocode recognise queries that
+// are already coordinates (see TryParseCoordinates) and answer them
+// directly instead of calling the API.
+func WithCoordinateQueries() ClientOption {
+	return func(c *Client) {
+		c.ParseCoordinates = true
+	}
+}
+
+// TryParseCoordinates reports whether s is a coordinate pair rather than an
+// address, and if so returns it. It accepts the forms ParseDMS does, such as
+// "45.5,-73.6", "45.5 N 73.6 W", "(45.5;-73.6)" and DMS, but plain numbers
+// must be written as decimals or separated by a comma or semicolon so that
+// things like "10 20" aren't mistaken for coordinates.
+func TryParseCoordinates(s string) (LatLng, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return LatLng{}, false
+	}
+	tokens, err := tokenizeCoordinates(s)
+	if err != nil {
+		return LatLng{}, false
+	}
+	numbers, marked := 0, false
+	for _, t := range tokens {
+		switch t.kind {
+		case 'n':
+			numbers++
+		case 'u', 'h', 's':
+			marked = true
+		}
+	}
+	if !marked && (numbers != 2 || !strings.Contains(s, ".")) {
+		return LatLng{}, false
+	}
+	ll, err := ParseDMS(s)
+	if err != nil {
+		return LatLng{}, false
+	}
+	return ll, true
+}
+
+// coordinateAddress answers q locally if the Client parses coordinate
+// queries and q is one. The address has a single rooftop result at the
+// coordinates, shaped like a provider's answer.
+func (c *Client) coordinateAddress(q string) (*Address, bool) {
+	if !c.ParseCoordinates {
+		return nil, false
+	}
+	ll, ok := TryParseCoordinates(q)
+	if !ok {
+		return nil, false
+	}
+	q = strings.TrimSpace(q)
+	r := Result{FormattedAddress: q, Geometry: GeometryData{Location: ll, LocationType: LocationTypeRooftop}}
+	r.scorePrecision(PrecisionRooftop)
+	a := &Address{Lat: ll.Lat, Lng: ll.Lng, Address: q, Response: &Response{Status: StatusOk, Results: []Result{r}}}
+	a.stamp(time.Now(), c.Validity)
+	return a, true
+}
@@ -0,0 +1,60 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTryParseCoordinates(t *testing.T) {
+	tests := []struct {
+		Input string
+		OK    bool
+		Lat   float64
+		Lng   float64
+	}{
+		{"45.5,-73.6", true, 45.5, -73.6},
+		{" 45.5 , -73.6 ", true, 45.5, -73.6},
+		{"45.5 N 73.6 W", true, 45.5, -73.6},
+		{"(45.5;-73.6)", true, 45.5, -73.6},
+		{"45.5 -73.6", true, 45.5, -73.6},
+		{"45°30′N 73°36′W", true, 45.5, -73.6},
+		{"45, -73", true, 45, -73},
+		{"10 20", false, 0, 0},
+		{"555 w 18th st, ny, ny", false, 0, 0},
+		{"91.5, 10.2", false, 0, 0},
+		{"", false, 0, 0},
+	}
+	for _, test := range tests {
+		ll, ok := TryParseCoordinates(test.Input)
+		if ok != test.OK {
+			t.Errorf("%q Expected: %v, Got: %v", test.Input, test.OK, ok)
+			continue
+		}
+		if ok && (ll.Lat-test.Lat > 1e-9 || test.Lat-ll.Lat > 1e-9 || ll.Lng-test.Lng > 1e-9 || test.Lng-ll.Lng > 1e-9) {
+			t.Errorf("%q Expected: %f,%f, Got: %v", test.Input, test.Lat, test.Lng, ll)
+		}
+	}
+}
+
+func TestGeocodeParsesCoordinates(t *testing.T) {
+	c := NewClient("", WithBaseURL("http://127.0.0.1:0"), WithCoordinateQueries(), WithValidity(time.Hour))
+	addy, err := c.Geocode(context.Background(), "40.7453721,-74.0078293")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addy.Lat != 40.7453721 || addy.Lng != -74.0078293 || addy.FetchedAt.IsZero() || addy.ValidUntil.Sub(addy.FetchedAt) != time.Hour {
+		t.Errorf("Expected coordinates without a lookup, Got: %#v", addy)
+	}
+	if len(addy.Response.Results) != 1 {
+		t.Fatalf("Expected: 1 result, Got: %d", len(addy.Response.Results))
+	}
+	r := addy.Response.Results[0]
+	if r.Geometry.Location != (LatLng{40.7453721, -74.0078293}) || r.Geometry.LocationType != LocationTypeRooftop || addy.Precision() != PrecisionRooftop || r.Confidence != 1 {
+		t.Errorf("Unexpected result: %+v", r)
+	}
+
+	if _, err := NewClient("", WithBaseURL("http://127.0.0.1:0")).Geocode(context.Background(), "40.7453721,-74.0078293"); err == nil {
+		t.Errorf("Expected: a lookup without WithCoordinateQueries, Got: %v", err)
+	}
+}
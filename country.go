@@ -0,0 +1,321 @@
+package geo
+
+import "sync"
+
+type countryShape struct {
+	code   string
+	rings  []Polygon
+	bounds BoundingBox
+}
+
+var (
+	countryIndex     *RTree
+	countryIndexOnce sync.Once
+)
+
+// Bounds returns the bounding box of the shape's outer ring.
+func (c *countryShape) Bounds() BoundingBox {
+	return c.bounds
+}
+
+// Contains applies the even-odd rule across all of the shape's rings, so
+// that holes (e.g. Lesotho inside South Africa) are excluded.
+func (c *countryShape) Contains(ll LatLng) bool {
+	inside := false
+	for _, r := range c.rings {
+		if r.Contains(ll) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func loadCountryIndex() {
+	countryIndex = NewRTree()
+	for _, cp := range countryPolygons {
+		s := &countryShape{code: cp.code}
+		for _, flat := range cp.rings {
+			ring := make(Polygon, len(flat)/2)
+			for i := range ring {
+				ring[i] = LatLng{Lat: float64(flat[2*i+1]) / 100, Lng: float64(flat[2*i]) / 100}
+			}
+			s.rings = append(s.rings, ring)
+		}
+		s.bounds = s.rings[0].Bounds()
+		countryIndex.Insert(s, cp.code)
+	}
+}
+
+// OfflineReverseCountry returns the ISO 3166-1 alpha-2 code of the country
+// containing ll, using an embedded copy of the Natural Earth 1:110m
+// boundaries. No network request is made. The boundaries are coarse (off by
+// tens of kilometers near borders and coastlines, and very small countries
+// are missing), so treat the result as a pre-filter or a fallback rather than
+// an authoritative answer there. It returns false for points at sea.
+func OfflineReverseCountry(ll LatLng) (string, bool) {
+	countryIndexOnce.Do(loadCountryIndex)
+	if matches := countryIndex.Containing(ll); len(matches) > 0 {
+		return matches[0].Value.(string), true
+	}
+	return "", false
+}
+
+// OfflineTimezone returns the IANA time zone ID at ll, such as
+// "Europe/Paris", from the country OfflineReverseCountry finds there, with
+// no network request. Within countries that span several zones it picks
+// the zone of the nearest of a few reference points, so near a zone
+// boundary inside such a country the answer can be a neighbouring zone; use
+// Client.Timezone where that matters. It returns false at sea and in
+// Antarctica.
+func OfflineTimezone(ll LatLng) (string, bool) {
+	cc, ok := OfflineReverseCountry(ll)
+	if !ok {
+		return "", false
+	}
+	if zone, ok := countryTimezones[cc]; ok {
+		return zone, true
+	}
+	points := timezonePoints[cc]
+	if len(points) == 0 {
+		return "", false
+	}
+	best := points[0]
+	for _, p := range points[1:] {
+		if ll.DistanceTo(p.LatLng) < ll.DistanceTo(best.LatLng) {
+			best = p
+		}
+	}
+	return best.zone, true
+}
+
+// zonePoint is a point inside a time zone of a country with several.
+type zonePoint struct {
+	zone string
+	LatLng
+}
+
+// countryTimezones holds the zone of each country with only one.
+var countryTimezones = map[string]string{
+	"AE": "Asia/Dubai", "AF": "Asia/Kabul", "AL": "Europe/Tirane", "AM": "Asia/Yerevan",
+	"AO": "Africa/Luanda", "AR": "America/Argentina/Buenos_Aires", "AT": "Europe/Vienna", "AZ": "Asia/Baku",
+	"BA": "Europe/Sarajevo", "BD": "Asia/Dhaka", "BE": "Europe/Brussels", "BF": "Africa/Ouagadougou",
+	"BG": "Europe/Sofia", "BI": "Africa/Bujumbura", "BJ": "Africa/Porto-Novo", "BN": "Asia/Brunei",
+	"BO": "America/La_Paz", "BS": "America/Nassau", "BT": "Asia/Thimphu", "BW": "Africa/Gaborone",
+	"BY": "Europe/Minsk", "BZ": "America/Belize", "CF": "Africa/Bangui", "CG": "Africa/Brazzaville",
+	"CH": "Europe/Zurich", "CI": "Africa/Abidjan", "CM": "Africa/Douala", "CN": "Asia/Shanghai",
+	"CO": "America/Bogota", "CR": "America/Costa_Rica", "CU": "America/Havana", "CY": "Asia/Nicosia",
+	"CZ": "Europe/Prague", "DE": "Europe/Berlin", "DJ": "Africa/Djibouti", "DK": "Europe/Copenhagen",
+	"DO": "America/Santo_Domingo", "DZ": "Africa/Algiers", "EC": "America/Guayaquil", "EE": "Europe/Tallinn", "EG": "Africa/Cairo",
+	"EH": "Africa/El_Aaiun", "ER": "Africa/Asmara", "ES": "Europe/Madrid", "ET": "Africa/Addis_Ababa", "FI": "Europe/Helsinki",
+	"FJ": "Pacific/Fiji", "FK": "Atlantic/Stanley", "FR": "Europe/Paris", "GA": "Africa/Libreville",
+	"GB": "Europe/London", "GE": "Asia/Tbilisi", "GH": "Africa/Accra", "GM": "Africa/Banjul",
+	"GN": "Africa/Conakry", "GQ": "Africa/Malabo", "GR": "Europe/Athens", "GT": "America/Guatemala",
+	"GW": "Africa/Bissau", "GY": "America/Guyana", "HN": "America/Tegucigalpa", "HR": "Europe/Zagreb",
+	"HT": "America/Port-au-Prince", "HU": "Europe/Budapest", "IE": "Europe/Dublin", "IL": "Asia/Jerusalem",
+	"IN": "Asia/Kolkata", "IQ": "Asia/Baghdad", "IR": "Asia/Tehran", "IS": "Atlantic/Reykjavik",
+	"IT": "Europe/Rome", "JM": "America/Jamaica", "JO": "Asia/Amman", "JP": "Asia/Tokyo",
+	"KE": "Africa/Nairobi", "KG": "Asia/Bishkek", "KH": "Asia/Phnom_Penh", "KP": "Asia/Pyongyang",
+	"KR": "Asia/Seoul", "KW": "Asia/Kuwait", "LA": "Asia/Vientiane", "LB": "Asia/Beirut",
+	"LK": "Asia/Colombo", "LR": "Africa/Monrovia", "LS": "Africa/Maseru", "LT": "Europe/Vilnius",
+	"LU": "Europe/Luxembourg", "LV": "Europe/Riga", "LY": "Africa/Tripoli", "MA": "Africa/Casablanca",
+	"MD": "Europe/Chisinau", "ME": "Europe/Podgorica", "MG": "Indian/Antananarivo", "MK": "Europe/Skopje",
+	"ML": "Africa/Bamako", "MM": "Asia/Yangon", "MR": "Africa/Nouakchott", "MW": "Africa/Blantyre",
+	"MY": "Asia/Kuala_Lumpur", "MZ": "Africa/Maputo", "NA": "Africa/Windhoek", "NC": "Pacific/Noumea",
+	"NE": "Africa/Niamey", "NG": "Africa/Lagos", "NI": "America/Managua", "NL": "Europe/Amsterdam",
+	"NO": "Europe/Oslo", "NP": "Asia/Kathmandu", "NZ": "Pacific/Auckland", "OM": "Asia/Muscat",
+	"PA": "America/Panama", "PE": "America/Lima", "PH": "Asia/Manila", "PK": "Asia/Karachi",
+	"PL": "Europe/Warsaw", "PR": "America/Puerto_Rico", "PS": "Asia/Hebron", "PT": "Europe/Lisbon", "PY": "America/Asuncion",
+	"QA": "Asia/Qatar", "RO": "Europe/Bucharest", "RS": "Europe/Belgrade", "RW": "Africa/Kigali",
+	"SA": "Asia/Riyadh", "SB": "Pacific/Guadalcanal", "SD": "Africa/Khartoum", "SE": "Europe/Stockholm",
+	"SI": "Europe/Ljubljana", "SK": "Europe/Bratislava", "SL": "Africa/Freetown", "SN": "Africa/Dakar",
+	"SO": "Africa/Mogadishu", "SR": "America/Paramaribo", "SS": "Africa/Juba", "SV": "America/El_Salvador",
+	"SY": "Asia/Damascus", "SZ": "Africa/Mbabane", "TD": "Africa/Ndjamena", "TF": "Indian/Kerguelen",
+	"TG": "Africa/Lome", "TH": "Asia/Bangkok", "TJ": "Asia/Dushanbe", "TL": "Asia/Dili",
+	"TM": "Asia/Ashgabat", "TN": "Africa/Tunis", "TR": "Europe/Istanbul", "TT": "America/Port_of_Spain",
+	"TW": "Asia/Taipei", "TZ": "Africa/Dar_es_Salaam", "UA": "Europe/Kyiv", "UG": "Africa/Kampala",
+	"UY": "America/Montevideo", "UZ": "Asia/Tashkent", "VE": "America/Caracas", "VN": "Asia/Ho_Chi_Minh",
+	"VU": "Pacific/Efate", "XK": "Europe/Belgrade", "YE": "Asia/Aden", "ZA": "Africa/Johannesburg",
+	"ZM": "Africa/Lusaka", "ZW": "Africa/Harare",
+}
+
+// timezonePoints holds reference points for countries with several zones,
+// usually their larger cities, with more where a zone is large or oddly
+// shaped.
+var timezonePoints = map[string][]zonePoint{
+	"AU": {
+		{"Australia/Sydney", LatLng{-33.87, 151.21}},
+		{"Australia/Melbourne", LatLng{-37.81, 144.96}},
+		{"Australia/Hobart", LatLng{-42.88, 147.33}},
+		{"Australia/Brisbane", LatLng{-27.47, 153.03}},
+		{"Australia/Brisbane", LatLng{-19.26, 146.82}},
+		{"Australia/Brisbane", LatLng{-23.7, 142.0}},
+		{"Australia/Adelaide", LatLng{-34.93, 138.6}},
+		{"Australia/Adelaide", LatLng{-29.0, 134.75}},
+		{"Australia/Darwin", LatLng{-12.46, 130.84}},
+		{"Australia/Darwin", LatLng{-23.7, 133.88}},
+		{"Australia/Perth", LatLng{-31.95, 115.86}},
+		{"Australia/Perth", LatLng{-25.0, 122.0}},
+		{"Australia/Perth", LatLng{-18.0, 125.0}},
+	},
+	"BR": {
+		{"America/Sao_Paulo", LatLng{-23.55, -46.63}},
+		{"America/Sao_Paulo", LatLng{-15.79, -47.88}},
+		{"America/Sao_Paulo", LatLng{-30.03, -51.23}},
+		{"America/Bahia", LatLng{-12.97, -38.5}},
+		{"America/Recife", LatLng{-8.1, -35.5}},
+		{"America/Fortaleza", LatLng{-3.72, -38.54}},
+		{"America/Belem", LatLng{-1.46, -48.49}},
+		{"America/Manaus", LatLng{-3.12, -60.02}},
+		{"America/Cuiaba", LatLng{-15.6, -56.1}},
+		{"America/Campo_Grande", LatLng{-20.44, -54.65}},
+		{"America/Porto_Velho", LatLng{-8.76, -63.9}},
+		{"America/Boa_Vista", LatLng{2.82, -60.67}},
+		{"America/Rio_Branco", LatLng{-9.97, -67.81}},
+	},
+	"CA": {
+		{"America/St_Johns", LatLng{47.56, -52.71}},
+		{"America/Halifax", LatLng{44.65, -63.58}},
+		{"America/Moncton", LatLng{46.09, -64.78}},
+		{"America/Toronto", LatLng{43.65, -79.38}},
+		{"America/Toronto", LatLng{45.5, -73.57}},
+		{"America/Toronto", LatLng{46.81, -71.21}},
+		{"America/Toronto", LatLng{48.38, -89.25}},
+		{"America/Iqaluit", LatLng{63.75, -68.52}},
+		{"America/Winnipeg", LatLng{49.9, -97.14}},
+		{"America/Winnipeg", LatLng{58.77, -94.17}},
+		{"America/Regina", LatLng{50.45, -104.61}},
+		{"America/Regina", LatLng{52.13, -106.67}},
+		{"America/Edmonton", LatLng{53.55, -113.49}},
+		{"America/Edmonton", LatLng{51.05, -114.07}},
+		{"America/Yellowknife", LatLng{62.45, -114.37}},
+		{"America/Vancouver", LatLng{49.28, -123.12}},
+		{"America/Vancouver", LatLng{53.92, -122.75}},
+		{"America/Whitehorse", LatLng{60.72, -135.06}},
+	},
+	"CD": {
+		{"Africa/Kinshasa", LatLng{-4.44, 15.27}},
+		{"Africa/Kinshasa", LatLng{0.05, 18.26}},
+		{"Africa/Lubumbashi", LatLng{-11.66, 27.48}},
+		{"Africa/Lubumbashi", LatLng{0.52, 25.19}},
+		{"Africa/Lubumbashi", LatLng{-2.5, 28.86}},
+	},
+	"CL": {
+		{"America/Santiago", LatLng{-33.45, -70.67}},
+		{"America/Santiago", LatLng{-23.65, -70.4}},
+		{"America/Santiago", LatLng{-41.47, -72.94}},
+		{"America/Punta_Arenas", LatLng{-53.16, -70.91}},
+	},
+	"GL": {
+		{"America/Nuuk", LatLng{64.18, -51.72}},
+		{"America/Nuuk", LatLng{72.0, -45.0}},
+		{"America/Thule", LatLng{76.53, -68.7}},
+	},
+	"ID": {
+		{"Asia/Jakarta", LatLng{-6.21, 106.85}},
+		{"Asia/Jakarta", LatLng{3.6, 98.67}},
+		{"Asia/Jakarta", LatLng{-7.25, 112.75}},
+		{"Asia/Pontianak", LatLng{-0.03, 109.33}},
+		{"Asia/Pontianak", LatLng{-2.21, 113.92}},
+		{"Asia/Makassar", LatLng{-3.0, 120.0}},
+		{"Asia/Makassar", LatLng{1.47, 124.84}},
+		{"Asia/Makassar", LatLng{1.0, 116.5}},
+		{"Asia/Jayapura", LatLng{-4.0, 138.5}},
+		{"Asia/Jayapura", LatLng{-0.86, 131.25}},
+	},
+	"KZ": {
+		{"Asia/Almaty", LatLng{43.24, 76.95}},
+		{"Asia/Almaty", LatLng{51.17, 71.45}},
+		{"Asia/Almaty", LatLng{49.95, 82.62}},
+		{"Asia/Qostanay", LatLng{53.21, 63.62}},
+		{"Asia/Qyzylorda", LatLng{44.85, 65.51}},
+		{"Asia/Aqtobe", LatLng{50.28, 57.17}},
+		{"Asia/Atyrau", LatLng{47.12, 51.88}},
+		{"Asia/Aqtau", LatLng{43.65, 51.2}},
+		{"Asia/Oral", LatLng{51.23, 51.37}},
+	},
+	"MN": {
+		{"Asia/Ulaanbaatar", LatLng{47.92, 106.92}},
+		{"Asia/Ulaanbaatar", LatLng{48.0, 114.5}},
+		{"Asia/Hovd", LatLng{48.01, 91.64}},
+	},
+	"MX": {
+		{"America/Mexico_City", LatLng{19.43, -99.13}},
+		{"America/Mexico_City", LatLng{20.67, -103.35}},
+		{"America/Merida", LatLng{20.97, -89.62}},
+		{"America/Cancun", LatLng{21.16, -86.85}},
+		{"America/Monterrey", LatLng{25.69, -100.32}},
+		{"America/Chihuahua", LatLng{28.63, -106.09}},
+		{"America/Ciudad_Juarez", LatLng{31.2, -106.4}},
+		{"America/Mazatlan", LatLng{23.25, -106.41}},
+		{"America/Mazatlan", LatLng{24.14, -110.31}},
+		{"America/Hermosillo", LatLng{29.07, -110.96}},
+		{"America/Tijuana", LatLng{32.51, -117.04}},
+		{"America/Tijuana", LatLng{30.0, -115.0}},
+	},
+	"PG": {
+		{"Pacific/Port_Moresby", LatLng{-9.44, 147.18}},
+		{"Pacific/Port_Moresby", LatLng{-5.5, 143.0}},
+		{"Pacific/Bougainville", LatLng{-6.23, 155.56}},
+	},
+	"RU": {
+		{"Europe/Kaliningrad", LatLng{54.71, 20.45}},
+		{"Europe/Moscow", LatLng{55.76, 37.62}},
+		{"Europe/Moscow", LatLng{59.94, 30.31}},
+		{"Europe/Moscow", LatLng{64.54, 40.54}},
+		{"Europe/Moscow", LatLng{45.04, 38.98}},
+		{"Europe/Samara", LatLng{53.2, 50.15}},
+		{"Asia/Yekaterinburg", LatLng{56.84, 60.61}},
+		{"Asia/Yekaterinburg", LatLng{61.0, 69.0}},
+		{"Asia/Omsk", LatLng{54.99, 73.37}},
+		{"Asia/Novosibirsk", LatLng{55.03, 82.92}},
+		{"Asia/Krasnoyarsk", LatLng{56.01, 92.87}},
+		{"Asia/Krasnoyarsk", LatLng{69.35, 88.2}},
+		{"Asia/Irkutsk", LatLng{52.29, 104.28}},
+		{"Asia/Yakutsk", LatLng{62.03, 129.73}},
+		{"Asia/Yakutsk", LatLng{52.03, 113.5}},
+		{"Asia/Vladivostok", LatLng{43.12, 131.89}},
+		{"Asia/Vladivostok", LatLng{48.48, 135.08}},
+		{"Asia/Sakhalin", LatLng{46.96, 142.73}},
+		{"Asia/Magadan", LatLng{59.56, 150.8}},
+		{"Asia/Srednekolymsk", LatLng{67.0, 154.0}},
+		{"Asia/Kamchatka", LatLng{53.02, 158.65}},
+		{"Asia/Anadyr", LatLng{64.73, 177.5}},
+	},
+	"US": {
+		{"America/New_York", LatLng{40.71, -74.01}},
+		{"America/New_York", LatLng{42.36, -71.06}},
+		{"America/New_York", LatLng{38.91, -77.04}},
+		{"America/New_York", LatLng{33.75, -84.39}},
+		{"America/New_York", LatLng{25.76, -80.19}},
+		{"America/New_York", LatLng{39.96, -83.0}},
+		{"America/Detroit", LatLng{42.33, -83.05}},
+		{"America/Indiana/Indianapolis", LatLng{39.77, -86.16}},
+		{"America/Chicago", LatLng{41.88, -87.63}},
+		{"America/Chicago", LatLng{29.76, -95.37}},
+		{"America/Chicago", LatLng{32.78, -96.8}},
+		{"America/Chicago", LatLng{44.98, -93.27}},
+		{"America/Chicago", LatLng{39.1, -94.58}},
+		{"America/Chicago", LatLng{36.16, -86.78}},
+		{"America/Chicago", LatLng{29.95, -90.07}},
+		{"America/Chicago", LatLng{46.81, -100.78}},
+		{"America/Denver", LatLng{39.74, -104.99}},
+		{"America/Denver", LatLng{35.08, -106.65}},
+		{"America/Denver", LatLng{40.76, -111.89}},
+		{"America/Denver", LatLng{46.59, -112.04}},
+		{"America/Denver", LatLng{31.76, -106.49}},
+		{"America/Boise", LatLng{43.62, -116.2}},
+		{"America/Phoenix", LatLng{33.45, -112.07}},
+		{"America/Phoenix", LatLng{35.2, -111.65}},
+		{"America/Los_Angeles", LatLng{34.05, -118.24}},
+		{"America/Los_Angeles", LatLng{37.77, -122.42}},
+		{"America/Los_Angeles", LatLng{45.52, -122.68}},
+		{"America/Los_Angeles", LatLng{47.61, -122.33}},
+		{"America/Los_Angeles", LatLng{36.17, -115.14}},
+		{"America/Los_Angeles", LatLng{39.53, -119.81}},
+		{"America/Anchorage", LatLng{61.22, -149.9}},
+		{"America/Anchorage", LatLng{64.84, -147.72}},
+		{"America/Anchorage", LatLng{67.0, -155.0}},
+		{"America/Juneau", LatLng{58.3, -134.42}},
+		{"Pacific/Honolulu", LatLng{21.31, -157.86}},
+	},
+}
@@ -0,0 +1,874 @@
+// Code generated from the Natural Earth 1:110m admin-0 countries dataset
+// (public domain). DO NOT EDIT.
+
+package geo
+
+// countryPolygons holds one entry per polygon, each ring a flat list of
+// longitude, latitude pairs in hundredths of a degree. Later rings are holes.
+var countryPolygons = []struct {
+	code  string
+	rings [][]int16
+}{
+	{"AE", [][]int16{
+		{5158, 2425, 5176, 2429, 5179, 2402, 5258, 2418, 5340, 2415, 5401, 2412, 5469, 2480, 5544, 2544, 5607, 2606, 5626, 2571, 5640, 2492, 5589, 2492, 5580, 2427, 5598, 2413, 5553, 2393, 5553, 2352, 5523, 2311, 5521, 2271, 5501, 2250, 5200, 2300, 5162, 2401},
+	}},
+	{"AF", [][]int16{
+		{6652, 3736, 6708, 3736, 6783, 3714, 6814, 3702, 6886, 3734, 6920, 3715, 6952, 3761, 7012, 3759, 7027, 3774, 7038, 3814, 7081, 3849, 7135, 3826, 7124, 3795, 7154, 3791, 7145, 3707, 7184, 3674, 7219, 3695, 7264, 3705, 7326, 3750, 7395, 3742, 7498, 3742, 7516, 3713, 7458, 3702, 7407, 3684, 7292, 3672, 7185, 3651, 7126, 3607, 7150, 3565, 7161, 3515, 7112, 3473, 7116, 3435, 7088, 3399, 6993, 3402, 7032, 3336, 6969, 3311, 6926, 3250, 6932, 3190, 6893, 3162, 6856, 3171, 6779, 3158, 6768, 3130, 6694, 3130, 6638, 3074, 6635, 2989, 6505, 2947, 6435, 2956, 6415, 2934, 6355, 2947, 6255, 2932, 6087, 2983, 6178, 3074, 6170, 3138, 6094, 3155, 6086, 3218, 6054, 3298, 6096, 3353, 6053, 3368, 6080, 3440, 6121, 3565, 6223, 3527, 6298, 3540, 6319, 3586, 6398, 3601, 6455, 3631, 6475, 3711, 6559, 3731, 6575, 3766, 6622, 3739},
+	}},
+	{"AL", [][]int16{
+		{2102, 4084, 2100, 4058, 2067, 4044, 2062, 4011, 2015, 3962, 1998, 3969, 1996, 3992, 1941, 4025, 1932, 4073, 1940, 4141, 1954, 4172, 1937, 4188, 1930, 4220, 1974, 4269, 1980, 4250, 2007, 4259, 2028, 4232, 2052, 4222, 2059, 4186, 2046, 4152, 2061, 4109},
+	}},
+	{"AM", [][]int16{
+		{4651, 3877, 4614, 3874, 4574, 3932, 4574, 3947, 4530, 3947, 4500, 3974, 4479, 3971, 4440, 4001, 4366, 4025, 4375, 4074, 4358, 4109, 4497, 4125, 4518, 4099, 4556, 4081, 4536, 4056, 4589, 4022, 4561, 3990, 4603, 3963, 4648, 3946},
+	}},
+	{"AO", [][]int16{
+		{1300, -478, 1263, -499, 1247, -525, 1244, -568, 1218, -579, 1191, -504, 1232, -461, 1262, -444},
+	}},
+	{"AO", [][]int16{
+		{1232, -610, 1274, -597, 1302, -598, 1338, -586, 1633, -588, 1657, -662, 1686, -722, 1709, -755, 1747, -807, 1813, -799, 1846, -785, 1902, -799, 1917, -774, 1942, -716, 2004, -712, 2009, -694, 2060, -694, 2051, -730, 2173, -729, 2175, -792, 2195, -831, 2180, -891, 2188, -952, 2221, -989, 2216, -1108, 2240, -1099, 2284, -1102, 2346, -1087, 2391, -1093, 2402, -1124, 2390, -1172, 2408, -1219, 2393, -1257, 2402, -1291, 2193, -1290, 2189, -1608, 2256, -1690, 2322, -1752, 2138, -1793, 1896, -1779, 1826, -1731, 1421, -1735, 1406, -1742, 1346, -1697, 1281, -1694, 1222, -1711, 1173, -1730, 1164, -1667, 1178, -1579, 1212, -1488, 1218, -1445, 1250, -1355, 1274, -1314, 1331, -1248, 1363, -1204, 1374, -1130, 1369, -1073, 1339, -1037, 1312, -977, 1288, -917, 1293, -896, 1324, -856, 1293, -760, 1273, -693, 1223, -629},
+	}},
+	{"AQ", [][]int16{
+		{-4866, -7805, -4815, -7805, -4666, -7783, -4515, -7805, -4392, -7848, -4349, -7909, -4337, -7952, -4333, -8003, -4488, -8034, -4651, -8059, -4839, -8083, -5048, -8103, -5285, -8097, -5416, -8063, -5399, -8022, -5185, -7995, -5099, -7961, -5036, -7918, -4991, -7881, -4931, -7846},
+	}},
+	{"AQ", [][]int16{
+		{-6629, -8026, -6404, -8029, -6188, -8039, -6114, -7998, -6061, -7963, -5957, -8004, -5987, -8055, -6016, -8100, -6226, -8086, -6449, -8092, -6574, -8059, -6574, -8055},
+	}},
+	{"AQ", [][]int16{
+		{-7392, -7127, -7323, -7115, -7207, -7119, -7178, -7068, -7172, -7031, -7174, -6951, -7117, -6904, -7025, -6888, -6972, -6925, -6949, -6962, -6906, -7007, -6873, -7051, -6845, -7096, -6833, -7141, -6851, -7180, -6878, -7217, -6996, -7231, -7108, -7250, -7239, -7248, -7190, -7209, -7307, -7223, -7419, -7237, -7495, -7207, -7501, -7166},
+	}},
+	{"AQ", [][]int16{
+		{-10233, -7189, -10170, -7172, -10043, -7185, -9898, -7193, -9788, -7207, -9679, -7195, -9620, -7252, -9698, -7244, -9820, -7248, -9943, -7244, -10078, -7250, -10180, -7231},
+	}},
+	{"AQ", [][]int16{
+		{-12262, -7366, -12241, -7332, -12121, -7350, -11992, -7366, -11872, -7348, -11929, -7383, -12023, -7409, -12162, -7401},
+	}},
+	{"AQ", [][]int16{
+		{-12728, -7346, -12656, -7325, -12556, -7348, -12403, -7387, -12462, -7383, -12591, -7374},
+	}},
+	{"AQ", [][]int16{
+		{-16371, -7860, -16311, -7822, -16125, -7838, -16025, -7869, -15948, -7905, -15921, -7950, -16113, -7963, -16244, -7928, -16303, -7893, -16307, -7887},
+	}},
+	{"AQ", [][]int16{
+		{18000, -8471, 18000, -9000, -18000, -9000, -18000, -8471, -17994, -8472, -17906, -8414, -17726, -8445, -17714, -8442, -17608, -8410, -17595, -8411, -17583, -8412, -17438, -8453, -17312, -8412, -17289, -8406, -16995, -8388, -16900, -8412, -16853, -8424, -16702, -8457, -16418, -8483, -16193, -8514, -15807, -8537, -15519, -8510, -15094, -8530, -14853, -8561, -14589, -8532, -14311, -8504, -14289, -8457, -14683, -8453, -15006, -8430, -15090, -8390, -15359, -8369, -15341, -8324, -15304, -8283, -15267, -8245, -15286, -8204, -15453, -8177, -15529, -8142, -15684, -8110, -15441, -8116, -15210, -8100, -15065, -8134, -14887, -8104, -14722, -8067, -14642, -8034, -14677, -7993, -14806, -7965, -14953, -7936, -15159, -7930, -15339, -7916, -15533, -7906, -15598, -7869, -15727, -7838, -15805, -7803, -15837, -7689, -15788, -7699, -15697, -7730, -15533, -7720, -15374, -7707, -15292, -7750, -15133, -7740, -15000, -7718, -14875, -7691, -14761, -7658, -14610, -7648, -14614, -7611, -14650, -7573, -14620, -7538, -14491, -7520, -14432, -7554, -14279, -7534, -14164, -7509, -14021, -7507, -13886, -7497, -13751, -7473, -13643, -7452, -13521, -7430, -13443, -7436, -13375, -7444, -13226, -7430, -13093, -7448, -12955, -7446, -12824, -7432, -12689, -7442, -12540, -7452, -12401, -7448, -12256, -7450, -12107, -7452, -11970, -7448, -11868, -7419, -11747, -7403, -11622, -7424, -11502, -7407, -11394, -7371, -11330, -7403, -11295, -7438, -11230, -7471, -11126, -7442, -11007, -7479, -10871, -7491, -10756, -7518, -10615, -7513, -10488, -7495, -10337, -7499, -10202, -7513, -10065, -7530, -10012, -7487, -10076, -7454, -10125, -7419, -10255, -7411, -10311, -7373, -10333, -7336, -10368, -7262, -10292, -7275, -10161, -7281, -10031, -7275, -9914, -7291, -9812, -7321, -9769, -7356, -9634, -7362, -9504, -7348, -9367, -7328, -9244, -7317, -9142, -7340, -9009, -7332, -8923, -7256, -8842, -7301, -8727, -7319, -8601, -7309, -8519, -7348, -8388, -7352, -8267, -7364, -8147, -7385, -8069, -7348, -8030, -7313, -7930, -7352, -7793, -7342, -7691, -7364, -7622, -7397, -7489, -7387, -7385, -7366, -7283, -7340, -7162, -7326, -7021, -7315, -6894, -7301, -6796, -7279, -6737, -7248, -6713, -7205, -6725, -7164, -6756, -7125, -6792, -7085, -6823, -7046, -6849, -7011, -6854, -6972, -6845, -6933, -6798, -6895, -6758, -6854, -6743, -6815, -6762, -6772, -6774, -6733, -6725, -6688, -6670, -6658, -6606, -6621, -6537, -6590, -6457, -6560, -6418, -6517, -6363, -6490, -6300, -6464, -6204, -6458, -6141, -6427, -6071, -6407, -5989, -6396, -5916, -6370, -5859, -6339, -5781, -6327, -5722, -6353, -5760, -6386, -5861, -6415, -5905, -6437, -5979, -6421, -6061, -6431, -6130, -6454, -6202, -6480, -6251, -6509, -6265, -6548, -6259, -6586, -6212, -6619, -6281, -6643, -6375, -6650, -6429, -6684, -6488, -6715, -6551, -6758, -6567, -6795, -6531, -6837, -6478, -6868, -6396, -6891, -6320, -6923, -6279, -6962, -6257, -6999, -6228, -7038, -6181, -7072, -6151, -7109, -6138, -7201, -6108, -7238, -6100, -7277, -6069, -7317, -6083, -7370, -6138, -7411, -6196, -7444, -6330, -7458, -6375, -7493, -6435, -7526, -6586, -7564, -6719, -7579, -6845, -7601, -6980, -7622, -7060, -7663, -7221, -7667, -7397, -7663, -7556, -7671, -7724, -7671, -7693, -7710, -7540, -7728, -7428, -7756, -7366, -7791, -7477, -7822, -7650, -7812, -7793, -7838, -7798, -7879, -7802, -7918, -7685, -7951, -7663, -7989, -7536, -8026, -7324, -8042, -7144, -8069, -7001, -8100, -6819, -8132, -6570, -8147, -6326, -8175, -6155, -8204, -5969, -8238, -5871, -8285, -5822, -8322, -5701, -8287, -5536, -8257, -5362, -8226, -5154, -8200, -4976, -8173, -4727, -8171, -4483, -8185, -4281, -8208, -4216, -8165, -4077, -8136, -3824, -8134, -3627, -8112, -3439, -8091, -3231, -8077, -3010, -8059, -2855, -8034, -2925, -7999, -2969, -7963, -2969, -7926, -3162, -7930, -3368, -7946, -3564, -7946, -3591, -7908, -3578, -7834, -3533, -7812, -3390, -7789, -3221, -7765, -3100, -7736, -2978, -7707, -2888, -7667, -2751, -7650, -2616, -7636, -2547, -7628, -2393, -7624, -2246, -7611, -2122, -7591, -2001, -7567, -1891, -7544, -1752, -7513, -1664, -7479, -1570, -7450, -1541, -7411, -1647, -7387, -1611, -7346, -1545, -7315, -1441, -7295, -1331, -7272, -1229, -7240, -1151, -7201, -1102, -7154, -1030, -7127, -910, -7132, -861, -7166, -742, -7170, -738, -7132, -687, -7093, -579, -7103, -554, -7140, -434, -7146, -305, -7129, -180, -7117, -66, -7123, -23, -7164, 87, -7130, 189, -7113, 302, -7099, 414, -7085, 516, -7062, 627, -7046, 714, -7025, 774, -6989, 849, -7015, 953, -7001, 1025, -7048, 1082, -7083, 1195, -7064, 1240, -7025, 1342, -6997, 1473, -7003, 1513, -7040, 1595, -7003, 1703, -6991, 1820, -6987, 1926, -6989, 2038, -7001, 2145, -7007, 2192, -7040, 2257, -7070, 2367, -7052, 2484, -7048, 2598, -7048, 2709, -7046, 2809, -7032, 2915, -7021, 3003, -6993, 3097, -6976, 3199, -6966, 3275, -6938, 3330, -6884, 3387, -6850, 3491, -6866, 3530, -6901, 3616, -6925, 3720, -6917, 3791, -6952, 3865, -6978, 3967, -6954, 4002, -6911, 4092, -6893, 4196, -6860, 4294, -6846, 4411, -6827, 4490, -6805, 4572, -6782, 4650, -6760, 4744, -6772, 4834, -6737, 4899, -6709, 4993, -6711, 5075, -6688, 5095, -6652, 5179, -6625, 5261, -6605, 5361, -6590, 5453, -6582, 5541, -6588, 5636, -6597, 5716, -6625, 5726, -6668, 5814, -6701, 5874, -6729, 5994, -6741, 6061, -6768, 6143, -6795, 6239, -6801, 6319, -6782, 6405, -6741, 6499, -6762, 6597, -6774, 6691, -6786, 6789, -6793, 6889, -6793, 6971, -6897, 6967, -6923, 6956, -6968, 6860, -6993, 6781, -7031, 6795, -7070, 6907, -7068, 6893, -7107, 6842, -7144, 6795, -7185, 6871, -7217, 6987, -7226, 7102, -7209, 7157, -7170, 7191, -7132, 7245, -7101, 7308, -7072, 7334, -7036, 7386, -6987, 7449, -6978, 7563, -6974, 7663, -6962, 7764, -6946, 7813, -6907, 7843, -6870, 7911, -6833, 8009, -6807, 8094, -6788, 8148, -6754, 8205, -6737, 8278, -6721, 8378, -6731, 8468, -6721, 8566, -6709, 8675, -6715, 8748, -6688, 8799, -6621, 8836, -6648, 8883, -6695, 8967, -6715, 9063, -6723, 9159, -6711, 9261, -6719, 9355, -6721, 9418, -6711, 9502, -6717, 9578, -6739, 9668, -6725, 9776, -6725, 9868, -6711, 9972, -6725, 10038, -6692, 10089, -6658, 10158, -6631, 10283, -6556, 10348, -6570, 10424, -6597, 10491, -6633, 10618, -6693, 10716, -6695, 10808, -6695, 10916, -6684, 11024, -6670, 11106, -6643, 11174, -6613, 11286, -6609, 11360, -6588, 11439, -6607, 11490, -6639, 11560, -6670, 11670, -6666, 11738, -6692, 11858, -6717, 11983, -6727, 12087, -6719, 12165, -6688, 12232, -6656, 12322, -6648, 12412, -6662, 12516, -6672, 12610, -6656, 12700, -6656, 12788, -6666, 12880, -6676, 12970, -6658, 13078, -6643, 13180, -6639, 13294, -6639, 13386, -6629, 13476, -6621, 13503, -6572, 13507, -6531, 13570, -6558, 13587, -6603, 13621, -6645, 13662, -6678, 13746, -6695, 13860, -6690, 13991, -6688, 14081, -6682, 14212, -6682, 14306, -6680, 14437, -6684, 14549, -6692, 14620, -6723, 14600, -6760, 14665, -6790, 14772, -6813, 14884, -6839, 15013, -6856, 15148, -6872, 15250, -6887, 15364, -6889, 15428, -6856, 15517, -6884, 15593, -6915, 15681, -6938, 15803, -6948, 15918, -6960, 15967, -6999, 16081, -7023, 16157, -7058, 16269, -7074, 16384, -7072, 16492, -7078, 16611, -7076, 16731, -7083, 16843, -7097, 16946, -7121, 17050, -7140, 17121, -7170, 17109, -7209, 17056, -7244, 17011, -7289, 16976, -7324, 16929, -7366, 16798, -7381, 16739, -7417, 16609, -7438, 16564, -7477, 16496, -7515, 16423, -7546, 16382, -7587, 16357, -7624, 16347, -7669, 16349, -7707, 16406, -7746, 16427, -7783, 16474, -7818, 16660, -7832, 16700, -7875, 16519, -7891, 16367, -7912, 16177, -7916, 16092, -7973, 16075, -8020, 16032, -8057, 15979, -8095, 16112, -8128, 16163, -8169, 16249, -8206, 16371, -8240, 16510, -8271, 16660, -8302, 16890, -8334, 16940, -8383, 17228, -8404, 17248, -8412, 17322, -8441, 17599, -8416, 17828, -8447},
+	}},
+	{"AR", [][]int16{
+		{-6863, -5264, -6825, -5310, -6775, -5385, -6645, -5445, -6505, -5470, -6550, -5520, -6645, -5525, -6696, -5490, -6756, -5487, -6863, -5487},
+	}},
+	{"AR", [][]int16{
+		{-5763, -3022, -5787, -3102, -5814, -3204, -5813, -3304, -5835, -3326, -5843, -3391, -5850, -3443, -5723, -3529, -5736, -3598, -5674, -3641, -5679, -3690, -5775, -3818, -5923, -3872, -6124, -3893, -6234, -3883, -6213, -3942, -6233, -4017, -6215, -4068, -6275, -4103, -6377, -4117, -6473, -4080, -6512, -4106, -6498, -4206, -6430, -4236, -6376, -4204, -6346, -4256, -6438, -4287, -6518, -4350, -6533, -4450, -6557, -4504, -6651, -4504, -6729, -4555, -6758, -4630, -6660, -4703, -6564, -4724, -6599, -4813, -6717, -4870, -6782, -4987, -6873, -5026, -6914, -5073, -6882, -5177, -6815, -5235, -6857, -5230, -6950, -5214, -7191, -5201, -7233, -5143, -7231, -5068, -7298, -5074, -7333, -5038, -7342, -4932, -7265, -4888, -7233, -4824, -7245, -4774, -7192, -4688, -7155, -4556, -7166, -4497, -7122, -4478, -7133, -4441, -7179, -4421, -7146, -4379, -7192, -4341, -7215, -4225, -7175, -4205, -7192, -4083, -7168, -3981, -7141, -3892, -7081, -3855, -7112, -3758, -7112, -3666, -7036, -3601, -7039, -3517, -6982, -3419, -6981, -3327, -7007, -3309, -7054, -3137, -6992, -3034, -7001, -2937, -6966, -2846, -6900, -2752, -6830, -2690, -6859, -2651, -6839, -2619, -6842, -2452, -6733, -2403, -6699, -2299, -6711, -2274, -6627, -2183, -6496, -2208, -6438, -2280, -6399, -2199, -6285, -2203, -6269, -2225, -6085, -2388, -6003, -2403, -5881, -2477, -5778, -2516, -5763, -2560, -5862, -2712, -5761, -2740, -5649, -2755, -5570, -2739, -5479, -2662, -5463, -2574, -5413, -2555, -5363, -2612, -5365, -2692, -5449, -2747, -5516, -2788, -5629, -2885},
+	}},
+	{"AT", [][]int16{
+		{1698, 4812, 1690, 4771, 1634, 4771, 1653, 4750, 1620, 4685, 1601, 4668, 1514, 4666, 1463, 4643, 1381, 4651, 1238, 4677, 1215, 4712, 1116, 4694, 1105, 4675, 1044, 4689, 993, 4692, 948, 4710, 963, 4735, 959, 4753, 990, 4758, 1040, 4730, 1054, 4757, 1143, 4752, 1214, 4770, 1262, 4767, 1293, 4747, 1303, 4764, 1288, 4829, 1324, 4842, 1360, 4888, 1434, 4856, 1490, 4896, 1525, 4904, 1603, 4873, 1650, 4879, 1696, 4860, 1688, 4847},
+	}},
+	{"AU", [][]int16{
+		{14769, -4081, 14829, -4088, 14836, -4206, 14802, -4241, 14791, -4321, 14756, -4294, 14687, -4363, 14666, -4358, 14605, -4355, 14543, -4269, 14530, -4203, 14472, -4116, 14474, -4070, 14540, -4079, 14636, -4114, 14691, -4100},
+	}},
+	{"AU", [][]int16{
+		{12615, -3222, 12509, -3273, 12422, -3296, 12403, -3348, 12366, -3389, 12281, -3391, 12218, -3400, 12130, -3382, 12058, -3393, 11989, -3398, 11930, -3451, 11901, -3446, 11851, -3475, 11802, -3506, 11730, -3503, 11663, -3503, 11556, -3439, 11503, -3420, 11505, -3362, 11555, -3349, 11571, -3326, 11568, -3290, 11580, -3221, 11569, -3161, 11516, -3060, 11500, -3003, 11504, -2946, 11464, -2881, 11462, -2852, 11417, -2812, 11405, -2733, 11348, -2654, 11334, -2612, 11378, -2655, 11344, -2562, 11394, -2591, 11423, -2630, 11422, -2579, 11372, -2500, 11363, -2468, 11339, -2438, 11350, -2381, 11371, -2356, 11384, -2306, 11374, -2248, 11415, -2176, 11423, -2252, 11465, -2183, 11546, -2150, 11595, -2107, 11671, -2070, 11717, -2062, 11744, -2075, 11823, -2037, 11884, -2026, 11899, -2004, 11925, -1995, 11981, -1998, 12086, -1968, 12140, -1924, 12166, -1871, 12224, -1820, 12229, -1780, 12231, -1725, 12301, -1641, 12343, -1727, 12386, -1707, 12350, -1660, 12382, -1611, 12426, -1633, 12438, -1557, 12493, -1508, 12517, -1468, 12567, -1451, 12569, -1423, 12613, -1435, 12614, -1410, 12658, -1395, 12707, -1382, 12780, -1428, 12836, -1487, 12899, -1488, 12962, -1497, 12941, -1442, 12989, -1362, 13034, -1336, 13018, -1311, 13062, -1254, 13122, -1218, 13174, -1230, 13258, -1211, 13256, -1160, 13182, -1127, 13236, -1113, 13302, -1138, 13355, -1179, 13439, -1204, 13468, -1194, 13530, -1225, 13588, -1196, 13626, -1205, 13649, -1186, 13695, -1235, 13669, -1289, 13631, -1329, 13596, -1332, 13608, -1372, 13578, -1422, 13543, -1472, 13550, -1500, 13630, -1555, 13707, -1587, 13758, -1622, 13830, -1681, 13859, -1681, 13911, -1706, 13926, -1737, 14022, -1771, 14088, -1737, 14107, -1683, 14127, -1639, 14140, -1584, 14170, -1504, 14156, -1456, 14164, -1427, 14152, -1370, 14165, -1294, 14184, -1274, 14169, -1241, 14193, -1188, 14212, -1133, 14214, -1104, 14252, -1067, 14280, -1116, 14287, -1178, 14312, -1191, 14316, -1233, 14352, -1283, 14360, -1340, 14356, -1376, 14392, -1455, 14456, -1417, 14489, -1459, 14537, -1498, 14527, -1543, 14549, -1629, 14564, -1678, 14589, -1691, 14616, -1776, 14606, -1828, 14639, -1896, 14747, -1948, 14818, -1996, 14885, -2039, 14872, -2063, 14929, -2126, 14968, -2234, 15008, -2212, 15048, -2256, 15073, -2240, 15090, -2346, 15161, -2408, 15207, -2446, 15286, -2527, 15314, -2607, 15316, -2664, 15309, -2726, 15357, -2811, 15351, -2900, 15334, -2946, 15307, -3035, 15309, -3092, 15289, -3164, 15245, -3255, 15171, -3304, 15134, -3382, 15101, -3431, 15071, -3517, 15033, -3567, 15008, -3642, 14995, -3711, 15000, -3743, 14942, -3777, 14830, -3781, 14738, -3822, 14692, -3861, 14632, -3904, 14549, -3859, 14488, -3842, 14503, -3790, 14449, -3809, 14361, -3881, 14275, -3854, 14218, -3838, 14161, -3831, 14064, -3802, 13999, -3740, 13981, -3664, 13957, -3614, 13908, -3573, 13812, -3561, 13845, -3513, 13821, -3438, 13772, -3508, 13683, -3526, 13735, -3471, 13750, -3413, 13789, -3364, 13781, -3290, 13700, -3375, 13637, -3409, 13599, -3489, 13521, -3448, 13524, -3395, 13461, -3322, 13409, -3285, 13427, -3262, 13299, -3201, 13229, -3198, 13133, -3150, 12954, -3159, 12824, -3195, 12710, -3228},
+	}},
+	{"AZ", [][]int16{
+		{4640, 4186, 4669, 4183, 4737, 4122, 4782, 4115, 4799, 4141, 4858, 4181, 4911, 4128, 4962, 4057, 5008, 4053, 5039, 4026, 4957, 4018, 4940, 3940, 4922, 3905, 4886, 3882, 4888, 3832, 4863, 3827, 4801, 3879, 4836, 3929, 4806, 3958, 4769, 3951, 4651, 3877, 4648, 3946, 4603, 3963, 4561, 3990, 4589, 4022, 4536, 4056, 4556, 4081, 4518, 4099, 4497, 4125, 4522, 4141, 4596, 4112, 4650, 4106, 4664, 4118, 4615, 4172},
+	}},
+	{"AZ", [][]int16{
+		{4614, 3874, 4546, 3887, 4495, 3934, 4479, 3971, 4500, 3974, 4530, 3947, 4574, 3947, 4574, 3932},
+	}},
+	{"BA", [][]int16{
+		{1856, 4265, 1767, 4303, 1730, 4345, 1692, 4367, 1646, 4404, 1624, 4435, 1575, 4482, 1596, 4523, 1632, 4500, 1653, 4521, 1700, 4523, 1786, 4507, 1855, 4508, 1901, 4486, 1937, 4486, 1912, 4442, 1960, 4404, 1945, 4357, 1922, 4352, 1903, 4343, 1871, 4320},
+	}},
+	{"BD", [][]int16{
+		{9267, 2204, 9265, 2132, 9230, 2148, 9237, 2067, 9208, 2119, 9203, 2170, 9183, 2218, 9142, 2277, 9050, 2281, 9059, 2239, 9027, 2184, 8985, 2204, 8970, 2186, 8942, 2197, 8903, 2206, 8888, 2288, 8853, 2363, 8870, 2423, 8808, 2450, 8831, 2487, 8893, 2524, 8821, 2577, 8856, 2645, 8936, 2601, 8983, 2597, 8992, 2527, 9087, 2513, 9180, 2515, 9238, 2498, 9192, 2413, 9147, 2407, 9116, 2350, 9171, 2299, 9187, 2362, 9215, 2363},
+	}},
+	{"BE", [][]int16{
+		{616, 5080, 604, 5013, 578, 5009, 567, 4953, 480, 4999, 429, 4991, 359, 5038, 312, 5078, 266, 5080, 251, 5115, 331, 5135, 332, 5135, 331, 5135, 405, 5127, 497, 5148, 561, 5104},
+	}},
+	{"BF", [][]int16{
+		{-540, 1037, -547, 1095, -520, 1138, -522, 1171, -443, 1254, -428, 1323, -401, 1347, -352, 1334, -310, 1354, -297, 1380, -219, 1425, -200, 1456, -107, 1497, -52, 1512, -27, 1492, 37, 1493, 30, 1444, 43, 1399, 99, 1334, 102, 1285, 218, 1263, 215, 1194, 194, 1164, 145, 1155, 124, 1111, 90, 1100, 2, 1102, -44, 1110, -76, 1094, -120, 1101, -294, 1096, -296, 1040, -283, 964, -351, 990, -398, 986, -433, 961, -478, 982, -495, 1015},
+	}},
+	{"BG", [][]int16{
+		{2266, 4423, 2294, 4382, 2333, 4390, 2410, 4374, 2557, 4369, 2607, 4394, 2724, 4418, 2797, 4381, 2856, 4371, 2804, 4329, 2767, 4258, 2800, 4201, 2714, 4214, 2612, 4183, 2611, 4133, 2520, 4123, 2449, 4158, 2369, 4131, 2295, 4134, 2288, 4200, 2238, 4232, 2255, 4246, 2244, 4258, 2260, 4290, 2299, 4321, 2250, 4364, 2241, 4401},
+	}},
+	{"BI", [][]int16{
+		{3047, -241, 3053, -281, 3074, -303, 3075, -336, 3051, -357, 3012, -409, 2975, -445, 2934, -450, 2928, -329, 2902, -284, 2963, -292, 2994, -235},
+	}},
+	{"BJ", [][]int16{
+		{269, 626, 187, 614, 162, 683, 166, 913, 146, 933, 143, 983, 108, 1018, 77, 1047, 90, 1100, 124, 1111, 145, 1155, 194, 1164, 215, 1194, 249, 1223, 285, 1224, 361, 1166, 357, 1133, 380, 1073, 360, 1033, 371, 1006, 322, 944, 291, 914, 272, 851, 275, 787},
+	}},
+	{"BN", [][]int16{
+		{11545, 545, 11541, 496, 11535, 432, 11487, 435, 11466, 401, 11420, 453, 11460, 490},
+	}},
+	{"BO", [][]int16{
+		{-6953, -1095, -6879, -1104, -6827, -1101, -6805, -1071, -6717, -1031, -6665, -993, -6534, -976, -6544, -1051, -6532, -1090, -6540, -1157, -6432, -1246, -6320, -1263, -6280, -1300, -6213, -1320, -6171, -1349, -6108, -1348, -6050, -1378, -6046, -1435, -6026, -1465, -6025, -1508, -6054, -1509, -6016, -1626, -5824, -1630, -5839, -1688, -5828, -1727, -5773, -1755, -5750, -1817, -5768, -1896, -5795, -1940, -5785, -1997, -5817, -2018, -5818, -1987, -5912, -1936, -6004, -1934, -6179, -1963, -6227, -2051, -6229, -2105, -6269, -2225, -6285, -2203, -6399, -2199, -6438, -2280, -6496, -2208, -6627, -2183, -6711, -2274, -6783, -2287, -6822, -2149, -6876, -2037, -6844, -1941, -6897, -1898, -6910, -1826, -6959, -1758, -6896, -1650, -6939, -1566, -6916, -1532, -6934, -1495, -6895, -1445, -6893, -1360, -6888, -1290, -6867, -1256},
+	}},
+	{"BR", [][]int16{
+		{-5337, -3377, -5365, -3320, -5321, -3273, -5379, -3205, -5457, -3149, -5560, -3085, -5597, -3088, -5698, -3011, -5763, -3022, -5629, -2885, -5516, -2788, -5449, -2747, -5365, -2692, -5363, -2612, -5413, -2555, -5463, -2574, -5443, -2516, -5429, -2457, -5429, -2402, -5465, -2384, -5503, -2400, -5540, -2396, -5552, -2357, -5561, -2266, -5580, -2236, -5647, -2209, -5688, -2228, -5794, -2209, -5787, -2073, -5817, -2018, -5785, -1997, -5795, -1940, -5768, -1896, -5750, -1817, -5773, -1755, -5828, -1727, -5839, -1688, -5824, -1630, -6016, -1626, -6054, -1509, -6025, -1508, -6026, -1465, -6046, -1435, -6050, -1378, -6108, -1348, -6171, -1349, -6213, -1320, -6280, -1300, -6320, -1263, -6432, -1246, -6540, -1157, -6532, -1090, -6544, -1051, -6534, -976, -6665, -993, -6717, -1031, -6805, -1071, -6827, -1101, -6879, -1104, -6953, -1095, -7009, -1112, -7055, -1101, -7048, -949, -7130, -1008, -7218, -1005, -7256, -952, -7323, -946, -7302, -903, -7357, -842, -7399, -752, -7372, -734, -7372, -692, -7312, -663, -7322, -609, -7296, -574, -7289, -527, -7175, -459, -7093, -440, -7079, -425, -6989, -430, -6944, -156, -6942, -112, -6958, -55, -7002, -19, -7002, 54, -6945, 71, -6925, 60, -6922, 99, -6980, 109, -6982, 171, -6787, 169, -6754, 204, -6726, 172, -6707, 113, -6688, 125, -6633, 72, -6555, 79, -6535, 110, -6461, 133, -6420, 149, -6408, 192, -6337, 220, -6342, 241, -6427, 250, -6441, 313, -6437, 380, -6482, 406, -6463, 415, -6389, 402, -6309, 377, -6280, 401, -6209, 416, -6097, 454, -6060, 492, -6073, 520, -6021, 524, -5998, 501, -6011, 457, -5977, 442, -5954, 396, -5982, 361, -5997, 276, -5972, 225, -5965, 179, -5903, 132, -5854, 127, -5843, 146, -5811, 151, -5766, 168, -5734, 195, -5678, 186, -5654, 190, -5600, 182, -5591, 202, -5607, 222, -5597, 251, -5557, 242, -5510, 252, -5452, 231, -5409, 211, -5378, 238, -5355, 233, -5342, 205, -5294, 212, -5256, 250, -5225, 324, -5166, 416, -5132, 420, -5107, 365, -5051, 190, -4997, 174, -4995, 105, -5070, 22, -5039, -8, -4862, -24, -4858, -124, -4782, -58, -4657, -94, -4491, -155, -4442, -214, -4458, -269, -4342, -238, -4147, -291, -3998, -287, -3850, -370, -3722, -482, -3645, -511, -3560, -515, -3524, -546, -3490, -674, -3473, -734, -3513, -900, -3564, -965, -3705, -1104, -3768, -1217, -3842, -1304, -3867, -1306, -3895, -1379, -3888, -1567, -3916, -1721, -3927, -1787, -3958, -1826, -3976, -1960, -4077, -2090, -4094, -2194, -4175, -2237, -4199, -2297, -4307, -2297, -4465, -2335, -4535, -2380, -4647, -2409, -4765, -2489, -4850, -2588, -4864, -2662, -4847, -2718, -4866, -2819, -4889, -2867, -4959, -2922, -5070, -3098, -5158, -3178, -5226, -3225, -5271, -3320},
+	}},
+	{"BS", [][]int16{
+		{-7898, 2679, -7851, 2687, -7785, 2684, -7782, 2658, -7891, 2642},
+	}},
+	{"BS", [][]int16{
+		{-7779, 2704, -7700, 2659, -7717, 2588, -7736, 2601, -7734, 2653, -7779, 2693},
+	}},
+	{"BS", [][]int16{
+		{-7819, 2521, -7789, 2517, -7754, 2434, -7753, 2376, -7778, 2371, -7803, 2429, -7841, 2458},
+	}},
+	{"BT", [][]int16{
+		{9170, 2777, 9210, 2745, 9203, 2684, 9122, 2681, 9037, 2688, 8974, 2672, 8884, 2710, 8881, 2730, 8948, 2804, 9002, 2830, 9073, 2806, 9126, 2804},
+	}},
+	{"BW", [][]int16{
+		{2943, -2209, 2802, -2283, 2712, -2357, 2679, -2424, 2649, -2462, 2594, -2470, 2577, -2517, 2566, -2549, 2503, -2572, 2421, -2567, 2373, -2539, 2331, -2527, 2282, -2550, 2258, -2598, 2211, -2628, 2161, -2673, 2089, -2683, 2067, -2648, 2076, -2587, 2017, -2492, 1990, -2477, 1990, -2185, 2088, -2181, 2091, -1825, 2166, -1822, 2320, -1787, 2358, -1828, 2422, -1789, 2452, -1789, 2508, -1766, 2526, -1774, 2565, -1854, 2585, -1871, 2616, -1929, 2730, -2039, 2772, -2050, 2773, -2085, 2802, -2149, 2879, -2164},
+	}},
+	{"BY", [][]int16{
+		{2818, 5617, 2923, 5592, 2937, 5567, 2990, 5579, 3087, 5555, 3097, 5508, 3076, 5481, 3138, 5416, 3179, 5397, 3173, 5379, 3241, 5362, 3269, 5335, 3230, 5313, 3150, 5317, 3131, 5307, 3154, 5274, 3179, 5210, 3093, 5204, 3062, 5182, 3056, 5132, 3016, 5142, 2925, 5137, 2899, 5160, 2862, 5143, 2824, 5157, 2745, 5159, 2634, 5183, 2533, 5191, 2455, 5189, 2401, 5162, 2353, 5158, 2351, 5202, 2320, 5249, 2380, 5269, 2380, 5309, 2353, 5347, 2348, 5391, 2445, 5391, 2554, 5428, 2577, 5485, 2659, 5517, 2649, 5562, 2710, 5578},
+	}},
+	{"BZ", [][]int16{
+		{-8914, 1781, -8915, 1796, -8903, 1800, -8885, 1788, -8849, 1849, -8830, 1850, -8830, 1835, -8811, 1835, -8812, 1808, -8829, 1764, -8820, 1749, -8830, 1713, -8824, 1704, -8836, 1653, -8855, 1627, -8873, 1623, -8893, 1589, -8923, 1589, -8915, 1702},
+	}},
+	{"CA", [][]int16{
+		{-12284, 4900, -12297, 4900, -12491, 4998, -12562, 5042, -12744, 5083, -12799, 5172, -12785, 5233, -12913, 5276, -12931, 5356, -13051, 5429, -13054, 5480, -12998, 5528, -13001, 5592, -13171, 5655, -13273, 5769, -13336, 5841, -13427, 5886, -13494, 5927, -13548, 5979, -13648, 5946, -13745, 5890, -13834, 5956, -13904, 6000, -14001, 6028, -14100, 6031, -14099, 6600, -14099, 6971, -13912, 6947, -13755, 6899, -13650, 6890, -13563, 6932, -13441, 6963, -13293, 6951, -13143, 6994, -12979, 7019, -12911, 6978, -12836, 7001, -12814, 7048, -12745, 7038, -12576, 6948, -12442, 7016, -12429, 6940, -12306, 6956, -12268, 6986, -12147, 6980, -11994, 6938, -11760, 6901, -11623, 6884, -11525, 6891, -11390, 6840, -11530, 6790, -11350, 6769, -11080, 6781, -10995, 6798, -10888, 6738, -10779, 6789, -10881, 6831, -10817, 6865, -10695, 6870, -10615, 6880, -10534, 6856, -10434, 6802, -10322, 6810, -10145, 6765, -9990, 6781, -9844, 6778, -9856, 6840, -9767, 6858, -9612, 6824, -9613, 6729, -9549, 6809, -9468, 6806, -9423, 6907, -9530, 6969, -9647, 7009, -9639, 7119, -9521, 7192, -9389, 7176, -9288, 7132, -9152, 7019, -9241, 6970, -9055, 6950, -9055, 6847, -8922, 6926, -8802, 6862, -8832, 6787, -8735, 6720, -8631, 6792, -8558, 6878, -8552, 6988, -8410, 6981, -8262, 6966, -8128, 6916, -8122, 6867, -8196, 6813, -8126, 6760, -8139, 6711, -8334, 6641, -8474, 6626, -8577, 6656, -8607, 6606, -8703, 6521, -8732, 6478, -8848, 6410, -8991, 6403, -9070, 6361, -9077, 6296, -9193, 6284, -9316, 6202, -9424, 6090, -9463, 6011, -9468, 5895, -9322, 5878, -9276, 5785, -9230, 5709, -9090, 5728, -8904, 5685, -8804, 5647, -8732, 5600, -8607, 5572, -8501, 5530, -8336, 5524, -8227, 5515, -8244, 5428, -8213, 5328, -8140, 5216, -7991, 5121, -7914, 5153, -7860, 5256, -7912, 5414, -7983, 5467, -7823, 5514, -7710, 5584, -7654, 5653, -7662, 5720, -7730, 5805, -7852, 5880, -7734, 5985, -7777, 6076, -7811, 6232, -7741, 6255, -7570, 6228, -7467, 6218, -7384, 6244, -7291, 6211, -7168, 6153, -7137, 6114, -6959, 6106, -6962, 6022, -6929, 5896, -6837, 5880, -6765, 5821, -6620, 5877, -6525, 5987, -6458, 6034, -6380, 5944, -6250, 5817, -6140, 5697, -6180, 5634, -6047, 5578, -5957, 5520, -5798, 5495, -5733, 5463, -5694, 5378, -5616, 5365, -5576, 5327, -5568, 5215, -5641, 5177, -5713, 5142, -5877, 5106, -6003, 5024, -6172, 5008, -6386, 5029, -6536, 5030, -6640, 5023, -6724, 4951, -6851, 4907, -6995, 4774, -7110, 4682, -7026, 4699, -6865, 4830, -6655, 4913, -6506, 4923, -6417, 4874, -6512, 4807, -6480, 4699, -6447, 4624, -6317, 4574, -6152, 4588, -6052, 4701, -6045, 4628, -5980, 4592, -6104, 4527, -6325, 4467, -6425, 4427, -6536, 4355, -6612, 4362, -6616, 4447, -6443, 4529, -6603, 4526, -6714, 4514, -6779, 4570, -6779, 4707, -6823, 4735, -6890, 4718, -6924, 4745, -7000, 4669, -7031, 4592, -7066, 4546, -7108, 4531, -7140, 4526, -7151, 4501, -7335, 4501, -7487, 4500, -7532, 4482, -7638, 4410, -7650, 4402, -7682, 4363, -7774, 4363, -7872, 4363, -7917, 4347, -7901, 4327, -7892, 4296, -7894, 4286, -8025, 4237, -8128, 4221, -8244, 4168, -8269, 4168, -8303, 4183, -8314, 4198, -8312, 4208, -8290, 4243, -8243, 4298, -8214, 4357, -8234, 4444, -8255, 4535, -8359, 4582, -8347, 4599, -8362, 4612, -8389, 4612, -8409, 4628, -8414, 4651, -8434, 4641, -8460, 4644, -8454, 4654, -8478, 4664, -8488, 4690, -8565, 4722, -8646, 4755, -8744, 4794, -8838, 4830, -8927, 4802, -8960, 4801, -9083, 4827, -9164, 4814, -9261, 4845, -9363, 4861, -9433, 4867, -9464, 4884, -9482, 4939, -9516, 4938, -9516, 4900, -9723, 4900, -10065, 4900, -10405, 4900, -10705, 4900, -11005, 4900, -11300, 4900, -11605, 4900, -11703, 4900, -12000, 4900},
+	}},
+	{"CA", [][]int16{
+		{-8399, 6245, -8325, 6291, -8188, 6290, -8190, 6271, -8307, 6216, -8377, 6218},
+	}},
+	{"CA", [][]int16{
+		{-7978, 7280, -8088, 7333, -8083, 7369, -8035, 7376, -7806, 7365, -7634, 7310, -7625, 7283, -7731, 7286, -7839, 7288, -7949, 7274},
+	}},
+	{"CA", [][]int16{
+		{-8032, 6209, -7993, 6239, -7952, 6236, -7927, 6216, -7966, 6163, -8010, 6172, -8036, 6202},
+	}},
+	{"CA", [][]int16{
+		{-9361, 7498, -9416, 7459, -9561, 7467, -9682, 7493, -9629, 7538, -9485, 7565, -9398, 7530},
+	}},
+	{"CA", [][]int16{
+		{-9384, 7752, -9430, 7749, -9617, 7756, -9644, 7783, -9442, 7782, -9372, 7763},
+	}},
+	{"CA", [][]int16{
+		{-9675, 7877, -9556, 7842, -9583, 7806, -9731, 7785, -9812, 7808, -9855, 7846, -9863, 7887, -9734, 7883},
+	}},
+	{"CA", [][]int16{
+		{-8815, 7439, -8976, 7452, -9242, 7484, -9277, 7539, -9289, 7588, -9389, 7632, -9596, 7644, -9712, 7675, -9675, 7716, -9468, 7710, -9357, 7678, -9161, 7678, -9074, 7645, -9097, 7607, -8982, 7585, -8919, 7561, -8784, 7557, -8638, 7548, -8479, 7570, -8275, 7578, -8113, 7571, -8006, 7534, -7983, 7492, -8046, 7466, -8195, 7444, -8323, 7456, -8610, 7441},
+	}},
+	{"CA", [][]int16{
+		{-11126, 7815, -10985, 7800, -11019, 7770, -11205, 7741, -11353, 7773, -11272, 7805},
+	}},
+	{"CA", [][]int16{
+		{-11096, 7880, -10966, 7860, -11088, 7841, -11254, 7841, -11253, 7855, -11150, 7885},
+	}},
+	{"CA", [][]int16{
+		{-5560, 5132, -5613, 5069, -5680, 4981, -5614, 5015, -5547, 4994, -5582, 4959, -5494, 4931, -5447, 4956, -5348, 4925, -5379, 4852, -5309, 4869, -5296, 4816, -5265, 4754, -5307, 4666, -5352, 4662, -5418, 4681, -5396, 4763, -5424, 4775, -5540, 4688, -5600, 4692, -5529, 4739, -5625, 4763, -5733, 4757, -5927, 4760, -5942, 4790, -5880, 4825, -5923, 4852, -5839, 4913, -5736, 5072, -5674, 5129, -5587, 5163, -5541, 5159},
+	}},
+	{"CA", [][]int16{
+		{-8388, 6511, -8279, 6477, -8164, 6446, -8155, 6398, -8082, 6406, -8010, 6373, -8099, 6341, -8255, 6365, -8311, 6410, -8410, 6357, -8552, 6305, -8587, 6364, -8722, 6354, -8635, 6404, -8622, 6482, -8588, 6574, -8516, 6566, -8498, 6522, -8446, 6537},
+	}},
+	{"CA", [][]int16{
+		{-7877, 7235, -7782, 7275, -7561, 7224, -7423, 7177, -7410, 7133, -7224, 7156, -7120, 7092, -6879, 7053, -6791, 7012, -6697, 6919, -6881, 6872, -6645, 6807, -6486, 6785, -6342, 6693, -6185, 6686, -6216, 6616, -6392, 6500, -6515, 6543, -6672, 6639, -6802, 6626, -6814, 6569, -6709, 6511, -6573, 6465, -6532, 6438, -6467, 6339, -6501, 6267, -6628, 6295, -6878, 6375, -6737, 6288, -6633, 6228, -6617, 6193, -6888, 6233, -7102, 6291, -7224, 6340, -7189, 6368, -7338, 6419, -7483, 6468, -7482, 6439, -7771, 6423, -7856, 6457, -7790, 6531, -7602, 6533, -7396, 6545, -7429, 6581, -7394, 6631, -7265, 6728, -7293, 6773, -7331, 6807, -7484, 6855, -7687, 6889, -7623, 6915, -7729, 6977, -7817, 6983, -7896, 7017, -7949, 6987, -8131, 6974, -8494, 6997, -8706, 7026, -8868, 7041, -8951, 7076, -8847, 7122, -8989, 7122, -9021, 7224, -8944, 7313, -8841, 7354, -8583, 7380, -8656, 7316, -8577, 7253, -8485, 7334, -8232, 7375, -8060, 7272, -8075, 7206},
+	}},
+	{"CA", [][]int16{
+		{-9450, 7413, -9242, 7410, -9051, 7386, -9200, 7297, -9320, 7277, -9427, 7202, -9541, 7206, -9603, 7294, -9602, 7344, -9550, 7386},
+	}},
+	{"CA", [][]int16{
+		{-12285, 7612, -12116, 7686, -11910, 7751, -11757, 7750, -11620, 7765, -11634, 7688, -11711, 7653, -11804, 7648, -11990, 7605, -12150, 7590},
+	}},
+	{"CA", [][]int16{
+		{-13271, 5404, -13175, 5412, -13205, 5298, -13118, 5218, -13158, 5218, -13218, 5264, -13255, 5310, -13305, 5341, -13324, 5385, -13318, 5417},
+	}},
+	{"CA", [][]int16{
+		{-10549, 7930, -10353, 7917, -10083, 7880, -10006, 7832, -9967, 7791, -10130, 7802, -10295, 7834, -10518, 7838, -10421, 7868, -10542, 7892},
+	}},
+	{"CA", [][]int16{
+		{-12351, 4851, -12401, 4837, -12566, 4883, -12595, 4918, -12685, 4953, -12703, 4981, -12806, 4999, -12844, 5054, -12836, 5077, -12731, 5055, -12670, 5040, -12576, 5030, -12542, 4995, -12492, 4948, -12392, 4906},
+	}},
+	{"CA", [][]int16{
+		{-12154, 7445, -12011, 7424, -11756, 7419, -11658, 7390, -11551, 7348, -11677, 7322, -11922, 7252, -12046, 7182, -12046, 7138, -12309, 7090, -12362, 7134, -12593, 7187, -12550, 7229, -12481, 7302, -12394, 7368, -12492, 7429},
+	}},
+	{"CA", [][]int16{
+		{-10782, 7585, -10693, 7601, -10588, 7597, -10570, 7548, -10631, 7501, -10970, 7485, -11222, 7442, -11374, 7439, -11387, 7472, -11179, 7516, -11631, 7504, -11771, 7522, -11635, 7620, -11540, 7648, -11259, 7614, -11081, 7555, -10907, 7547, -11050, 7643, -10958, 7679, -10855, 7668, -10821, 7620},
+	}},
+	{"CA", [][]int16{
+		{-10652, 7308, -10540, 7267, -10477, 7170, -10446, 7099, -10279, 7050, -10098, 7002, -10109, 6958, -10273, 6950, -10209, 6912, -10243, 6875, -10424, 6891, -10596, 6918, -10712, 6912, -10900, 6878, -11153, 6863, -11331, 6854, -11385, 6901, -11522, 6928, -11611, 6917, -11734, 6996, -11667, 7007, -11513, 7024, -11372, 7019, -11242, 7037, -11435, 7060, -11649, 7052, -11790, 7054, -11843, 7091, -11611, 7131, -11766, 7130, -11940, 7156, -11856, 7231, -11787, 7271, -11519, 7331, -11417, 7312, -11467, 7265, -11244, 7296, -11105, 7245, -10992, 7296, -10901, 7263, -10819, 7165, -10769, 7207, -10840, 7309, -10752, 7324},
+	}},
+	{"CA", [][]int16{
+		{-10044, 7271, -10154, 7336, -10036, 7384, -9916, 7363, -9738, 7376, -9712, 7347, -9805, 7299, -9654, 7256, -9672, 7166, -9836, 7127, -9932, 7136, -10001, 7174, -10250, 7251, -10248, 7283},
+	}},
+	{"CA", [][]int16{
+		{-10660, 7360, -10526, 7364, -10450, 7342, -10538, 7276, -10694, 7346},
+	}},
+	{"CA", [][]int16{
+		{-9850, 7672, -9774, 7626, -9770, 7574, -9816, 7500, -9981, 7490, -10088, 7506, -10086, 7564, -10250, 7556, -10257, 7634, -10149, 7631, -9998, 7665, -9858, 7659},
+	}},
+	{"CA", [][]int16{
+		{-9602, 8060, -9532, 8091, -9430, 8098, -9474, 8121, -9241, 8126, -9113, 8072, -8945, 8051, -8781, 8032, -8702, 7966, -8581, 7934, -8719, 7904, -8904, 7829, -9080, 7822, -9288, 7834, -9395, 7875, -9394, 7911, -9315, 7938, -9497, 7937, -9608, 7971, -9671, 8016},
+	}},
+	{"CA", [][]int16{
+		{-9159, 8189, -9010, 8208, -8893, 8212, -8697, 8228, -8550, 8265, -8426, 8260, -8318, 8232, -8242, 8286, -8110, 8302, -7931, 8313, -7625, 8317, -7572, 8306, -7283, 8323, -7067, 8317, -6850, 8311, -6583, 8303, -6368, 8290, -6185, 8263, -6189, 8236, -6433, 8193, -6675, 8173, -6766, 8150, -6548, 8151, -6784, 8090, -6947, 8062, -7118, 7980, -7324, 7963, -7388, 7943, -7691, 7932, -7553, 7920, -7622, 7902, -7539, 7853, -7634, 7818, -7789, 7790, -7836, 7751, -7976, 7721, -7962, 7698, -7791, 7702, -7789, 7678, -8056, 7618, -8317, 7645, -8611, 7630, -8760, 7642, -8949, 7647, -8962, 7695, -8777, 7718, -8826, 7790, -8765, 7797, -8498, 7754, -8634, 7818, -8796, 7837, -8715, 7876, -8538, 7900, -8509, 7935, -8651, 7974, -8693, 8025, -8420, 8021, -8341, 8010, -8185, 8046, -8410, 8058, -8760, 8052, -8937, 8086, -9020, 8126, -9137, 8155},
+	}},
+	{"CA", [][]int16{
+		{-7522, 6744, -7587, 6715, -7699, 6710, -7724, 6759, -7681, 6815, -7590, 6829, -7511, 6801, -7510, 6758},
+	}},
+	{"CA", [][]int16{
+		{-9626, 6949, -9565, 6911, -9627, 6876, -9762, 6906, -9843, 6895, -9980, 6940, -9892, 6971, -9822, 7014, -9716, 6986, -9656, 6968},
+	}},
+	{"CA", [][]int16{
+		{-6452, 4987, -6417, 4996, -6286, 4971, -6184, 4929, -6181, 4911, -6229, 4909, -6359, 4940},
+	}},
+	{"CA", [][]int16{
+		{-6401, 4704, -6366, 4655, -6294, 4642, -6201, 4644, -6250, 4603, -6287, 4597, -6414, 4639, -6439, 4673},
+	}},
+	{"CD", [][]int16{
+		{2934, -450, 2952, -542, 2942, -594, 2962, -652, 3020, -708, 3074, -834, 3035, -824, 2900, -841, 2873, -853, 2845, -916, 2867, -961, 2850, -1079, 2837, -1179, 2864, -1197, 2934, -1236, 2962, -1218, 2970, -1326, 2893, -1325, 2852, -1270, 2816, -1227, 2739, -1213, 2716, -1161, 2655, -1192, 2575, -1178, 2542, -1133, 2478, -1124, 2431, -1126, 2426, -1095, 2391, -1093, 2346, -1087, 2284, -1102, 2240, -1099, 2216, -1108, 2221, -989, 2188, -952, 2180, -891, 2195, -831, 2175, -792, 2173, -729, 2051, -730, 2060, -694, 2009, -694, 2004, -712, 1942, -716, 1917, -774, 1902, -799, 1846, -785, 1813, -799, 1747, -807, 1709, -755, 1686, -722, 1657, -662, 1633, -588, 1338, -586, 1302, -598, 1274, -597, 1232, -610, 1218, -579, 1244, -568, 1247, -525, 1263, -499, 1300, -478, 1326, -488, 1360, -450, 1414, -451, 1421, -479, 1458, -497, 1517, -434, 1575, -386, 1601, -354, 1597, -271, 1641, -174, 1687, -123, 1752, -74, 1764, -42, 1766, -6, 1783, 29, 1777, 86, 1790, 174, 1809, 237, 1839, 290, 1845, 350, 1854, 420, 1893, 471, 1947, 503, 2029, 469, 2093, 432, 2166, 422, 2241, 403, 2270, 463, 2284, 471, 2330, 461, 2441, 511, 2481, 490, 2513, 493, 2528, 517, 2565, 526, 2640, 515, 2704, 513, 2737, 523, 2798, 441, 2843, 429, 2870, 446, 2916, 439, 2972, 460, 2995, 417, 3083, 351, 3077, 234, 3117, 220, 3085, 185, 3047, 158, 3009, 106, 2988, 60, 2982, -21, 2959, -59, 2958, -134, 2929, -162, 2925, -222, 2912, -229, 2902, -284, 2928, -329},
+	}},
+	{"CF", [][]int16{
+		{2737, 523, 2704, 513, 2640, 515, 2565, 526, 2528, 517, 2513, 493, 2481, 490, 2441, 511, 2330, 461, 2284, 471, 2270, 463, 2241, 403, 2166, 422, 2093, 432, 2029, 469, 1947, 503, 1893, 471, 1854, 420, 1845, 350, 1781, 356, 1713, 373, 1654, 320, 1601, 227, 1591, 256, 1586, 301, 1541, 334, 1504, 385, 1495, 421, 1448, 473, 1456, 503, 1446, 545, 1454, 623, 1478, 641, 1528, 742, 1611, 750, 1629, 775, 1646, 773, 1671, 751, 1796, 789, 1839, 828, 1891, 863, 1881, 898, 1909, 907, 2006, 901, 2100, 948, 2172, 1057, 2223, 1097, 2286, 1114, 2298, 1071, 2355, 1009, 2356, 968, 2339, 927, 2346, 895, 2381, 867, 2457, 823, 2511, 783, 2512, 750, 2580, 698, 2621, 655, 2647, 595, 2721, 555},
+	}},
+	{"CG", [][]int16{
+		{1845, 350, 1839, 290, 1809, 237, 1790, 174, 1777, 86, 1783, 29, 1766, -6, 1764, -42, 1752, -74, 1687, -123, 1641, -174, 1597, -271, 1601, -354, 1575, -386, 1517, -434, 1458, -497, 1421, -479, 1414, -451, 1360, -450, 1326, -488, 1300, -478, 1262, -444, 1232, -461, 1191, -504, 1109, -398, 1186, -343, 1148, -277, 1182, -251, 1250, -239, 1258, -195, 1311, -243, 1399, -247, 1430, -200, 1443, -133, 1432, -55, 1384, 4, 1428, 120, 1403, 140, 1328, 131, 1300, 183, 1308, 227, 1434, 223, 1515, 196, 1594, 173, 1601, 227, 1654, 320, 1713, 373, 1781, 356},
+	}},
+	{"CH", [][]int16{
+		{959, 4753, 963, 4735, 948, 4710, 993, 4692, 1044, 4689, 1036, 4648, 992, 4631, 918, 4644, 897, 4604, 849, 4601, 832, 4616, 776, 4582, 727, 4578, 684, 4599, 650, 4643, 602, 4627, 604, 4673, 677, 4729, 674, 4754, 719, 4745, 747, 4762, 832, 4761, 852, 4783},
+	}},
+	{"CI", [][]int16{
+		{-803, 1021, -790, 1030, -762, 1015, -685, 1014, -667, 1043, -649, 1041, -621, 1052, -605, 1010, -582, 1022, -540, 1037, -495, 1015, -478, 982, -433, 961, -398, 986, -351, 990, -283, 964, -256, 822, -298, 738, -324, 625, -281, 539, -286, 499, -331, 498, -401, 518, -465, 517, -583, 499, -653, 471, -752, 434, -771, 436, -764, 519, -754, 531, -757, 571, -799, 613, -831, 619, -860, 647, -839, 691, -849, 740, -844, 769, -828, 769, -822, 812, -830, 832, -820, 846, -783, 858, -808, 938, -831, 979, -823, 1013},
+	}},
+	{"CL", [][]int16{
+		{-6863, -5264, -6863, -5487, -6756, -5487, -6696, -5490, -6729, -5530, -6815, -5561, -6864, -5558, -6923, -5550, -6996, -5520, -7101, -5505, -7226, -5450, -7329, -5396, -7466, -5284, -7384, -5305, -7243, -5372, -7111, -5407, -7059, -5362, -7027, -5293, -6935, -5252},
+	}},
+	{"CL", [][]int16{
+		{-6959, -1758, -6910, -1826, -6897, -1898, -6844, -1941, -6876, -2037, -6822, -2149, -6783, -2287, -6711, -2274, -6699, -2299, -6733, -2403, -6842, -2452, -6839, -2619, -6859, -2651, -6830, -2690, -6900, -2752, -6966, -2846, -7001, -2937, -6992, -3034, -7054, -3137, -7007, -3309, -6981, -3327, -6982, -3419, -7039, -3517, -7036, -3601, -7112, -3666, -7112, -3758, -7081, -3855, -7141, -3892, -7168, -3981, -7192, -4083, -7175, -4205, -7215, -4225, -7192, -4341, -7146, -4379, -7179, -4421, -7133, -4441, -7122, -4478, -7166, -4497, -7155, -4556, -7192, -4688, -7245, -4774, -7233, -4824, -7265, -4888, -7342, -4932, -7333, -5038, -7298, -5074, -7231, -5068, -7233, -5143, -7191, -5201, -6950, -5214, -6857, -5230, -6946, -5229, -6994, -5254, -7085, -5290, -7101, -5383, -7143, -5386, -7256, -5353, -7370, -5284, -7495, -5226, -7526, -5163, -7498, -5104, -7548, -5038, -7561, -4867, -7518, -4771, -7413, -4694, -7564, -4665, -7469, -4576, -7435, -4410, -7324, -4445, -7272, -4238, -7339, -4212, -7370, -4337, -7433, -4322, -7402, -4179, -7368, -3994, -7322, -3926, -7351, -3828, -7359, -3716, -7317, -3712, -7255, -3551, -7186, -3391, -7144, -3242, -7167, -3092, -7137, -3010, -7149, -2886, -7091, -2764, -7072, -2571, -7040, -2363, -7009, -2139, -7016, -1976, -7037, -1835, -6986, -1809},
+	}},
+	{"CM", [][]int16{
+		{1450, 1286, 1489, 1222, 1496, 1156, 1492, 1089, 1547, 998, 1491, 999, 1463, 992, 1417, 1002, 1395, 955, 1454, 897, 1498, 880, 1512, 838, 1544, 769, 1528, 742, 1478, 641, 1454, 623, 1446, 545, 1456, 503, 1448, 473, 1495, 421, 1504, 385, 1541, 334, 1586, 301, 1591, 256, 1601, 227, 1594, 173, 1515, 196, 1434, 223, 1308, 227, 1295, 232, 1236, 219, 1175, 233, 1128, 226, 965, 228, 980, 307, 940, 373, 895, 390, 874, 435, 849, 450, 850, 477, 876, 548, 923, 644, 952, 645, 1012, 704, 1050, 706, 1106, 664, 1175, 698, 1184, 740, 1206, 780, 1222, 831, 1275, 872, 1296, 942, 1317, 964, 1331, 1016, 1357, 1080, 1442, 1157, 1447, 1190, 1458, 1209, 1418, 1248, 1421, 1280},
+	}},
+	{"CN", [][]int16{
+		{10948, 1820, 10866, 1851, 10863, 1937, 10912, 1982, 11021, 2010, 11079, 2008, 11101, 1970, 11057, 1926, 11034, 1868},
+	}},
+	{"CN", [][]int16{
+		{8026, 4235, 8018, 4292, 8087, 4318, 7997, 4492, 8195, 4532, 8246, 4554, 8318, 4733, 8516, 4700, 8572, 4745, 8577, 4846, 8660, 4855, 8736, 4921, 8775, 4930, 8801, 4860, 8885, 4807, 9028, 4769, 9097, 4689, 9059, 4572, 9095, 4529, 9213, 4512, 9348, 4498, 9469, 4435, 9531, 4424, 9576, 4332, 9635, 4273, 9745, 4275, 9952, 4252, 10085, 4266, 10183, 4251, 10331, 4191, 10452, 4191, 10496, 4160, 10613, 4213, 10774, 4248, 10924, 4252, 11041, 4287, 11113, 4341, 11183, 4374, 11167, 4407, 11135, 4446, 11187, 4510, 11244, 4501, 11346, 4481, 11446, 4534, 11599, 4573, 11672, 4639, 11742, 4667, 11887, 4681, 11966, 4669, 11977, 4705, 11887, 4775, 11806, 4807, 11730, 4770, 11631, 4785, 11574, 4773, 11549, 4814, 11619, 4913, 11668, 4989, 11788, 4951, 11929, 5014, 11928, 5058, 12018, 5164, 12074, 5196, 12073, 5252, 12018, 5275, 12100, 5325, 12225, 5343, 12357, 5346, 12507, 5316, 12595, 5279, 12656, 5178, 12694, 5135, 12729, 5074, 12766, 4976, 12940, 4944, 13058, 4873, 13099, 4779, 13251, 4779, 13337, 4818, 13503, 4848, 13450, 4758, 13411, 4721, 13377, 4612, 13310, 4514, 13188, 4532, 13103, 4497, 13129, 4411, 13114, 4293, 13063, 4290, 13064, 4240, 12999, 4299, 12960, 4242, 12805, 4199, 12821, 4147, 12734, 4150, 12687, 4182, 12618, 4111, 12508, 4057, 12427, 3993, 12287, 3964, 12213, 3917, 12105, 3890, 12159, 3936, 12138, 3975, 12217, 4042, 12164, 4095, 12077, 4059, 11964, 3990, 11902, 3925, 11804, 3920, 11753, 3874, 11806, 3806, 11888, 3790, 11891, 3745, 11970, 3716, 12082, 3787, 12171, 3748, 12236, 3745, 12252, 3693, 12110, 3665, 12064, 3611, 11966, 3561, 11915, 3491, 12023, 3436, 12062, 3338, 12123, 3246, 12191, 3169, 12189, 3095, 12126, 3068, 12150, 3014, 12209, 2983, 12194, 2902, 12168, 2823, 12113, 2814, 12040, 2705, 11959, 2574, 11866, 2455, 11728, 2362, 11589, 2278, 11476, 2267, 11415, 2222, 11381, 2255, 11324, 2205, 11184, 2155, 11079, 2140, 11044, 2034, 10989, 2028, 10963, 2101, 10986, 2140, 10852, 2172, 10805, 2155, 10704, 2181, 10657, 2222, 10673, 2279, 10581, 2298, 10533, 2335, 10448, 2282, 10350, 2270, 10271, 2271, 10217, 2246, 10165, 2232, 10180, 2117, 10127, 2120, 10118, 2144, 10115, 2185, 10042, 2156, 9998, 2174, 9924, 2212, 9953, 2295, 9890, 2314, 9866, 2406, 9760, 2390, 9772, 2508, 9867, 2592, 9871, 2674, 9868, 2751, 9825, 2775, 9791, 2834, 9733, 2826, 9625, 2841, 9659, 2883, 9612, 2945, 9540, 2903, 9457, 2928, 9341, 2864, 9250, 2790, 9170, 2777, 9126, 2804, 9073, 2806, 9002, 2830, 8948, 2804, 8881, 2730, 8873, 2809, 8812, 2788, 8695, 2797, 8582, 2820, 8501, 2864, 8423, 2884, 8390, 2932, 8334, 2946, 8233, 3012, 8153, 3042, 8111, 3018, 7972, 3088, 7874, 3152, 7846, 3262, 7918, 3248, 7921, 3299, 7881, 3351, 7891, 3432, 7784, 3549, 7619, 3590, 7590, 3667, 7516, 3713, 7498, 3742, 7483, 3799, 7486, 3838, 7426, 3861, 7393, 3851, 7368, 3943, 7396, 3966, 7382, 3989, 7478, 4037, 7547, 4056, 7653, 4043, 7690, 4107, 7819, 4119, 7854, 4158, 8012, 4212},
+	}},
+	{"CO", [][]int16{
+		{-6688, 125, -6707, 113, -6726, 172, -6754, 204, -6787, 169, -6982, 171, -6980, 109, -6922, 99, -6925, 60, -6945, 71, -7002, 54, -7002, -19, -6958, -55, -6942, -112, -6944, -156, -6989, -430, -7039, -377, -7069, -374, -7005, -273, -7081, -226, -7141, -234, -7177, -217, -7233, -243, -7307, -231, -7366, -126, -7412, -100, -7444, -53, -7511, -6, -7537, -15, -7580, 8, -7629, 42, -7658, 26, -7742, 40, -7767, 83, -7786, 81, -7886, 138, -7899, 169, -7862, 177, -7866, 227, -7843, 263, -7793, 270, -7751, 333, -7713, 385, -7750, 409, -7731, 467, -7753, 558, -7732, 585, -7748, 669, -7788, 722, -7775, 771, -7743, 764, -7724, 794, -7747, 852, -7735, 867, -7684, 864, -7609, 934, -7567, 944, -7566, 977, -7548, 1062, -7491, 1108, -7428, 1110, -7420, 1131, -7341, 1123, -7263, 1173, -7224, 1196, -7175, 1244, -7140, 1238, -7114, 1211, -7133, 1178, -7197, 1161, -7223, 1111, -7261, 1082, -7291, 1045, -7303, 974, -7330, 915, -7279, 909, -7266, 863, -7244, 841, -7236, 800, -7248, 763, -7244, 742, -7220, 734, -7196, 699, -7067, 709, -7009, 696, -6939, 610, -6899, 621, -6827, 615, -6770, 627, -6734, 610, -6752, 556, -6774, 522, -6782, 450, -6762, 384, -6734, 354, -6730, 332, -6781, 282, -6745, 260, -6718, 225},
+	}},
+	{"CR", [][]int16{
+		{-8255, 957, -8293, 948, -8293, 907, -8272, 893, -8287, 881, -8283, 863, -8291, 842, -8297, 823, -8351, 845, -8371, 866, -8360, 883, -8363, 905, -8391, 929, -8430, 949, -8465, 962, -8471, 991, -8498, 1009, -8491, 980, -8511, 956, -8534, 983, -8566, 993, -8580, 1013, -8579, 1044, -8566, 1075, -8594, 1090, -8571, 1109, -8556, 1122, -8490, 1095, -8467, 1108, -8436, 1100, -8419, 1079, -8390, 1073, -8366, 1094, -8340, 1040, -8302, 999},
+	}},
+	{"CU", [][]int16{
+		{-8227, 2319, -8140, 2312, -8062, 2311, -7968, 2277, -7928, 2240, -7835, 2251, -7799, 2228, -7715, 2166, -7652, 2121, -7619, 2122, -7560, 2102, -7567, 2074, -7493, 2069, -7418, 2028, -7430, 2005, -7496, 1992, -7563, 1987, -7632, 1995, -7776, 1986, -7709, 2041, -7749, 2067, -7814, 2074, -7848, 2103, -7872, 2160, -7928, 2156, -8022, 2183, -8052, 2204, -8182, 2219, -8217, 2239, -8180, 2264, -8278, 2269, -8349, 2217, -8391, 2215, -8405, 2191, -8455, 2180, -8497, 2190, -8445, 2220, -8423, 2257, -8378, 2279, -8327, 2298, -8251, 2308},
+	}},
+	{"CY", [][]int16{
+		{3273, 3514, 3280, 3515, 3295, 3539, 3367, 3537, 3458, 3567, 3390, 3525, 3397, 3506, 3387, 3509, 3368, 3502, 3353, 3504, 3348, 3500, 3346, 3510, 3338, 3516, 3319, 3517, 3292, 3509},
+	}},
+	{"CY", [][]int16{
+		{3273, 3514, 3292, 3509, 3319, 3517, 3338, 3516, 3346, 3510, 3348, 3500, 3353, 3504, 3368, 3502, 3387, 3509, 3397, 3506, 3400, 3498, 3298, 3457, 3249, 3470, 3226, 3510},
+	}},
+	{"CZ", [][]int16{
+		{1502, 5111, 1549, 5078, 1624, 5070, 1618, 5042, 1672, 5022, 1687, 5047, 1755, 5036, 1765, 5005, 1839, 4999, 1885, 4950, 1855, 4950, 1840, 4932, 1817, 4927, 1810, 4904, 1791, 4900, 1789, 4890, 1755, 4880, 1710, 4882, 1696, 4860, 1650, 4879, 1603, 4873, 1525, 4904, 1490, 4896, 1434, 4856, 1360, 4888, 1303, 4931, 1252, 4955, 1242, 4997, 1224, 5027, 1297, 5048, 1334, 5073, 1406, 5093, 1431, 5112, 1457, 5100},
+	}},
+	{"DE", [][]int16{
+		{1412, 5376, 1435, 5325, 1407, 5298, 1444, 5262, 1469, 5209, 1461, 5175, 1502, 5111, 1457, 5100, 1431, 5112, 1406, 5093, 1334, 5073, 1297, 5048, 1224, 5027, 1242, 4997, 1252, 4955, 1303, 4931, 1360, 4888, 1324, 4842, 1288, 4829, 1303, 4764, 1293, 4747, 1262, 4767, 1214, 4770, 1143, 4752, 1054, 4757, 1040, 4730, 990, 4758, 959, 4753, 852, 4783, 832, 4761, 747, 4762, 759, 4833, 810, 4902, 666, 4920, 619, 4946, 624, 4990, 604, 5013, 616, 5080, 599, 5185, 659, 5185, 684, 5223, 709, 5314, 691, 5348, 710, 5369, 794, 5375, 812, 5353, 880, 5402, 857, 5440, 853, 5496, 928, 5483, 992, 5498, 994, 5460, 1095, 5436, 1094, 5401, 1196, 5420, 1252, 5447, 1365, 5408},
+	}},
+	{"DJ", [][]int16{
+		{4235, 1254, 4278, 1246, 4308, 1270, 4332, 1239, 4329, 1197, 4272, 1174, 4315, 1146, 4278, 1093, 4255, 1111, 4231, 1103, 4176, 1105, 4174, 1136, 4166, 1163, 4200, 1210},
+	}},
+	{"DK", [][]int16{
+		{992, 5498, 928, 5483, 853, 5496, 812, 5552, 809, 5654, 826, 5681, 854, 5711, 942, 5717, 978, 5745, 1058, 5773, 1055, 5722, 1025, 5689, 1037, 5661, 1091, 5646, 1067, 5608, 1037, 5619, 965, 5547},
+	}},
+	{"DK", [][]int16{
+		{1237, 5611, 1269, 5561, 1209, 5480, 1104, 5536, 1090, 5578},
+	}},
+	{"DO", [][]int16{
+		{-7171, 1804, -7169, 1832, -7195, 1862, -7170, 1879, -7162, 1917, -7171, 1971, -7159, 1988, -7081, 1988, -7021, 1962, -6995, 1965, -6977, 1929, -6922, 1931, -6925, 1902, -6881, 1898, -6832, 1861, -6869, 1821, -6916, 1842, -6962, 1838, -6995, 1843, -7013, 1825, -7052, 1818, -7067, 1843, -7100, 1828, -7140, 1760, -7166, 1776},
+	}},
+	{"DZ", [][]int16{
+		{-868, 2740, -867, 2759, -867, 2766, -867, 2884, -706, 2958, -606, 2973, -524, 3000, -486, 3050, -369, 3090, -365, 3164, -307, 3172, -262, 3209, -131, 3226, -112, 3265, -139, 3286, -173, 3392, -179, 3453, -217, 3517, -121, 3571, -13, 3589, 50, 3630, 147, 3661, 316, 3678, 482, 3687, 532, 3672, 626, 3711, 733, 3712, 774, 3689, 842, 3695, 822, 3643, 838, 3548, 814, 3466, 752, 3410, 761, 3334, 843, 3275, 844, 3251, 906, 3210, 948, 3031, 981, 2942, 986, 2896, 968, 2814, 976, 2769, 963, 2714, 972, 2651, 932, 2609, 991, 2537, 995, 2494, 1030, 2438, 1077, 2456, 1156, 2410, 1200, 2347, 857, 2157, 568, 1960, 427, 1916, 316, 1906, 315, 1969, 268, 1986, 206, 2014, 182, 2061, -155, 2279, -492, 2497},
+	}},
+	{"EC", [][]int16{
+		{-7537, -15, -7523, -91, -7554, -156, -7664, -261, -7784, -300, -7845, -387, -7864, -455, -7921, -496, -7962, -445, -8003, -435, -8044, -443, -8047, -406, -8018, -382, -8030, -340, -7977, -266, -7999, -222, -8037, -269, -8097, -225, -8076, -197, -8093, -106, -8058, -91, -8040, -28, -8002, 36, -8009, 77, -7954, 98, -7886, 138, -7786, 81, -7767, 83, -7742, 40, -7658, 26, -7629, 42, -7580, 8},
+	}},
+	{"EE", [][]int16{
+		{2798, 5948, 2813, 5930, 2742, 5872, 2772, 5779, 2729, 5747, 2646, 5748, 2560, 5785, 2516, 5797, 2431, 5779, 2443, 5838, 2406, 5826, 2343, 5861, 2334, 5919, 2460, 5947, 2586, 5961, 2695, 5945},
+	}},
+	{"EG", [][]int16{
+		{3687, 2200, 3290, 2200, 2902, 2200, 2500, 2200, 2500, 2568, 2500, 2924, 2470, 3004, 2496, 3066, 2480, 3109, 2516, 3157, 2650, 3159, 2746, 3132, 2845, 3103, 2891, 3087, 2968, 3119, 3010, 3147, 3098, 3156, 3169, 3143, 3196, 3093, 3219, 3126, 3299, 3102, 3377, 3097, 3427, 3122, 3482, 2976, 3492, 2950, 3464, 2910, 3443, 2834, 3415, 2782, 3392, 2765, 3359, 2797, 3314, 2842, 3242, 2985, 3232, 2976, 3273, 2871, 3335, 2770, 3410, 2614, 3447, 2560, 3480, 2503, 3569, 2393, 3549, 2375, 3553, 2310, 3669, 2220},
+	}},
+	{"EH", [][]int16{
+		{-867, 2766, -867, 2759, -868, 2740, -869, 2588, -1197, 2593, -1194, 2337, -1287, 2328, -1312, 2277, -1293, 2133, -1685, 2133, -1706, 2100, -1702, 2142, -1700, 2142, -1475, 2150, -1463, 2186, -1422, 2231, -1389, 2369, -1250, 2477, -1203, 2603, -1172, 2610, -1139, 2688, -1055, 2699, -1019, 2686, -974, 2686, -941, 2709, -879, 2712, -882, 2766},
+	}},
+	{"ER", [][]int16{
+		{3643, 1442, 3632, 1482, 3675, 1629, 3685, 1696, 3717, 1726, 3790, 1743, 3841, 1800, 3899, 1684, 3927, 1592, 3981, 1544, 4118, 1449, 4173, 1392, 4228, 1334, 4259, 1300, 4308, 1270, 4278, 1246, 4235, 1254, 4201, 1287, 4160, 1345, 4116, 1377, 4090, 1412, 4003, 1452, 3934, 1453, 3910, 1474, 3851, 1451, 3791, 1496, 3759, 1421},
+	}},
+	{"ES", [][]int16{
+		{-745, 3710, -754, 3743, -717, 3780, -703, 3808, -737, 3837, -710, 3903, -750, 3963, -707, 3971, -703, 4018, -686, 4033, -685, 4111, -639, 4138, -667, 4188, -725, 4192, -742, 4179, -801, 4179, -826, 4228, -867, 4213, -903, 4188, -898, 4259, -939, 4303, -798, 4375, -675, 4357, -541, 4357, -435, 4340, -352, 4346, -190, 4342, -150, 4303, 34, 4258, 70, 4280, 183, 4234, 299, 4247, 304, 4189, 209, 4123, 81, 4101, 72, 4068, 11, 4012, -28, 3931, 11, 3874, -47, 3829, -68, 3764, -144, 3744, -215, 3667, -342, 3666, -437, 3668, -500, 3632, -538, 3595, -587, 3603, -624, 3637, -652, 3694},
+	}},
+	{"ET", [][]int16{
+		{4779, 800, 4496, 500, 4366, 496, 4277, 425, 4213, 423, 4186, 392, 4117, 392, 4077, 426, 3985, 384, 3956, 342, 3889, 350, 3867, 362, 3844, 359, 3812, 360, 3686, 445, 3616, 445, 3582, 478, 3582, 534, 3530, 551, 3471, 659, 3425, 683, 3408, 723, 3357, 771, 3295, 778, 3329, 835, 3383, 838, 3397, 868, 3396, 958, 3426, 1063, 3473, 1091, 3483, 1132, 3526, 1208, 3586, 1258, 3627, 1356, 3643, 1442, 3759, 1421, 3791, 1496, 3851, 1451, 3910, 1474, 3934, 1453, 4003, 1452, 4090, 1412, 4116, 1377, 4160, 1345, 4201, 1287, 4235, 1254, 4200, 1210, 4166, 1163, 4174, 1136, 4176, 1105, 4231, 1103, 4255, 1111, 4278, 1093, 4256, 1057, 4293, 1002, 4330, 954, 4368, 918, 4695, 800},
+	}},
+	{"FI", [][]int16{
+		{2859, 6906, 2845, 6836, 2998, 6770, 2905, 6694, 3022, 6581, 2954, 6495, 3044, 6420, 3004, 6355, 3152, 6287, 3114, 6236, 3021, 6178, 2807, 6050, 2626, 6042, 2450, 6006, 2287, 5985, 2229, 6039, 2132, 6072, 2154, 6171, 2106, 6261, 2154, 6319, 2244, 6382, 2473, 6490, 2540, 6511, 2529, 6553, 2390, 6601, 2357, 6640, 2354, 6794, 2198, 6862, 2065, 6911, 2124, 6937, 2236, 6884, 2366, 6889, 2474, 6865, 2569, 6909, 2618, 6983, 2773, 7016, 2902, 6977},
+	}},
+	{"FJ", [][]int16{
+		{18000, -1607, 18000, -1656, 17936, -1680, 17873, -1701, 17860, -1664, 17910, -1643, 17941, -1638},
+	}},
+	{"FJ", [][]int16{
+		{17813, -1750, 17837, -1734, 17872, -1763, 17855, -1815, 17793, -1829, 17738, -1816, 17729, -1772, 17767, -1738},
+	}},
+	{"FJ", [][]int16{
+		{-17979, -1602, -17992, -1650, -18000, -1656, -18000, -1607},
+	}},
+	{"FK", [][]int16{
+		{-6120, -5185, -6000, -5125, -5915, -5150, -5855, -5110, -5775, -5155, -5805, -5190, -5940, -5220, -5985, -5185, -6070, -5230},
+	}},
+	{"FR", [][]int16{
+		{-5166, 416, -5225, 324, -5256, 250, -5294, 212, -5342, 205, -5355, 233, -5378, 238, -5409, 211, -5452, 231, -5427, 273, -5418, 319, -5401, 362, -5440, 421, -5448, 490, -5396, 576, -5362, 565, -5288, 541, -5182, 457},
+	}},
+	{"FR", [][]int16{
+		{619, 4946, 666, 4920, 810, 4902, 759, 4833, 747, 4762, 719, 4745, 674, 4754, 677, 4729, 604, 4673, 602, 4627, 650, 4643, 684, 4599, 680, 4571, 710, 4533, 675, 4503, 701, 4425, 755, 4413, 744, 4369, 653, 4313, 456, 4340, 310, 4308, 299, 4247, 183, 4234, 70, 4280, 34, 4258, -150, 4303, -190, 4342, -138, 4402, -119, 4601, -223, 4706, -296, 4757, -449, 4795, -459, 4868, -330, 4890, -162, 4864, -193, 4978, -99, 4935, 134, 5013, 164, 5095, 251, 5115, 266, 5080, 312, 5078, 359, 5038, 429, 4991, 480, 4999, 567, 4953, 590, 4944},
+	}},
+	{"FR", [][]int16{
+		{875, 4263, 939, 4301, 956, 4215, 923, 4138, 878, 4158, 854, 4226},
+	}},
+	{"GA", [][]int16{
+		{1128, 226, 1175, 233, 1236, 219, 1295, 232, 1308, 227, 1300, 183, 1328, 131, 1403, 140, 1428, 120, 1384, 4, 1432, -55, 1443, -133, 1430, -200, 1399, -247, 1311, -243, 1258, -195, 1250, -239, 1182, -251, 1148, -277, 1186, -343, 1109, -398, 1007, -297, 941, -214, 880, -111, 883, -78, 905, -46, 929, 27, 949, 101, 983, 107, 1129, 106},
+	}},
+	{"GB", [][]int16{
+		{-620, 5387, -695, 5407, -757, 5406, -737, 5460, -757, 5513, -673, 5517, -566, 5455},
+	}},
+	{"GB", [][]int16{
+		{-309, 5340, -295, 5398, -361, 5460, -363, 5462, -484, 5479, -508, 5506, -472, 5551, -505, 5578, -559, 5531, -564, 5628, -615, 5679, -579, 5782, -501, 5863, -421, 5855, -301, 5864, -407, 5755, -306, 5769, -196, 5768, -222, 5687, -312, 5597, -209, 5591, -201, 5580, -111, 5462, -43, 5446, 18, 5333, 47, 5293, 168, 5274, 156, 5210, 105, 5181, 145, 5129, 55, 5077, -79, 5077, -249, 5050, -296, 5070, -362, 5023, -454, 5034, -525, 4996, -578, 5016, -431, 5121, -341, 5143, -342, 5143, -498, 5159, -527, 5199, -422, 5230, -477, 5284, -458, 5350},
+	}},
+	{"GE", [][]int16{
+		{3996, 4343, 4008, 4355, 4092, 4338, 4239, 4322, 4376, 4274, 4393, 4255, 4454, 4271, 4547, 4250, 4578, 4209, 4640, 4186, 4615, 4172, 4664, 4118, 4650, 4106, 4596, 4112, 4522, 4141, 4497, 4125, 4358, 4109, 4262, 4158, 4155, 4154, 4170, 4196, 4145, 4265, 4088, 4301, 4032, 4313},
+	}},
+	{"GH", [][]int16{
+		{2, 1102, -5, 1071, 37, 1019, 37, 947, 46, 868, 71, 831, 49, 741, 57, 691, 84, 628, 106, 593, -51, 534, -106, 500, -196, 471, -286, 499, -281, 539, -324, 625, -298, 738, -256, 822, -283, 964, -296, 1040, -294, 1096, -120, 1101, -76, 1094, -44, 1110},
+	}},
+	{"GL", [][]int16{
+		{-4676, 8263, -4341, 8323, -3990, 8318, -3862, 8355, -3509, 8365, -2710, 8352, -2085, 8273, -2269, 8234, -2652, 8230, -3190, 8220, -3140, 8202, -2786, 8213, -2484, 8179, -2290, 8209, -2207, 8173, -2317, 8115, -2062, 8152, -1577, 8191, -1277, 8172, -1221, 8129, -1629, 8058, -1685, 8035, -2005, 8018, -1773, 8013, -1890, 7940, -1970, 7875, -1967, 7764, -1847, 7699, -2004, 7694, -2168, 7663, -1983, 7610, -1960, 7525, -2067, 7516, -1937, 7430, -2159, 7422, -2043, 7382, -2076, 7346, -2217, 7331, -2357, 7331, -2231, 7263, -2230, 7218, -2428, 7260, -2479, 7233, -2344, 7208, -2213, 7147, -2175, 7066, -2354, 7047, -2431, 7086, -2554, 7143, -2520, 7075, -2636, 7023, -2373, 7018, -2235, 7013, -2503, 6926, -2775, 6847, -3067, 6813, -3178, 6812, -3281, 6774, -3420, 6668, -3635, 6598, -3704, 6594, -3838, 6569, -3981, 6546, -4067, 6484, -4068, 6414, -4119, 6348, -4282, 6268, -4242, 6190, -4287, 6107, -4338, 6010, -4479, 6004, -4626, 6085, -4826, 6086, -4923, 6141, -4990, 6238, -5163, 6363, -5214, 6428, -5228, 6518, -5366, 6610, -5330, 6684, -5397, 6719, -5298, 6836, -5148, 6873, -5108, 6915, -5087, 6993, -5201, 6957, -5256, 6943, -5346, 6928, -5468, 6961, -5475, 7029, -5436, 7082, -5343, 7084, -5139, 7057, -5311, 7120, -5400, 7155, -5500, 7141, -5583, 7165, -5472, 7259, -5533, 7296, -5612, 7365, -5732, 7471, -5860, 7510, -5859, 7552, -6127, 7610, -6339, 7618, -6606, 7613, -6850, 7606, -6966, 7638, -7140, 7701, -6878, 7732, -6676, 7738, -7104, 7764, -7330, 7804, -7316, 7843, -6937, 7891, -6571, 7939, -6532, 7976, -6802, 8012, -6715, 8052, -6369, 8121, -6223, 8132, -6265, 8177, -6028, 8203, -5721, 8219, -5413, 8220, -5304, 8189, -5039, 8244, -4800, 8206, -4660, 8199, -4452, 8166, -4690, 8220},
+	}},
+	{"GM", [][]int16{
+		{-1671, 1359, -1562, 1362, -1540, 1386, -1508, 1388, -1469, 1363, -1438, 1363, -1405, 1379, -1384, 1351, -1428, 1328, -1471, 1330, -1514, 1351, -1551, 1328, -1569, 1327, -1593, 1313, -1684, 1315},
+	}},
+	{"GN", [][]int16{
+		{-1370, 1259, -1322, 1258, -1250, 1233, -1228, 1235, -1220, 1247, -1166, 1239, -1151, 1244, -1146, 1208, -1130, 1208, -1104, 1221, -1087, 1218, -1059, 1192, -1017, 1184, -989, 1206, -957, 1219, -933, 1233, -913, 1231, -891, 1209, -879, 1181, -838, 1139, -858, 1114, -862, 1081, -841, 1091, -828, 1079, -834, 1049, -803, 1021, -823, 1013, -831, 979, -808, 938, -783, 858, -820, 846, -830, 832, -822, 812, -828, 769, -844, 769, -872, 771, -893, 731, -921, 731, -940, 753, -934, 793, -976, 854, -1002, 843, -1023, 841, -1051, 835, -1049, 872, -1065, 898, -1062, 927, -1084, 969, -1112, 1005, -1192, 1005, -1215, 986, -1243, 984, -1260, 962, -1271, 934, -1325, 890, -1369, 949, -1407, 989, -1433, 1002, -1458, 1021, -1469, 1066, -1484, 1088, -1513, 1104, -1469, 1153, -1438, 1151, -1412, 1168, -1390, 1168, -1374, 1181, -1383, 1214, -1372, 1225},
+	}},
+	{"GQ", [][]int16{
+		{965, 228, 1128, 226, 1129, 106, 983, 107, 949, 101, 931, 116},
+	}},
+	{"GR", [][]int16{
+		{2629, 3530, 2616, 3500, 2472, 3492, 2474, 3508, 2351, 3528, 2370, 3571, 2425, 3537, 2503, 3542, 2577, 3535, 2575, 3518},
+	}},
+	{"GR", [][]int16{
+		{2295, 4134, 2369, 4131, 2449, 4158, 2520, 4123, 2611, 4133, 2612, 4183, 2660, 4156, 2629, 4094, 2606, 4082, 2545, 4085, 2493, 4095, 2371, 4069, 2441, 4012, 2390, 3996, 2334, 3996, 2281, 4048, 2263, 4026, 2285, 3966, 2335, 3919, 2297, 3897, 2353, 3851, 2403, 3822, 2404, 3766, 2312, 3792, 2341, 3741, 2277, 3731, 2315, 3642, 2249, 3641, 2167, 3684, 2130, 3764, 2112, 3831, 2073, 3877, 2022, 3934, 2015, 3962, 2062, 4011, 2067, 4044, 2100, 4058, 2102, 4084, 2167, 4093, 2206, 4115, 2260, 4113, 2276, 4130},
+	}},
+	{"GT", [][]int16{
+		{-9223, 1454, -9220, 1483, -9209, 1506, -9223, 1525, -9175, 1607, -9046, 1607, -9044, 1641, -9060, 1647, -9071, 1669, -9108, 1692, -9145, 1725, -9100, 1725, -9100, 1782, -9007, 1782, -8914, 1781, -8915, 1702, -8923, 1589, -8893, 1589, -8860, 1571, -8852, 1586, -8823, 1573, -8868, 1535, -8915, 1507, -8923, 1487, -8915, 1468, -8935, 1442, -8959, 1436, -8953, 1424, -8972, 1413, -9006, 1388, -9010, 1374, -9061, 1391, -9123, 1393, -9169, 1413},
+	}},
+	{"GW", [][]int16{
+		{-1668, 1238, -1615, 1255, -1582, 1252, -1555, 1263, -1370, 1259, -1372, 1225, -1383, 1214, -1374, 1181, -1390, 1168, -1412, 1168, -1438, 1151, -1469, 1153, -1513, 1104, -1566, 1146, -1609, 1152, -1631, 1181, -1631, 1196, -1661, 1217},
+	}},
+	{"GY", [][]int16{
+		{-5654, 190, -5678, 186, -5734, 195, -5766, 168, -5811, 151, -5843, 146, -5854, 127, -5903, 132, -5965, 179, -5972, 225, -5997, 276, -5982, 361, -5954, 396, -5977, 442, -6011, 457, -5998, 501, -6021, 524, -6073, 520, -6141, 596, -6114, 623, -6116, 670, -6054, 686, -6030, 704, -6064, 742, -6055, 778, -5976, 837, -5910, 800, -5848, 735, -5845, 683, -5808, 681, -5754, 632, -5715, 597, -5731, 507, -5791, 481, -5786, 458, -5804, 406, -5760, 333, -5728, 333, -5715, 277},
+	}},
+	{"HN", [][]int16{
+		{-8315, 1500, -8349, 1502, -8363, 1488, -8398, 1475, -8423, 1475, -8445, 1462, -8465, 1467, -8482, 1482, -8492, 1479, -8505, 1455, -8515, 1456, -8517, 1435, -8551, 1408, -8570, 1396, -8580, 1384, -8610, 1404, -8631, 1377, -8652, 1378, -8676, 1375, -8673, 1326, -8688, 1325, -8701, 1303, -8732, 1298, -8749, 1330, -8779, 1338, -8772, 1379, -8786, 1389, -8807, 1396, -8850, 1385, -8854, 1398, -8884, 1414, -8906, 1434, -8935, 1442, -8915, 1468, -8923, 1487, -8915, 1507, -8868, 1535, -8823, 1573, -8812, 1569, -8790, 1586, -8762, 1588, -8752, 1580, -8737, 1585, -8690, 1576, -8644, 1578, -8612, 1589, -8600, 1601, -8568, 1595, -8544, 1589, -8518, 1591, -8498, 1600, -8453, 1586, -8437, 1584, -8406, 1565, -8377, 1542, -8341, 1527},
+	}},
+	{"HR", [][]int16{
+		{1656, 4650, 1688, 4638, 1763, 4595, 1846, 4576, 1883, 4591, 1907, 4552, 1939, 4524, 1901, 4486, 1855, 4508, 1786, 4507, 1700, 4523, 1653, 4521, 1632, 4500, 1596, 4523, 1575, 4482, 1624, 4435, 1646, 4404, 1692, 4367, 1730, 4345, 1767, 4303, 1856, 4265, 1845, 4248, 1751, 4285, 1693, 4321, 1602, 4351, 1517, 4424, 1538, 4432, 1492, 4474, 1490, 4508, 1426, 4523, 1395, 4480, 1366, 4514, 1368, 4548, 1372, 4550, 1441, 4547, 1460, 4563, 1494, 4547, 1533, 4545, 1532, 4573, 1567, 4583, 1577, 4624},
+	}},
+	{"HT", [][]int16{
+		{-7171, 1971, -7162, 1917, -7170, 1879, -7195, 1862, -7169, 1832, -7171, 1804, -7237, 1821, -7284, 1815, -7345, 1822, -7392, 1803, -7446, 1834, -7437, 1866, -7345, 1853, -7269, 1845, -7233, 1867, -7279, 1910, -7278, 1948, -7342, 1964, -7319, 1992, -7258, 1987},
+	}},
+	{"HU", [][]int16{
+		{2209, 4842, 2264, 4815, 2271, 4788, 2210, 4767, 2163, 4699, 2102, 4632, 2022, 4613, 1960, 4617, 1883, 4591, 1846, 4576, 1763, 4595, 1688, 4638, 1656, 4650, 1637, 4684, 1620, 4685, 1653, 4750, 1634, 4771, 1690, 4771, 1698, 4812, 1749, 4787, 1786, 4776, 1870, 4788, 1878, 4808, 1917, 4811, 1966, 4827, 1977, 4820, 2024, 4833, 2047, 4856, 2080, 4862, 2187, 4832},
+	}},
+	{"ID", [][]int16{
+		{14100, -260, 14102, -586, 14103, -912, 14014, -830, 13913, -810, 13888, -838, 13761, -841, 13804, -760, 13867, -732, 13841, -623, 13793, -539, 13599, -455, 13516, -446, 13366, -354, 13337, -402, 13298, -411, 13276, -375, 13275, -331, 13199, -282, 13307, -246, 13378, -248, 13370, -221, 13223, -221, 13184, -162, 13094, -143, 13052, -94, 13187, -70, 13238, -37, 13399, -78, 13414, -115, 13442, -277, 13546, -337, 13629, -231, 13744, -170, 13833, -170, 13918, -205, 13993, -241},
+	}},
+	{"ID", [][]int16{
+		{12497, -889, 12507, -909, 12509, -939, 12444, -1014, 12358, -1036, 12346, -1024, 12355, -990, 12398, -929},
+	}},
+	{"ID", [][]int16{
+		{13421, -690, 13411, -614, 13429, -578, 13450, -545, 13473, -574, 13472, -621},
+	}},
+	{"ID", [][]int16{
+		{11788, 414, 11731, 323, 11805, 229, 11788, 183, 11900, 90, 11781, 78, 11748, 10, 11752, -80, 11656, -149, 11653, -248, 11615, -401, 11600, -366, 11486, -411, 11447, -350, 11376, -344, 11326, -312, 11207, -348, 11170, -299, 11105, -305, 11022, -293, 11007, -159, 10957, -131, 10909, -46, 10895, 42, 10907, 134, 10966, 201, 10983, 134, 11051, 77, 11116, 98, 11180, 90, 11238, 141, 11286, 150, 11381, 122, 11462, 143, 11513, 282, 11552, 317, 11587, 431, 11702, 431},
+	}},
+	{"ID", [][]int16{
+		{12937, -280, 13047, -309, 13083, -386, 12999, -345, 12916, -336, 12859, -343, 12790, -339, 12814, -284},
+	}},
+	{"ID", [][]int16{
+		{12687, -379, 12618, -361, 12599, -318, 12700, -313, 12725, -346},
+	}},
+	{"ID", [][]int16{
+		{12793, 217, 12800, 163, 12859, 154, 12869, 113, 12864, 26, 12812, 36, 12797, -25, 12838, -78, 12810, -90, 12770, -27, 12740, 101, 12760, 181},
+	}},
+	{"ID", [][]int16{
+		{12293, 88, 12408, 92, 12507, 164, 12524, 142, 12444, 43, 12369, 24, 12272, 43, 12106, 38, 12018, 24, 12004, -52, 12094, -141, 12148, -96, 12334, -62, 12326, -108, 12282, -93, 12239, -152, 12151, -190, 12245, -319, 12227, -353, 12317, -468, 12316, -534, 12263, -563, 12224, -528, 12272, -446, 12174, -485, 12149, -457, 12162, -419, 12090, -360, 12097, -263, 12031, -293, 12039, -410, 12043, -553, 11980, -567, 11937, -538, 11965, -446, 11950, -349, 11908, -349, 11877, -280, 11918, -215, 11932, -135, 11983, 15, 12004, 57, 12089, 131, 12167, 101},
+	}},
+	{"ID", [][]int16{
+		{12030, -1026, 11897, -956, 11990, -936, 12043, -967, 12078, -997, 12072, -1024},
+	}},
+	{"ID", [][]int16{
+		{12134, -854, 12201, -846, 12290, -809, 12276, -865, 12125, -893, 11992, -881, 11992, -844, 12072, -824},
+	}},
+	{"ID", [][]int16{
+		{11826, -836, 11888, -828, 11913, -871, 11797, -891, 11728, -904, 11674, -903, 11708, -846, 11763, -845, 11790, -810},
+	}},
+	{"ID", [][]int16{
+		{10849, -642, 10862, -678, 11054, -688, 11076, -647, 11261, -695, 11298, -759, 11448, -778, 11571, -837, 11456, -875, 11346, -835, 11256, -838, 11152, -830, 11059, -812, 10943, -774, 10869, -764, 10828, -777, 10645, -735, 10628, -692, 10537, -685, 10605, -590, 10727, -595, 10807, -635},
+	}},
+	{"ID", [][]int16{
+		{10437, -108, 10454, -178, 10489, -234, 10562, -243, 10611, -306, 10586, -431, 10582, -585, 10471, -587, 10387, -504, 10258, -422, 10216, -361, 10140, -280, 10090, -205, 10014, -65, 9926, 18, 9897, 104, 9860, 182, 9770, 245, 9718, 331, 9642, 387, 9538, 497, 9529, 548, 9594, 544, 9748, 525, 9837, 427, 9914, 359, 9969, 317, 10064, 210, 10166, 208, 10250, 140, 10308, 56, 10384, 10, 10344, -71, 10401, -106},
+	}},
+	{"IE", [][]int16{
+		{-620, 5387, -603, 5315, -679, 5226, -856, 5167, -998, 5182, -917, 5286, -969, 5388, -833, 5466, -757, 5513, -737, 5460, -757, 5406, -695, 5407},
+	}},
+	{"IL", [][]int16{
+		{3572, 3271, 3555, 3239, 3518, 3253, 3497, 3187, 3523, 3175, 3497, 3162, 3493, 3135, 3540, 3149, 3542, 3110, 3492, 2950, 3482, 2976, 3427, 3122, 3456, 3155, 3449, 3161, 3475, 3207, 3496, 3283, 3510, 3308, 3513, 3309, 3546, 3309, 3555, 3326, 3582, 3328, 3584, 3287, 3570, 3272},
+	}},
+	{"IN", [][]int16{
+		{9733, 2826, 9740, 2788, 9705, 2770, 9713, 2708, 9642, 2726, 9512, 2657, 9516, 2600, 9460, 2516, 9455, 2468, 9411, 2385, 9333, 2408, 9329, 2304, 9306, 2270, 9317, 2228, 9267, 2204, 9215, 2363, 9187, 2362, 9171, 2299, 9116, 2350, 9147, 2407, 9192, 2413, 9238, 2498, 9180, 2515, 9087, 2513, 8992, 2527, 8983, 2597, 8936, 2601, 8856, 2645, 8821, 2577, 8893, 2524, 8831, 2487, 8808, 2450, 8870, 2423, 8853, 2363, 8888, 2288, 8903, 2206, 8889, 2169, 8821, 2170, 8698, 2150, 8703, 2074, 8650, 2015, 8506, 1948, 8394, 1830, 8319, 1767, 8219, 1702, 8219, 1656, 8169, 1631, 8079, 1595, 8032, 1590, 8003, 1514, 8023, 1384, 8029, 1301, 7986, 1206, 7986, 1036, 7934, 1031, 7889, 955, 7919, 922, 7828, 893, 7794, 825, 7754, 797, 7659, 890, 7613, 1030, 7575, 1131, 7540, 1178, 7486, 1274, 7462, 1399, 7444, 1462, 7353, 1599, 7312, 1793, 7282, 1921, 7282, 2042, 7263, 2136, 7118, 2076, 7047, 2088, 6916, 2209, 6964, 2245, 6935, 2284, 6818, 2369, 6884, 2436, 7104, 2436, 7084, 2522, 7028, 2572, 7017, 2649, 6951, 2694, 7062, 2799, 7178, 2791, 7282, 2896, 7345, 2998, 7442, 3098, 7441, 3169, 7526, 3227, 7445, 3276, 7410, 3344, 7375, 3432, 7424, 3475, 7576, 3450, 7687, 3465, 7784, 3549, 7891, 3432, 7881, 3351, 7921, 3299, 7918, 3248, 7846, 3262, 7874, 3152, 7972, 3088, 8111, 3018, 8048, 2973, 8009, 2879, 8106, 2842, 8200, 2793, 8330, 2736, 8468, 2723, 8525, 2673, 8602, 2663, 8723, 2640, 8806, 2641, 8817, 2681, 8804, 2745, 8812, 2788, 8873, 2809, 8881, 2730, 8884, 2710, 8974, 2672, 9037, 2688, 9122, 2681, 9203, 2684, 9210, 2745, 9170, 2777, 9250, 2790, 9341, 2864, 9457, 2928, 9540, 2903, 9612, 2945, 9659, 2883, 9625, 2841},
+	}},
+	{"IQ", [][]int16{
+		{3920, 3216, 3879, 3338, 4101, 3442, 4138, 3563, 4129, 3636, 4184, 3661, 4235, 3723, 4278, 3739, 4394, 3726, 4429, 3700, 4477, 3717, 4542, 3598, 4608, 3568, 4615, 3509, 4565, 3475, 4542, 3397, 4611, 3302, 4733, 3247, 4785, 3171, 4769, 3098, 4800, 3099, 4801, 3045, 4857, 2993, 4797, 2998, 4730, 3006, 4657, 2910, 4471, 2918, 4189, 3119, 4040, 3189},
+	}},
+	{"IR", [][]int16{
+		{4857, 2993, 4801, 3045, 4800, 3099, 4769, 3098, 4785, 3171, 4733, 3247, 4611, 3302, 4542, 3397, 4565, 3475, 4615, 3509, 4608, 3568, 4542, 3598, 4477, 3717, 4423, 3797, 4442, 3828, 4411, 3943, 4479, 3971, 4495, 3934, 4546, 3887, 4614, 3874, 4651, 3877, 4769, 3951, 4806, 3958, 4836, 3929, 4801, 3879, 4863, 3827, 4888, 3832, 4920, 3758, 5015, 3737, 5084, 3687, 5226, 3670, 5383, 3697, 5392, 3720, 5480, 3739, 5551, 3796, 5618, 3794, 5662, 3812, 5733, 3803, 5844, 3752, 5923, 3741, 6038, 3653, 6112, 3649, 6121, 3565, 6080, 3440, 6053, 3368, 6096, 3353, 6054, 3298, 6086, 3218, 6094, 3155, 6170, 3138, 6178, 3074, 6087, 2983, 6137, 2930, 6177, 2870, 6273, 2826, 6276, 2738, 6323, 2722, 6332, 2676, 6187, 2624, 6150, 2508, 5962, 2538, 5853, 2561, 5740, 2574, 5697, 2697, 5649, 2714, 5572, 2696, 5472, 2648, 5349, 2681, 5248, 2758, 5152, 2787, 5085, 2881, 5012, 3015, 4958, 2999, 4894, 3032},
+	}},
+	{"IS", [][]int16{
+		{-1451, 6646, -1474, 6581, -1361, 6513, -1491, 6436, -1779, 6368, -1866, 6350, -1997, 6364, -2276, 6396, -2178, 6440, -2396, 6489, -2218, 6508, -2223, 6538, -2433, 6561, -2365, 6626, -2213, 6641, -2058, 6573, -1906, 6628, -1780, 6599, -1617, 6653},
+	}},
+	{"IT", [][]int16{
+		{1044, 4689, 1105, 4675, 1116, 4694, 1215, 4712, 1238, 4677, 1381, 4651, 1370, 4602, 1394, 4559, 1314, 4574, 1233, 4538, 1238, 4489, 1226, 4460, 1259, 4409, 1353, 4359, 1403, 4276, 1514, 4196, 1593, 4196, 1617, 4174, 1589, 4154, 1679, 4118, 1752, 4088, 1838, 4036, 1848, 4017, 1829, 3981, 1774, 4028, 1687, 4044, 1645, 3980, 1717, 3942, 1705, 3890, 1664, 3884, 1610, 3799, 1568, 3791, 1569, 3821, 1589, 3875, 1611, 3896, 1572, 3954, 1541, 4005, 1500, 4017, 1470, 4060, 1406, 4079, 1363, 4119, 1289, 4125, 1211, 4170, 1119, 4236, 1051, 4293, 1020, 4392, 970, 4404, 889, 4437, 843, 4423, 785, 4377, 744, 4369, 755, 4413, 701, 4425, 675, 4503, 710, 4533, 680, 4571, 684, 4599, 727, 4578, 776, 4582, 832, 4616, 849, 4601, 897, 4604, 918, 4644, 992, 4631, 1036, 4648},
+	}},
+	{"IT", [][]int16{
+		{1476, 3814, 1552, 3823, 1516, 3744, 1531, 3713, 1510, 3662, 1434, 3700, 1383, 3710, 1243, 3761, 1257, 3813, 1374, 3803},
+	}},
+	{"IT", [][]int16{
+		{871, 4090, 921, 4121, 981, 4050, 967, 3918, 921, 3924, 881, 3891, 843, 3917, 839, 4038, 816, 4095},
+	}},
+	{"JM", [][]int16{
+		{-7757, 1849, -7690, 1840, -7637, 1816, -7620, 1789, -7690, 1787, -7721, 1770, -7777, 1786, -7834, 1823, -7822, 1845, -7780, 1852},
+	}},
+	{"JO", [][]int16{
+		{3555, 3239, 3572, 3271, 3683, 3231, 3879, 3338, 3920, 3216, 3900, 3201, 3700, 3151, 3800, 3051, 3767, 3034, 3750, 3000, 3674, 2987, 3650, 2951, 3607, 2920, 3496, 2936, 3492, 2950, 3542, 3110, 3540, 3149, 3555, 3178},
+	}},
+	{"JP", [][]int16{
+		{14188, 3918, 14096, 3817, 14098, 3714, 14060, 3634, 14077, 3584, 14025, 3514, 13898, 3467, 13722, 3461, 13579, 3346, 13512, 3385, 13508, 3460, 13334, 3438, 13216, 3390, 13099, 3389, 13200, 3315, 13133, 3145, 13069, 3103, 13020, 3142, 13045, 3232, 12981, 3261, 12941, 3330, 13035, 3360, 13088, 3423, 13188, 3475, 13262, 3543, 13461, 3573, 13568, 3553, 13672, 3730, 13739, 3683, 13886, 3783, 13943, 3822, 14005, 3944, 13988, 4056, 14031, 4120, 14137, 4138, 14191, 3999},
+	}},
+	{"JP", [][]int16{
+		{14461, 4396, 14532, 4438, 14554, 4326, 14406, 4299, 14318, 4200, 14161, 4268, 14107, 4158, 13996, 4157, 13982, 4256, 14031, 4333, 14138, 4339, 14167, 4477, 14197, 4555, 14314, 4451, 14391, 4417},
+	}},
+	{"JP", [][]int16{
+		{13237, 3346, 13292, 3406, 13349, 3394, 13390, 3436, 13464, 3415, 13477, 3381, 13420, 3320, 13379, 3352, 13328, 3329, 13301, 3270, 13236, 3299},
+	}},
+	{"KE", [][]int16{
+		{3920, -468, 3777, -368, 3770, -310, 3407, -106, 3390, -95, 3389, 11, 3418, 52, 3467, 118, 3504, 191, 3460, 305, 3448, 356, 3401, 425, 3462, 485, 3530, 551, 3582, 534, 3582, 478, 3616, 445, 3686, 445, 3812, 360, 3844, 359, 3867, 362, 3889, 350, 3956, 342, 3985, 384, 4077, 426, 4117, 392, 4186, 392, 4098, 278, 4099, -86, 4159, -168, 4088, -208, 4064, -250, 4026, -257, 4012, -328, 3980, -368, 3960, -435},
+	}},
+	{"KG", [][]int16{
+		{7096, 4227, 7119, 4270, 7184, 4285, 7349, 4250, 7365, 4309, 7421, 4330, 7564, 4288, 7600, 4299, 7766, 4296, 7914, 4286, 7964, 4250, 8026, 4235, 8012, 4212, 7854, 4158, 7819, 4119, 7690, 4107, 7653, 4043, 7547, 4056, 7478, 4037, 7382, 3989, 7396, 3966, 7368, 3943, 7178, 3928, 7055, 3960, 6946, 3953, 6956, 4010, 7065, 3994, 7101, 4024, 7177, 4015, 7306, 4087, 7187, 4139, 7116, 4114, 7042, 4152, 7126, 4217},
+	}},
+	{"KH", [][]int16{
+		{10258, 1219, 10235, 1339, 10299, 1423, 10428, 1442, 10522, 1427, 10604, 1388, 10650, 1457, 10738, 1420, 10761, 1354, 10749, 1234, 10581, 1157, 10625, 1096, 10520, 1089, 10433, 1049, 10350, 1063, 10309, 1115},
+	}},
+	{"KP", [][]int16{
+		{13064, 4240, 13078, 4222, 13040, 4228, 12997, 4194, 12967, 4160, 12971, 4088, 12919, 4066, 12901, 4049, 12863, 4019, 12797, 4003, 12753, 3976, 12750, 3932, 12739, 3921, 12778, 3905, 12835, 3861, 12821, 3837, 12778, 3830, 12707, 3826, 12668, 3780, 12624, 3784, 12617, 3775, 12569, 3794, 12557, 3775, 12528, 3767, 12524, 3786, 12498, 3795, 12471, 3811, 12499, 3855, 12522, 3867, 12513, 3885, 12539, 3939, 12532, 3955, 12474, 3966, 12427, 3993, 12508, 4057, 12618, 4111, 12687, 4182, 12734, 4150, 12821, 4147, 12805, 4199, 12960, 4242, 12999, 4299},
+	}},
+	{"KR", [][]int16{
+		{12617, 3775, 12624, 3784, 12668, 3780, 12707, 3826, 12778, 3830, 12821, 3837, 12835, 3861, 12921, 3743, 12946, 3678, 12947, 3563, 12909, 3508, 12819, 3489, 12739, 3448, 12649, 3439, 12637, 3493, 12656, 3568, 12612, 3673, 12686, 3689},
+	}},
+	{"KW", [][]int16{
+		{4797, 2998, 4818, 2953, 4809, 2931, 4842, 2855, 4771, 2853, 4746, 2900, 4657, 2910, 4730, 3006},
+	}},
+	{"KZ", [][]int16{
+		{8736, 4921, 8660, 4855, 8577, 4846, 8572, 4745, 8516, 4700, 8318, 4733, 8246, 4554, 8195, 4532, 7997, 4492, 8087, 4318, 8018, 4292, 8026, 4235, 7964, 4250, 7914, 4286, 7766, 4296, 7600, 4299, 7564, 4288, 7421, 4330, 7365, 4309, 7349, 4250, 7184, 4285, 7119, 4270, 7096, 4227, 7039, 4208, 6907, 4138, 6863, 4067, 6826, 4066, 6799, 4114, 6671, 4117, 6651, 4199, 6602, 4199, 6610, 4300, 6490, 4373, 6319, 4365, 6201, 4350, 6106, 4441, 6024, 4478, 5869, 4550, 5850, 4559, 5593, 4500, 5597, 4131, 5546, 4126, 5476, 4204, 5408, 4232, 5294, 4212, 5250, 4178, 5245, 4203, 5269, 4244, 5250, 4279, 5134, 4313, 5089, 4403, 5034, 4428, 5031, 4461, 5128, 4451, 5132, 4525, 5217, 4541, 5304, 4526, 5322, 4623, 5304, 4685, 5204, 4680, 5119, 4705, 5003, 4661, 4910, 4640, 4859, 4656, 4869, 4708, 4806, 4774, 4732, 4772, 4647, 4839, 4704, 4915, 4675, 4936, 4755, 5045, 4858, 4987, 4870, 5061, 5077, 5169, 5233, 5172, 5453, 5103, 5572, 5062, 5678, 5104, 5836, 5106, 5964, 5055, 5993, 5084, 6134, 5080, 6159, 5127, 5997, 5196, 6093, 5245, 6074, 5272, 6170, 5298, 6098, 5366, 6144, 5401, 6518, 5435, 6567, 5460, 6817, 5497, 6907, 5539, 7087, 5517, 7118, 5413, 7222, 5438, 7351, 5404, 7343, 5349, 7438, 5355, 7689, 5449, 7653, 5418, 7780, 5340, 8004, 5086, 8057, 5139, 8195, 5081, 8338, 5107, 8394, 5089, 8442, 5031, 8512, 5012, 8554, 4969, 8683, 4983},
+	}},
+	{"LA", [][]int16{
+		{10738, 1420, 10650, 1457, 10604, 1388, 10522, 1427, 10554, 1472, 10559, 1557, 10478, 1644, 10472, 1743, 10396, 1824, 10320, 1831, 10300, 1796, 10241, 1793, 10211, 1811, 10106, 1751, 10104, 1841, 10128, 1946, 10061, 1951, 10055, 2011, 10012, 2042, 10033, 2079, 10118, 2144, 10127, 2120, 10180, 2117, 10165, 2232, 10217, 2246, 10275, 2168, 10320, 2077, 10444, 2076, 10482, 1989, 10418, 1962, 10390, 1927, 10509, 1867, 10593, 1749, 10656, 1660, 10731, 1591, 10756, 1520},
+	}},
+	{"LB", [][]int16{
+		{3582, 3328, 3555, 3326, 3546, 3309, 3513, 3309, 3548, 3391, 3598, 3461, 3600, 3464, 3645, 3459, 3661, 3420, 3607, 3382},
+	}},
+	{"LK", [][]int16{
+		{8179, 752, 8164, 648, 8122, 620, 8035, 597, 7987, 676, 7970, 820, 8015, 982, 8084, 927, 8130, 856},
+	}},
+	{"LR", [][]int16{
+		{-844, 769, -849, 740, -839, 691, -860, 647, -831, 619, -799, 613, -757, 571, -754, 531, -764, 519, -771, 436, -797, 436, -900, 483, -991, 559, -1077, 614, -1144, 679, -1120, 711, -1115, 740, -1070, 794, -1023, 841, -1002, 843, -976, 854, -934, 793, -940, 753, -921, 731, -893, 731, -872, 771},
+	}},
+	{"LS", [][]int16{
+		{2898, -2896, 2933, -2926, 2902, -2974, 2885, -3007, 2829, -3023, 2811, -3055, 2775, -3065, 2700, -2988, 2753, -2924, 2807, -2885, 2854, -2865},
+	}},
+	{"LT", [][]int16{
+		{2649, 5562, 2659, 5517, 2577, 5485, 2554, 5428, 2445, 5391, 2348, 5391, 2324, 5422, 2273, 5433, 2265, 5458, 2276, 5486, 2232, 5502, 2127, 5519, 2106, 5603, 2220, 5634, 2388, 5627, 2486, 5637, 2500, 5616, 2553, 5610},
+	}},
+	{"LU", [][]int16{
+		{604, 5013, 624, 4990, 619, 4946, 590, 4944, 567, 4953, 578, 5009},
+	}},
+	{"LV", [][]int16{
+		{2729, 5747, 2777, 5724, 2786, 5676, 2818, 5617, 2710, 5578, 2649, 5562, 2553, 5610, 2500, 5616, 2486, 5637, 2388, 5627, 2220, 5634, 2106, 5603, 2109, 5678, 2158, 5741, 2252, 5775, 2332, 5701, 2412, 5703, 2431, 5779, 2516, 5797, 2560, 5785, 2646, 5748},
+	}},
+	{"LY", [][]int16{
+		{2500, 2200, 2500, 2000, 2385, 2000, 2384, 1958, 1985, 2150, 1586, 2341, 1485, 2286, 1414, 2249, 1358, 2304, 1200, 2347, 1156, 2410, 1077, 2456, 1030, 2438, 995, 2494, 991, 2537, 932, 2609, 972, 2651, 963, 2714, 976, 2769, 968, 2814, 986, 2896, 981, 2942, 948, 3031, 997, 3054, 1006, 3096, 995, 3138, 1064, 3176, 1094, 3208, 1143, 3237, 1149, 3314, 1266, 3279, 1308, 3288, 1392, 3271, 1525, 3227, 1571, 3138, 1661, 3118, 1802, 3076, 1909, 3027, 1957, 3053, 2005, 3099, 1982, 3175, 2013, 3224, 2085, 3271, 2154, 3284, 2290, 3264, 2324, 3219, 2361, 3219, 2393, 3202, 2492, 3190, 2516, 3157, 2480, 3109, 2496, 3066, 2470, 3004, 2500, 2924, 2500, 2568},
+	}},
+	{"MA", [][]int16{
+		{-217, 3517, -179, 3453, -173, 3392, -139, 3286, -112, 3265, -131, 3226, -262, 3209, -307, 3172, -365, 3164, -369, 3090, -486, 3050, -524, 3000, -606, 2973, -706, 2958, -867, 2884, -867, 2766, -882, 2766, -879, 2712, -941, 2709, -974, 2686, -1019, 2686, -1055, 2699, -1139, 2688, -1172, 2610, -1203, 2603, -1250, 2477, -1389, 2369, -1422, 2231, -1463, 2186, -1475, 2150, -1700, 2142, -1702, 2142, -1697, 2189, -1659, 2216, -1626, 2268, -1633, 2302, -1598, 2372, -1543, 2436, -1509, 2452, -1482, 2510, -1480, 2564, -1444, 2625, -1377, 2662, -1314, 2764, -1312, 2765, -1262, 2804, -1169, 2815, -1090, 2883, -1040, 2910, -956, 2993, -981, 3118, -943, 3204, -930, 3256, -866, 3324, -765, 3370, -691, 3411, -624, 3515, -593, 3576, -519, 3576, -459, 3533, -364, 3540, -260, 3518},
+	}},
+	{"MD", [][]int16{
+		{2662, 4822, 2686, 4837, 2752, 4847, 2826, 4816, 2867, 4812, 2912, 4785, 2905, 4751, 2942, 4735, 2956, 4693, 2991, 4667, 2984, 4653, 3002, 4642, 2976, 4635, 2917, 4638, 2907, 4652, 2886, 4644, 2893, 4626, 2866, 4594, 2849, 4560, 2823, 4549, 2805, 4594, 2816, 4637, 2813, 4681, 2755, 4741, 2723, 4783, 2692, 4812},
+	}},
+	{"ME", [][]int16{
+		{2007, 4259, 1980, 4250, 1974, 4269, 1930, 4220, 1937, 4188, 1916, 4196, 1888, 4228, 1845, 4248, 1856, 4265, 1871, 4320, 1903, 4343, 1922, 4352, 1948, 4335, 1963, 4321, 1996, 4311, 2034, 4290, 2026, 4281},
+	}},
+	{"MG", [][]int16{
+		{4954, -1247, 4981, -1290, 5006, -1356, 5022, -1476, 5048, -1523, 5038, -1571, 5020, -1600, 4986, -1541, 4967, -1571, 4986, -1645, 4977, -1688, 4950, -1711, 4944, -1795, 4904, -1912, 4855, -2050, 4793, -2239, 4755, -2378, 4710, -2494, 4628, -2518, 4541, -2560, 4483, -2535, 4404, -2499, 4376, -2446, 4370, -2357, 4335, -2278, 4325, -2206, 4343, -2134, 4389, -2116, 4390, -2083, 4437, -2007, 4446, -1944, 4423, -1896, 4404, -1833, 4396, -1741, 4431, -1685, 4445, -1622, 4494, -1618, 4550, -1597, 4587, -1579, 4631, -1578, 4688, -1521, 4771, -1459, 4801, -1409, 4787, -1366, 4829, -1378, 4885, -1309, 4886, -1249, 4919, -1204},
+	}},
+	{"MK", [][]int16{
+		{2238, 4232, 2288, 4200, 2295, 4134, 2276, 4130, 2260, 4113, 2206, 4115, 2167, 4093, 2102, 4084, 2061, 4109, 2046, 4152, 2059, 4186, 2072, 4185, 2076, 4205, 2135, 4221, 2158, 4225, 2192, 4230},
+	}},
+	{"ML", [][]int16{
+		{-1151, 1244, -1147, 1275, -1155, 1314, -1193, 1342, -1212, 1399, -1217, 1462, -1183, 1480, -1167, 1539, -1135, 1541, -1065, 1513, -1009, 1533, -970, 1526, -955, 1549, -554, 1550, -532, 1620, -549, 1633, -597, 2064, -645, 2496, -492, 2497, -155, 2279, 182, 2061, 206, 2014, 268, 1986, 315, 1969, 316, 1906, 427, 1916, 427, 1685, 372, 1618, 364, 1557, 275, 1541, 139, 1532, 102, 1497, 37, 1493, -27, 1492, -52, 1512, -107, 1497, -200, 1456, -219, 1425, -297, 1380, -310, 1354, -352, 1334, -401, 1347, -428, 1323, -443, 1254, -522, 1171, -520, 1138, -547, 1095, -540, 1037, -582, 1022, -605, 1010, -621, 1052, -649, 1041, -667, 1043, -685, 1014, -762, 1015, -790, 1030, -803, 1021, -834, 1049, -828, 1079, -841, 1091, -862, 1081, -858, 1114, -838, 1139, -879, 1181, -891, 1209, -913, 1231, -933, 1233, -957, 1219, -989, 1206, -1017, 1184, -1059, 1192, -1087, 1218, -1104, 1221, -1130, 1208, -1146, 1208},
+	}},
+	{"MM", [][]int16{
+		{10012, 2042, 9954, 2019, 9896, 1975, 9825, 1971, 9780, 1863, 9738, 1845, 9786, 1757, 9849, 1684, 9890, 1618, 9854, 1531, 9819, 1512, 9843, 1462, 9910, 1383, 9921, 1327, 9920, 1280, 9959, 1189, 9904, 1096, 9855, 993, 9846, 1068, 9876, 1144, 9843, 1203, 9851, 1312, 9810, 1364, 9778, 1484, 9760, 1610, 9716, 1693, 9651, 1643, 9537, 1571, 9481, 1580, 9419, 1604, 9453, 1728, 9432, 1821, 9354, 1937, 9366, 1973, 9308, 1986, 9237, 2067, 9230, 2148, 9265, 2132, 9267, 2204, 9317, 2228, 9306, 2270, 9329, 2304, 9333, 2408, 9411, 2385, 9455, 2468, 9460, 2516, 9516, 2600, 9512, 2657, 9642, 2726, 9713, 2708, 9705, 2770, 9740, 2788, 9733, 2826, 9791, 2834, 9825, 2775, 9868, 2751, 9871, 2674, 9867, 2592, 9772, 2508, 9760, 2390, 9866, 2406, 9890, 2314, 9953, 2295, 9924, 2212, 9998, 2174, 10042, 2156, 10115, 2185, 10118, 2144, 10033, 2079},
+	}},
+	{"MN", [][]int16{
+		{8775, 4930, 8881, 4947, 9071, 5033, 9223, 5080, 9310, 5050, 9415, 5048, 9482, 5001, 9581, 4998, 9726, 4973, 9823, 5042, 9783, 5101, 9886, 5205, 9998, 5163, 10089, 5152, 10207, 5126, 10226, 5051, 10368, 5009, 10462, 5028, 10589, 5041, 10689, 5027, 10787, 4979, 10848, 4928, 10940, 4929, 11066, 4913, 11158, 4938, 11290, 4954, 11436, 5025, 11496, 5014, 11549, 4981, 11668, 4989, 11619, 4913, 11549, 4814, 11574, 4773, 11631, 4785, 11730, 4770, 11806, 4807, 11887, 4775, 11977, 4705, 11966, 4669, 11887, 4681, 11742, 4667, 11672, 4639, 11599, 4573, 11446, 4534, 11346, 4481, 11244, 4501, 11187, 4510, 11135, 4446, 11167, 4407, 11183, 4374, 11113, 4341, 11041, 4287, 10924, 4252, 10774, 4248, 10613, 4213, 10496, 4160, 10452, 4191, 10331, 4191, 10183, 4251, 10085, 4266, 9952, 4252, 9745, 4275, 9635, 4273, 9576, 4332, 9531, 4424, 9469, 4435, 9348, 4498, 9213, 4512, 9095, 4529, 9059, 4572, 9097, 4689, 9028, 4769, 8885, 4807, 8801, 4860},
+	}},
+	{"MR", [][]int16{
+		{-1706, 2100, -1685, 2133, -1293, 2133, -1312, 2277, -1287, 2328, -1194, 2337, -1197, 2593, -869, 2588, -868, 2740, -492, 2497, -645, 2496, -597, 2064, -549, 1633, -532, 1620, -554, 1550, -955, 1549, -970, 1526, -1009, 1533, -1065, 1513, -1135, 1541, -1167, 1539, -1183, 1480, -1217, 1462, -1283, 1530, -1344, 1604, -1410, 1630, -1458, 1660, -1514, 1659, -1562, 1637, -1612, 1646, -1646, 1614, -1655, 1667, -1627, 1717, -1615, 1811, -1626, 1910, -1638, 1959, -1628, 2009, -1654, 2057},
+	}},
+	{"MW", [][]int16{
+		{3276, -923, 3374, -942, 3394, -969, 3428, -1016, 3456, -1152, 3428, -1228, 3456, -1358, 3491, -1357, 3527, -1389, 3569, -1461, 3577, -1590, 3534, -1611, 3503, -1680, 3438, -1618, 3431, -1548, 3452, -1501, 3446, -1461, 3406, -1436, 3379, -1445, 3321, -1397, 3269, -1371, 3299, -1278, 3331, -1244, 3311, -1161, 3332, -1080, 3349, -1053, 3323, -968},
+	}},
+	{"MX", [][]int16{
+		{-11713, 3254, -11599, 3261, -11472, 3272, -11482, 3253, -11330, 3204, -11102, 3133, -10904, 3134, -10824, 3134, -10824, 3175, -10651, 3175, -10614, 3140, -10563, 3108, -10504, 3064, -10471, 3012, -10446, 2957, -10394, 2927, -10311, 2897, -10248, 2976, -10166, 2978, -10096, 2938, -10046, 2870, -10011, 2811, -9952, 2754, -9930, 2684, -9902, 2637, -9824, 2606, -9753, 2584, -9714, 2587, -9753, 2499, -9770, 2427, -9778, 2293, -9787, 2244, -9770, 2190, -9739, 2141, -9719, 2064, -9653, 1989, -9629, 1932, -9590, 1883, -9484, 1856, -9443, 1814, -9355, 1842, -9279, 1852, -9204, 1870, -9141, 1888, -9077, 1928, -9053, 1987, -9045, 2071, -9028, 2100, -8960, 2126, -8854, 2149, -8766, 2146, -8705, 2154, -8681, 2133, -8685, 2085, -8738, 2026, -8762, 1965, -8744, 1947, -8759, 1904, -8784, 1826, -8809, 1852, -8830, 1850, -8849, 1849, -8885, 1788, -8903, 1800, -8915, 1796, -8914, 1781, -9007, 1782, -9100, 1782, -9100, 1725, -9145, 1725, -9108, 1692, -9071, 1669, -9060, 1647, -9044, 1641, -9046, 1607, -9175, 1607, -9223, 1525, -9209, 1506, -9220, 1483, -9223, 1454, -9336, 1562, -9388, 1594, -9469, 1620, -9525, 1613, -9605, 1575, -9656, 1565, -9726, 1592, -9801, 1611, -9895, 1657, -9970, 1671, -10083, 1717, -10167, 1765, -10192, 1792, -10248, 1798, -10350, 1829, -10392, 1875, -10499, 1932, -10549, 1995, -10573, 2043, -10540, 2053, -10550, 2082, -10527, 2108, -10527, 2142, -10560, 2187, -10569, 2227, -10603, 2277, -10691, 2377, -10792, 2455, -10840, 2517, -10926, 2558, -10944, 2582, -10929, 2644, -10980, 2668, -11039, 2716, -11064, 2786, -11118, 2794, -11176, 2847, -11223, 2895, -11227, 2927, -11281, 3002, -11316, 3079, -11315, 3117, -11387, 3157, -11421, 3152, -11478, 3180, -11494, 3139, -11477, 3091, -11467, 3016, -11433, 2975, -11359, 2906, -11342, 2883, -11327, 2875, -11314, 2841, -11296, 2843, -11276, 2778, -11246, 2753, -11224, 2717, -11162, 2666, -11128, 2573, -11099, 2529, -11071, 2483, -11066, 2430, -11017, 2427, -10977, 2381, -10941, 2336, -10943, 2319, -10985, 2282, -11003, 2282, -11030, 2343, -11095, 2400, -11167, 2448, -11218, 2474, -11215, 2547, -11230, 2601, -11278, 2632, -11346, 2677, -11360, 2664, -11385, 2690, -11447, 2714, -11506, 2772, -11498, 2780, -11457, 2774, -11420, 2812, -11416, 2857, -11493, 2928, -11552, 2956, -11589, 3018, -11626, 3084, -11672, 3164},
+	}},
+	{"MY", [][]int16{
+		{10009, 646, 10026, 664, 10108, 620, 10115, 569, 10181, 581, 10214, 622, 10237, 613, 10296, 552, 10338, 486, 10344, 418, 10333, 373, 10343, 338, 10350, 279, 10385, 252, 10425, 163, 10423, 129, 10352, 123, 10257, 197, 10139, 276, 10127, 327, 10070, 394, 10056, 477, 10020, 531, 10031, 604},
+	}},
+	{"MY", [][]int16{
+		{11788, 414, 11702, 431, 11587, 431, 11552, 317, 11513, 282, 11462, 143, 11381, 122, 11286, 150, 11238, 141, 11180, 90, 11116, 98, 11051, 77, 10983, 134, 10966, 201, 11040, 166, 11117, 185, 11137, 270, 11180, 289, 11300, 310, 11371, 389, 11420, 453, 11466, 401, 11487, 435, 11535, 432, 11541, 496, 11545, 545, 11622, 614, 11673, 692, 11713, 693, 11764, 642, 11769, 599, 11835, 571, 11918, 541, 11911, 502, 11844, 497, 11862, 448},
+	}},
+	{"MZ", [][]int16{
+		{3456, -1152, 3531, -1144, 3651, -1172, 3678, -1159, 3747, -1157, 3783, -1127, 3843, -1129, 3952, -1090, 4032, -1032, 4048, -1077, 4044, -1176, 4056, -1264, 4060, -1420, 4078, -1469, 4048, -1541, 4009, -1610, 3945, -1672, 3854, -1710, 3741, -1759, 3628, -1866, 3590, -1884, 3520, -1955, 3479, -1978, 3470, -2050, 3518, -2125, 3537, -2184, 3539, -2214, 3556, -2209, 3553, -2307, 3537, -2354, 3561, -2371, 3546, -2412, 3504, -2448, 3422, -2482, 3301, -2536, 3257, -2573, 3266, -2615, 3292, -2622, 3283, -2674, 3207, -2673, 3199, -2629, 3184, -2584, 3175, -2548, 3193, -2437, 3167, -2366, 3119, -2225, 3224, -2112, 3251, -2040, 3266, -2030, 3277, -1972, 3261, -1942, 3265, -1867, 3285, -1798, 3285, -1671, 3233, -1639, 3185, -1632, 3164, -1607, 3117, -1586, 3034, -1588, 3027, -1551, 3018, -1480, 3321, -1397, 3379, -1445, 3406, -1436, 3446, -1461, 3452, -1501, 3431, -1548, 3438, -1618, 3503, -1680, 3534, -1611, 3577, -1590, 3569, -1461, 3527, -1389, 3491, -1357, 3456, -1358, 3428, -1228},
+	}},
+	{"NA", [][]int16{
+		{1990, -2477, 1989, -2846, 1900, -2897, 1846, -2905, 1784, -2886, 1739, -2878, 1722, -2836, 1682, -2808, 1634, -2858, 1560, -2782, 1521, -2709, 1499, -2612, 1474, -2539, 1441, -2385, 1439, -2266, 1426, -2211, 1387, -2170, 1335, -2087, 1283, -1967, 1261, -1905, 1179, -1807, 1173, -1730, 1222, -1711, 1281, -1694, 1346, -1697, 1406, -1742, 1421, -1735, 1826, -1731, 1896, -1779, 2138, -1793, 2322, -1752, 2403, -1730, 2468, -1735, 2508, -1758, 2508, -1766, 2452, -1789, 2422, -1789, 2358, -1828, 2320, -1787, 2166, -1822, 2091, -1825, 2088, -2181, 1990, -2185},
+	}},
+	{"NC", [][]int16{
+		{16578, -2108, 16660, -2170, 16712, -2216, 16674, -2240, 16619, -2213, 16547, -2168, 16483, -2115, 16417, -2044, 16403, -2011, 16446, -2012, 16502, -2046, 16546, -2080},
+	}},
+	{"NE", [][]int16{
+		{1485, 2286, 1510, 2131, 1547, 2105, 1549, 2073, 1590, 2039, 1569, 1996, 1530, 1793, 1525, 1663, 1397, 1568, 1354, 1437, 1396, 1400, 1395, 1335, 1460, 1333, 1450, 1286, 1421, 1280, 1418, 1248, 1400, 1246, 1332, 1356, 1308, 1360, 1230, 1304, 1153, 1333, 1099, 1339, 1070, 1325, 1011, 1328, 952, 1285, 901, 1283, 780, 1334, 733, 1310, 682, 1312, 645, 1349, 544, 1387, 437, 1375, 411, 1353, 397, 1296, 368, 1255, 361, 1166, 285, 1224, 249, 1223, 215, 1194, 218, 1263, 102, 1285, 99, 1334, 43, 1399, 30, 1444, 37, 1493, 102, 1497, 139, 1532, 275, 1541, 364, 1557, 372, 1618, 427, 1685, 427, 1916, 568, 1960, 857, 2157, 1200, 2347, 1358, 2304, 1414, 2249},
+	}},
+	{"NG", [][]int16{
+		{269, 626, 275, 787, 272, 851, 291, 914, 322, 944, 371, 1006, 360, 1033, 380, 1073, 357, 1133, 361, 1166, 368, 1255, 397, 1296, 411, 1353, 437, 1375, 544, 1387, 645, 1349, 682, 1312, 733, 1310, 780, 1334, 901, 1283, 952, 1285, 1011, 1328, 1070, 1325, 1099, 1339, 1153, 1333, 1230, 1304, 1308, 1360, 1332, 1356, 1400, 1246, 1418, 1248, 1458, 1209, 1447, 1190, 1442, 1157, 1357, 1080, 1331, 1016, 1317, 964, 1296, 942, 1275, 872, 1222, 831, 1206, 780, 1184, 740, 1175, 698, 1106, 664, 1050, 706, 1012, 704, 952, 645, 923, 644, 876, 548, 850, 477, 746, 441, 708, 446, 670, 424, 590, 426, 536, 489, 503, 561, 433, 627, 357, 626},
+	}},
+	{"NI", [][]int16{
+		{-8366, 1094, -8390, 1073, -8419, 1079, -8436, 1100, -8467, 1108, -8490, 1095, -8556, 1122, -8571, 1109, -8606, 1140, -8653, 1181, -8675, 1214, -8717, 1246, -8767, 1291, -8756, 1306, -8739, 1291, -8732, 1298, -8701, 1303, -8688, 1325, -8673, 1326, -8676, 1375, -8652, 1378, -8631, 1377, -8610, 1404, -8580, 1384, -8570, 1396, -8551, 1408, -8517, 1435, -8515, 1456, -8505, 1455, -8492, 1479, -8482, 1482, -8465, 1467, -8445, 1462, -8423, 1475, -8398, 1475, -8363, 1488, -8349, 1502, -8315, 1500, -8323, 1490, -8328, 1468, -8318, 1431, -8341, 1397, -8352, 1357, -8355, 1313, -8350, 1287, -8347, 1242, -8363, 1232, -8372, 1189, -8365, 1163, -8386, 1137, -8381, 1110},
+	}},
+	{"NL", [][]int16{
+		{691, 5348, 709, 5314, 684, 5223, 659, 5185, 599, 5185, 616, 5080, 561, 5104, 497, 5148, 405, 5127, 331, 5135, 332, 5135, 383, 5162, 471, 5309, 607, 5351},
+	}},
+	{"NO", [][]int16{
+		{1514, 7967, 1552, 8002, 1699, 8005, 1825, 7970, 2154, 7896, 1903, 7856, 1847, 7783, 1759, 7764, 1712, 7681, 1591, 7677, 1376, 7738, 1467, 7774, 1317, 7802, 1122, 7887, 1044, 7965, 1317, 8001, 1372, 7966},
+	}},
+	{"NO", [][]int16{
+		{3110, 6956, 2940, 6916, 2859, 6906, 2902, 6977, 2773, 7016, 2618, 6983, 2569, 6909, 2474, 6865, 2366, 6889, 2236, 6884, 2124, 6937, 2065, 6911, 2003, 6907, 1988, 6841, 1799, 6857, 1773, 6801, 1677, 6801, 1611, 6730, 1511, 6619, 1356, 6479, 1392, 6445, 1357, 6405, 1258, 6407, 1193, 6313, 1199, 6180, 1263, 6129, 1230, 6012, 1147, 5943, 1103, 5886, 1036, 5947, 838, 5831, 705, 5808, 567, 5859, 531, 5966, 499, 6197, 591, 6261, 855, 6345, 1053, 6449, 1236, 6588, 1476, 6781, 1644, 6856, 1918, 6982, 2138, 7026, 2302, 7020, 2455, 7103, 2637, 7099, 2817, 7119, 3129, 7045, 3001, 7019},
+	}},
+	{"NO", [][]int16{
+		{2741, 8006, 2592, 7952, 2302, 7940, 2008, 7957, 1990, 7984, 1846, 7986, 1737, 8032, 2046, 8060, 2191, 8036, 2292, 8066, 2545, 8041},
+	}},
+	{"NO", [][]int16{
+		{2472, 7785, 2249, 7744, 2073, 7768, 2142, 7794, 2081, 7825, 2288, 7845, 2328, 7808},
+	}},
+	{"NP", [][]int16{
+		{8812, 2788, 8804, 2745, 8817, 2681, 8806, 2641, 8723, 2640, 8602, 2663, 8525, 2673, 8468, 2723, 8330, 2736, 8200, 2793, 8106, 2842, 8009, 2879, 8048, 2973, 8111, 3018, 8153, 3042, 8233, 3012, 8334, 2946, 8390, 2932, 8423, 2884, 8501, 2864, 8582, 2820, 8695, 2797},
+	}},
+	{"NZ", [][]int16{
+		{17689, -4007, 17651, -4060, 17601, -4129, 17524, -4169, 17507, -4143, 17465, -4128, 17523, -4046, 17490, -3991, 17382, -3951, 17385, -3915, 17457, -3880, 17474, -3803, 17470, -3738, 17429, -3671, 17432, -3653, 17384, -3612, 17305, -3524, 17264, -3453, 17301, -3445, 17355, -3501, 17433, -3527, 17461, -3616, 17534, -3721, 17536, -3653, 17581, -3680, 17596, -3756, 17676, -3788, 17744, -3796, 17801, -3758, 17852, -3770, 17827, -3858, 17797, -3917, 17721, -3915, 17694, -3945, 17703, -3988},
+	}},
+	{"NZ", [][]int16{
+		{16967, -4356, 17052, -4303, 17113, -4251, 17157, -4177, 17195, -4151, 17210, -4096, 17280, -4049, 17302, -4092, 17325, -4133, 17396, -4093, 17425, -4135, 17425, -4177, 17388, -4223, 17322, -4297, 17271, -4337, 17308, -4385, 17231, -4387, 17145, -4424, 17119, -4490, 17062, -4591, 16983, -4636, 16933, -4664, 16841, -4662, 16776, -4629, 16668, -4622, 16651, -4585, 16705, -4511, 16830, -4412, 16895, -4394},
+	}},
+	{"OM", [][]int16{
+		{5521, 2271, 5523, 2311, 5553, 2352, 5553, 2393, 5598, 2413, 5580, 2427, 5589, 2492, 5640, 2492, 5685, 2424, 5740, 2388, 5814, 2375, 5873, 2357, 5918, 2299, 5945, 2266, 5981, 2253, 5981, 2231, 5944, 2171, 5928, 2143, 5886, 2111, 5849, 2043, 5803, 2048, 5783, 2024, 5767, 1974, 5779, 1907, 5769, 1894, 5723, 1895, 5661, 1857, 5651, 1809, 5628, 1788, 5566, 1788, 5527, 1763, 5527, 1723, 5479, 1695, 5424, 1704, 5357, 1671, 5311, 1665, 5278, 1735, 5200, 1900, 5500, 2000, 5567, 2200},
+	}},
+	{"OM", [][]int16{
+		{5626, 2571, 5607, 2606, 5636, 2640, 5649, 2631, 5639, 2590},
+	}},
+	{"PA", [][]int16{
+		{-7735, 867, -7747, 852, -7724, 794, -7743, 764, -7775, 771, -7788, 722, -7821, 751, -7843, 805, -7818, 832, -7844, 839, -7862, 872, -7912, 900, -7956, 893, -7976, 858, -8016, 833, -8038, 830, -8048, 809, -8000, 755, -8028, 742, -8042, 727, -8089, 722, -8106, 782, -8119, 765, -8152, 771, -8172, 811, -8213, 818, -8239, 829, -8282, 829, -8285, 807, -8297, 823, -8291, 842, -8283, 863, -8287, 881, -8272, 893, -8293, 907, -8293, 948, -8255, 957, -8219, 921, -8221, 900, -8181, 895, -8171, 903, -8144, 879, -8095, 886, -8052, 911, -7991, 931, -7957, 961, -7902, 955, -7906, 945, -7850, 942, -7806, 925, -7773, 895},
+	}},
+	{"PE", [][]int16{
+		{-6989, -430, -7079, -425, -7093, -440, -7175, -459, -7289, -527, -7296, -574, -7322, -609, -7312, -663, -7372, -692, -7372, -734, -7399, -752, -7357, -842, -7302, -903, -7323, -946, -7256, -952, -7218, -1005, -7130, -1008, -7048, -949, -7055, -1101, -7009, -1112, -6953, -1095, -6867, -1256, -6888, -1290, -6893, -1360, -6895, -1445, -6934, -1495, -6916, -1532, -6939, -1566, -6896, -1650, -6959, -1758, -6986, -1809, -7037, -1835, -7138, -1777, -7146, -1736, -7344, -1636, -7524, -1527, -7601, -1465, -7642, -1382, -7626, -1354, -7711, -1222, -7809, -1038, -7904, -839, -7945, -793, -7976, -719, -8054, -654, -8125, -614, -8093, -569, -8141, -474, -8110, -404, -8030, -340, -8018, -382, -8047, -406, -8044, -443, -8003, -435, -7962, -445, -7921, -496, -7864, -455, -7845, -387, -7784, -300, -7664, -261, -7554, -156, -7523, -91, -7537, -15, -7511, -6, -7444, -53, -7412, -100, -7366, -126, -7307, -231, -7233, -243, -7177, -217, -7141, -234, -7081, -226, -7005, -273, -7069, -374, -7039, -377},
+	}},
+	{"PG", [][]int16{
+		{14100, -260, 14274, -329, 14458, -386, 14527, -437, 14583, -488, 14598, -547, 14765, -608, 14789, -661, 14697, -672, 14719, -739, 14808, -804, 14873, -910, 14931, -907, 14927, -951, 15004, -968, 14974, -987, 15080, -1029, 15069, -1058, 15003, -1065, 14978, -1039, 14892, -1028, 14791, -1013, 14714, -949, 14657, -894, 14605, -807, 14474, -763, 14390, -792, 14329, -825, 14341, -898, 14263, -933, 14207, -916, 14103, -912, 14102, -586},
+	}},
+	{"PG", [][]int16{
+		{15264, -366, 15302, -398, 15314, -450, 15283, -477, 15264, -418, 15241, -379, 15195, -346, 15138, -304, 15066, -274, 15094, -250, 15148, -278, 15182, -300, 15224, -324},
+	}},
+	{"PG", [][]int16{
+		{15130, -584, 15075, -608, 15024, -632, 14971, -632, 14889, -603, 14832, -575, 14840, -544, 14930, -558, 14985, -551, 15000, -503, 15014, -500, 15024, -553, 15081, -546, 15109, -511, 15165, -476, 15154, -417, 15214, -415, 15234, -431, 15232, -487, 15198, -548, 15146, -556},
+	}},
+	{"PG", [][]int16{
+		{15476, -534, 15506, -557, 15555, -620, 15602, -654, 15588, -682, 15560, -692, 15517, -654, 15473, -590, 15451, -514, 15465, -504},
+	}},
+	{"PH", [][]int16{
+		{12083, 1270, 12032, 1347, 12118, 1343, 12153, 1307, 12126, 1221},
+	}},
+	{"PH", [][]int16{
+		{12259, 998, 12284, 1026, 12295, 1088, 12350, 1094, 12334, 1027, 12408, 1123, 12398, 1028, 12362, 995, 12331, 932, 12300, 902, 12238, 971},
+	}},
+	{"PH", [][]int16{
+		{12638, 841, 12648, 775, 12654, 719, 12620, 627, 12583, 729, 12536, 679, 12568, 605, 12540, 558, 12422, 616, 12394, 689, 12424, 736, 12361, 783, 12330, 742, 12283, 746, 12209, 690, 12192, 719, 12231, 803, 12294, 832, 12349, 869, 12384, 824, 12460, 851, 12476, 896, 12547, 899, 12541, 976, 12622, 929, 12631, 878},
+	}},
+	{"PH", [][]int16{
+		{11850, 932, 11717, 837, 11766, 907, 11839, 968, 11899, 1038, 11951, 1137, 11969, 1055, 11903, 1000},
+	}},
+	{"PH", [][]int16{
+		{12234, 1822, 12217, 1781, 12252, 1709, 12225, 1626, 12166, 1593, 12151, 1512, 12173, 1433, 12226, 1422, 12270, 1434, 12395, 1378, 12386, 1324, 12418, 1300, 12408, 1254, 12330, 1303, 12293, 1355, 12267, 1319, 12203, 1378, 12113, 1364, 12063, 1386, 12068, 1427, 12099, 1453, 12069, 1476, 12056, 1440, 12007, 1497, 11992, 1541, 11988, 1636, 12029, 1603, 12039, 1760, 12072, 1851, 12132, 1850, 12194, 1822, 12225, 1848},
+	}},
+	{"PH", [][]int16{
+		{12204, 1142, 12188, 1189, 12248, 1158, 12312, 1158, 12310, 1117, 12264, 1074, 12200, 1044, 12197, 1091},
+	}},
+	{"PH", [][]int16{
+		{12550, 1216, 12578, 1105, 12501, 1131, 12503, 1098, 12528, 1036, 12480, 1013, 12476, 1084, 12446, 1089, 12430, 1150, 12489, 1142, 12488, 1179, 12427, 1256, 12523, 1254},
+	}},
+	{"PK", [][]int16{
+		{7784, 3549, 7687, 3465, 7576, 3450, 7424, 3475, 7375, 3432, 7410, 3344, 7445, 3276, 7526, 3227, 7441, 3169, 7442, 3098, 7345, 2998, 7282, 2896, 7178, 2791, 7062, 2799, 6951, 2694, 7017, 2649, 7028, 2572, 7084, 2522, 7104, 2436, 6884, 2436, 6818, 2369, 6744, 2394, 6715, 2466, 6637, 2543, 6453, 2524, 6291, 2522, 6150, 2508, 6187, 2624, 6332, 2676, 6323, 2722, 6276, 2738, 6273, 2826, 6177, 2870, 6137, 2930, 6087, 2983, 6255, 2932, 6355, 2947, 6415, 2934, 6435, 2956, 6505, 2947, 6635, 2989, 6638, 3074, 6694, 3130, 6768, 3130, 6779, 3158, 6856, 3171, 6893, 3162, 6932, 3190, 6926, 3250, 6969, 3311, 7032, 3336, 6993, 3402, 7088, 3399, 7116, 3435, 7112, 3473, 7161, 3515, 7150, 3565, 7126, 3607, 7185, 3651, 7292, 3672, 7407, 3684, 7458, 3702, 7516, 3713, 7590, 3667, 7619, 3590},
+	}},
+	{"PL", [][]int16{
+		{2348, 5391, 2353, 5347, 2380, 5309, 2380, 5269, 2320, 5249, 2351, 5202, 2353, 5158, 2403, 5071, 2392, 5042, 2343, 5031, 2252, 4948, 2278, 4903, 2256, 4909, 2161, 4947, 2089, 4933, 2042, 4943, 1983, 4922, 1932, 4957, 1891, 4944, 1885, 4950, 1839, 4999, 1765, 5005, 1755, 5036, 1687, 5047, 1672, 5022, 1618, 5042, 1624, 5070, 1549, 5078, 1502, 5111, 1461, 5175, 1469, 5209, 1444, 5262, 1407, 5298, 1435, 5325, 1412, 5376, 1480, 5405, 1636, 5451, 1762, 5485, 1862, 5468, 1870, 5444, 1966, 5443, 2089, 5431, 2273, 5433, 2324, 5422},
+	}},
+	{"PR", [][]int16{
+		{-6628, 1851, -6577, 1843, -6559, 1823, -6585, 1798, -6660, 1798, -6718, 1795, -6724, 1837, -6710, 1852},
+	}},
+	{"PS", [][]int16{
+		{3540, 3149, 3493, 3135, 3497, 3162, 3523, 3175, 3497, 3187, 3518, 3253, 3555, 3239, 3555, 3178},
+	}},
+	{"PT", [][]int16{
+		{-903, 4188, -867, 4213, -826, 4228, -801, 4179, -742, 4179, -725, 4192, -667, 4188, -639, 4138, -685, 4111, -686, 4033, -703, 4018, -707, 3971, -750, 3963, -710, 3903, -737, 3837, -703, 3808, -717, 3780, -754, 3743, -745, 3710, -786, 3684, -838, 3698, -890, 3687, -875, 3765, -884, 3827, -929, 3836, -953, 3874, -945, 3939, -905, 3976, -898, 4016, -877, 4076, -879, 4118, -899, 4154},
+	}},
+	{"PY", [][]int16{
+		{-5817, -2018, -5787, -2073, -5794, -2209, -5688, -2228, -5647, -2209, -5580, -2236, -5561, -2266, -5552, -2357, -5540, -2396, -5503, -2400, -5465, -2384, -5429, -2402, -5429, -2457, -5443, -2516, -5463, -2574, -5479, -2662, -5570, -2739, -5649, -2755, -5761, -2740, -5862, -2712, -5763, -2560, -5778, -2516, -5881, -2477, -6003, -2403, -6085, -2388, -6269, -2225, -6229, -2105, -6227, -2051, -6179, -1963, -6004, -1934, -5912, -1936, -5818, -1987},
+	}},
+	{"QA", [][]int16{
+		{5081, 2475, 5074, 2548, 5101, 2601, 5129, 2611, 5159, 2580, 5161, 2522, 5139, 2463, 5111, 2456},
+	}},
+	{"RO", [][]int16{
+		{2823, 4549, 2868, 4530, 2915, 4546, 2960, 4529, 2963, 4504, 2914, 4482, 2884, 4491, 2856, 4371, 2797, 4381, 2724, 4418, 2607, 4394, 2557, 4369, 2410, 4374, 2333, 4390, 2294, 4382, 2266, 4423, 2247, 4441, 2271, 4458, 2246, 4470, 2215, 4448, 2156, 4477, 2148, 4518, 2087, 4542, 2076, 4573, 2022, 4613, 2102, 4632, 2163, 4699, 2210, 4767, 2271, 4788, 2314, 4810, 2376, 4799, 2440, 4798, 2487, 4774, 2521, 4789, 2595, 4799, 2620, 4822, 2662, 4822, 2692, 4812, 2723, 4783, 2755, 4741, 2813, 4681, 2816, 4637, 2805, 4594},
+	}},
+	{"RS", [][]int16{
+		{1883, 4591, 1960, 4617, 2022, 4613, 2076, 4573, 2087, 4542, 2148, 4518, 2156, 4477, 2215, 4448, 2246, 4470, 2271, 4458, 2247, 4441, 2266, 4423, 2241, 4401, 2250, 4364, 2299, 4321, 2260, 4290, 2244, 4258, 2255, 4246, 2238, 4232, 2192, 4230, 2158, 4225, 2154, 4232, 2166, 4244, 2178, 4268, 2163, 4268, 2144, 4286, 2127, 4291, 2114, 4307, 2096, 4313, 2081, 4327, 2064, 4322, 2050, 4288, 2026, 4281, 2034, 4290, 1996, 4311, 1963, 4321, 1948, 4335, 1922, 4352, 1945, 4357, 1960, 4404, 1912, 4442, 1937, 4486, 1901, 4486, 1939, 4524, 1907, 4552},
+	}},
+	{"RU", [][]int16{
+		{17873, 7110, 18000, 7152, 18000, 7083, 17890, 7078},
+	}},
+	{"RU", [][]int16{
+		{4910, 4640, 4865, 4581, 4768, 4564, 4668, 4461, 4759, 4366, 4749, 4299, 4858, 4181, 4799, 4141, 4782, 4115, 4737, 4122, 4669, 4183, 4640, 4186, 4578, 4209, 4547, 4250, 4454, 4271, 4393, 4255, 4376, 4274, 4239, 4322, 4092, 4338, 4008, 4355, 3996, 4343, 3868, 4428, 3754, 4466, 3668, 4524, 3740, 4540, 3823, 4624, 3767, 4664, 3915, 4704, 3912, 4726, 3822, 4710, 3826, 4755, 3877, 4783, 3974, 4790, 3990, 4823, 3967, 4878, 4008, 4931, 4007, 4960, 3859, 4993, 3801, 4992, 3739, 5038, 3663, 5023, 3536, 5058, 3538, 5077, 3502, 5121, 3422, 5126, 3414, 5157, 3439, 5177, 3375, 5234, 3272, 5224, 3241, 5229, 3216, 5206, 3179, 5210, 3154, 5274, 3131, 5307, 3150, 5317, 3230, 5313, 3269, 5335, 3241, 5362, 3173, 5379, 3179, 5397, 3138, 5416, 3076, 5481, 3097, 5508, 3087, 5555, 2990, 5579, 2937, 5567, 2923, 5592, 2818, 5617, 2786, 5676, 2777, 5724, 2729, 5747, 2772, 5779, 2742, 5872, 2813, 5930, 2798, 5948, 2912, 6003, 2807, 6050, 3021, 6178, 3114, 6236, 3152, 6287, 3004, 6355, 3044, 6420, 2954, 6495, 3022, 6581, 2905, 6694, 2998, 6770, 2845, 6836, 2859, 6906, 2940, 6916, 3110, 6956, 3213, 6991, 3378, 6930, 3651, 6906, 4029, 6793, 4106, 6746, 4113, 6679, 4002, 6627, 3838, 6600, 3392, 6676, 3318, 6663, 3481, 6590, 3488, 6544, 3494, 6441, 3623, 6411, 3701, 6385, 3714, 6433, 3654, 6476, 3718, 6514, 3959, 6452, 4044, 6476, 3976, 6550, 4209, 6648, 4302, 6642, 4395, 6607, 4453, 6676, 4370, 6735, 4419, 6795, 4345, 6857, 4625, 6825, 4682, 6769, 4556, 6757, 4556, 6701, 4635, 6667, 4789, 6688, 4814, 6752, 5023, 6800, 5372, 6886, 5447, 6881, 5349, 6820, 5473, 6810, 5544, 6844, 5732, 6847, 5880, 6888, 5994, 6828, 6108, 6894, 6003, 6952, 6055, 6985, 6350, 6955, 6489, 6923, 6851, 6809, 6918, 6862, 6816, 6914, 6814, 6936, 6693, 6945, 6726, 6993, 6672, 7071, 6669, 7103, 6854, 7193, 6920, 7284, 6994, 7304, 7259, 7278, 7280, 7222, 7185, 7141, 7247, 7109, 7279, 7039, 7256, 6902, 7367, 6841, 7324, 6774, 7128, 6632, 7242, 6617, 7282, 6653, 7392, 6679, 7419, 6728, 7505, 6776, 7447, 6833, 7494, 6899, 7384, 6907, 7360, 6963, 7440, 7063, 7310, 7145, 7489, 7212, 7466, 7283, 7516, 7285, 7568, 7230, 7529, 7134, 7636, 7115, 7590, 7187, 7758, 7227, 7965, 7232, 8150, 7175, 8061, 7258, 8051, 7365, 8225, 7385, 8466, 7381, 8682, 7394, 8601, 7446, 8717, 7512, 8832, 7514, 9026, 7564, 9290, 7577, 9323, 7605, 9586, 7614, 9668, 7592, 9892, 7645, 10076, 7643, 10104, 7686, 10199, 7729, 10435, 7770, 10607, 7737, 10470, 7713, 10697, 7697, 10724, 7648, 10815, 7672, 11108, 7671, 11333, 7622, 11413, 7585, 11389, 7533, 11278, 7503, 11015, 7448, 10940, 7418, 11064, 7404, 11212, 7379, 11302, 7398, 11353, 7334, 11397, 7359, 11557, 7375, 11878, 7359, 11902, 7312, 12320, 7297, 12326, 7374, 12538, 7356, 12698, 7357, 12859, 7304, 12905, 7240, 12846, 7198, 12972, 7119, 13129, 7079, 13225, 7184, 13386, 7139, 13556, 7166, 13750, 7135, 13823, 7163, 13987, 7149, 13915, 7242, 14047, 7285, 14950, 7220, 15035, 7161, 15297, 7084, 15701, 7103, 15900, 7087, 15983, 7045, 15971, 6972, 16094, 6944, 16228, 6964, 16405, 6967, 16594, 6947, 16784, 6958, 16958, 6869, 17082, 6901, 17001, 6965, 17045, 7010, 17364, 6982, 17572, 6988, 17860, 6940, 18000, 6896, 18000, 6498, 17999, 6497, 17871, 6453, 17741, 6461, 17831, 6408, 17891, 6325, 17937, 6298, 17949, 6257, 17923, 6230, 17736, 6252, 17457, 6177, 17368, 6165, 17215, 6095, 17070, 6034, 17033, 5988, 16890, 6057, 16629, 5979, 16584, 6016, 16488, 5973, 16354, 5987, 16322, 5921, 16202, 5824, 16205, 5784, 16319, 5762, 16306, 5616, 16213, 5612, 16170, 5529, 16212, 5486, 16037, 5434, 16002, 5320, 15853, 5296, 15823, 5194, 15679, 5101, 15642, 5170, 15599, 5316, 15543, 5538, 15591, 5677, 15676, 5736, 15681, 5783, 15836, 5806, 16015, 5931, 16187, 6034, 16367, 6114, 16447, 6255, 16326, 6247, 16266, 6164, 16012, 6054, 15930, 6177, 15672, 6143, 15422, 5976, 15504, 5914, 15281, 5888, 15127, 5878, 15134, 5950, 14978, 5966, 14854, 5916, 14549, 5934, 14220, 5904, 13896, 5709, 13513, 5473, 13670, 5460, 13719, 5398, 13816, 5376, 13880, 5425, 13990, 5419, 14135, 5309, 14138, 5224, 14060, 5124, 14051, 5005, 14006, 4845, 13855, 4700, 13822, 4631, 13686, 4514, 13552, 4399, 13487, 4340, 13354, 4281, 13291, 4280, 13228, 4328, 13094, 4255, 13078, 4222, 13064, 4240, 13063, 4290, 13114, 4293, 13129, 4411, 13103, 4497, 13188, 4532, 13310, 4514, 13377, 4612, 13411, 4721, 13450, 4758, 13503, 4848, 13337, 4818, 13251, 4779, 13099, 4779, 13058, 4873, 12940, 4944, 12766, 4976, 12729, 5074, 12694, 5135, 12656, 5178, 12595, 5279, 12507, 5316, 12357, 5346, 12225, 5343, 12100, 5325, 12018, 5275, 12073, 5252, 12074, 5196, 12018, 5164, 11928, 5058, 11929, 5014, 11788, 4951, 11668, 4989, 11549, 4981, 11496, 5014, 11436, 5025, 11290, 4954, 11158, 4938, 11066, 4913, 10940, 4929, 10848, 4928, 10787, 4979, 10689, 5027, 10589, 5041, 10462, 5028, 10368, 5009, 10226, 5051, 10207, 5126, 10089, 5152, 9998, 5163, 9886, 5205, 9783, 5101, 9823, 5042, 9726, 4973, 9581, 4998, 9482, 5001, 9415, 5048, 9310, 5050, 9223, 5080, 9071, 5033, 8881, 4947, 8775, 4930, 8736, 4921, 8683, 4983, 8554, 4969, 8512, 5012, 8442, 5031, 8394, 5089, 8338, 5107, 8195, 5081, 8057, 5139, 8004, 5086, 7780, 5340, 7653, 5418, 7689, 5449, 7438, 5355, 7343, 5349, 7351, 5404, 7222, 5438, 7118, 5413, 7087, 5517, 6907, 5539, 6817, 5497, 6567, 5460, 6518, 5435, 6144, 5401, 6098, 5366, 6170, 5298, 6074, 5272, 6093, 5245, 5997, 5196, 6159, 5127, 6134, 5080, 5993, 5084, 5964, 5055, 5836, 5106, 5678, 5104, 5572, 5062, 5453, 5103, 5233, 5172, 5077, 5169, 4870, 5061, 4858, 4987, 4755, 5045, 4675, 4936, 4704, 4915, 4647, 4839, 4732, 4772, 4806, 4774, 4869, 4708, 4859, 4656},
+	}},
+	{"RU", [][]int16{
+		{9378, 8102, 9594, 8125, 9788, 8075, 10019, 7978, 9994, 7888, 9776, 7876, 9497, 7904, 9331, 7943, 9255, 8014, 9118, 8034},
+	}},
+	{"RU", [][]int16{
+		{10284, 7928, 10537, 7871, 10508, 7831, 9944, 7792, 10126, 7923, 10209, 7935},
+	}},
+	{"RU", [][]int16{
+		{13883, 7614, 14147, 7609, 14509, 7556, 14430, 7482, 14061, 7485, 13896, 7461, 13697, 7526, 13751, 7595},
+	}},
+	{"RU", [][]int16{
+		{14822, 7535, 15073, 7508, 14958, 7469, 14798, 7478, 14612, 7517, 14636, 7550},
+	}},
+	{"RU", [][]int16{
+		{13986, 7337, 14081, 7377, 14206, 7386, 14348, 7348, 14360, 7321, 14209, 7321, 14004, 7332},
+	}},
+	{"RU", [][]int16{
+		{4485, 8059, 4680, 8077, 4832, 8078, 4852, 8051, 4910, 8075, 5004, 8092, 5152, 8070, 5114, 8055, 4979, 8042, 4889, 8034, 4875, 8018, 4759, 8001, 4650, 8025, 4707, 8056},
+	}},
+	{"RU", [][]int16{
+		{2273, 5433, 2089, 5431, 1966, 5443, 1989, 5487, 2127, 5519, 2232, 5502, 2276, 5486, 2265, 5458},
+	}},
+	{"RU", [][]int16{
+		{5351, 7375, 5590, 7463, 5563, 7508, 5787, 7561, 6117, 7625, 6450, 7644, 6621, 7681, 6816, 7694, 6885, 7654, 6818, 7623, 6464, 7574, 6158, 7526, 5848, 7431, 5699, 7333, 5542, 7237, 5562, 7154, 5754, 7072, 5694, 7063, 5368, 7076, 5341, 7121, 5160, 7147, 5146, 7201, 5248, 7223, 5244, 7277, 5443, 7363},
+	}},
+	{"RU", [][]int16{
+		{14291, 5370, 14326, 5274, 14324, 5176, 14365, 5075, 14465, 4898, 14317, 4931, 14256, 4786, 14353, 4684, 14351, 4614, 14275, 4674, 14209, 4597, 14191, 4681, 14202, 4778, 14190, 4886, 14214, 4962, 14218, 5095, 14159, 5194, 14168, 5330, 14261, 5376, 14221, 5423, 14265, 5437},
+	}},
+	{"RU", [][]int16{
+		{-17493, 6721, -17501, 6658, -17434, 6634, -17457, 6706, -17186, 6691, -16990, 6598, -17089, 6554, -17253, 6544, -17256, 6446, -17296, 6425, -17389, 6428, -17465, 6463, -17598, 6492, -17621, 6536, -17722, 6552, -17836, 6539, -17890, 6574, -17869, 6611, -17988, 6587, -17943, 6540, -18000, 6498, -18000, 6896, -17755, 6820},
+	}},
+	{"RU", [][]int16{
+		{-17869, 7089, -18000, 7083, -18000, 7152, -17987, 7156, -17902, 7156, -17758, 7127, -17766, 7113},
+	}},
+	{"RU", [][]int16{
+		{3344, 4597, 3370, 4622, 3441, 4601, 3473, 4597, 3486, 4577, 3501, 4574, 3502, 4565, 3551, 4541, 3653, 4547, 3633, 4511, 3524, 4494, 3388, 4436, 3333, 4456, 3355, 4503, 3245, 4533, 3263, 4552, 3359, 4585},
+	}},
+	{"RW", [][]int16{
+		{3042, -113, 3082, -170, 3076, -229, 3047, -241, 2994, -235, 2963, -292, 2902, -284, 2912, -229, 2925, -222, 2929, -162, 2958, -134, 2982, -144},
+	}},
+	{"SA", [][]int16{
+		{3496, 2936, 3607, 2920, 3650, 2951, 3674, 2987, 3750, 3000, 3767, 3034, 3800, 3051, 3700, 3151, 3900, 3201, 3920, 3216, 4040, 3189, 4189, 3119, 4471, 2918, 4657, 2910, 4746, 2900, 4771, 2853, 4842, 2855, 4881, 2769, 4930, 2746, 4947, 2711, 5015, 2669, 5021, 2628, 5011, 2594, 5024, 2561, 5053, 2533, 5066, 2500, 5081, 2475, 5111, 2456, 5139, 2463, 5158, 2425, 5162, 2401, 5200, 2300, 5501, 2250, 5521, 2271, 5567, 2200, 5500, 2000, 5200, 1900, 4912, 1862, 4818, 1817, 4747, 1712, 4700, 1695, 4675, 1728, 4637, 1723, 4540, 1733, 4522, 1743, 4406, 1741, 4379, 1732, 4338, 1758, 4312, 1709, 4322, 1667, 4278, 1635, 4265, 1677, 4235, 1708, 4227, 1747, 4175, 1783, 4122, 1867, 4094, 1949, 4025, 2017, 3980, 2034, 3914, 2129, 3902, 2199, 3907, 2258, 3849, 2369, 3802, 2408, 3748, 2429, 3715, 2486, 3721, 2508, 3693, 2560, 3664, 2583, 3625, 2657, 3564, 2738, 3513, 2806, 3463, 2806, 3479, 2861, 3483, 2896},
+	}},
+	{"SB", [][]int16{
+		{16212, -1048, 16240, -1083, 16170, -1082, 16132, -1020, 16192, -1045},
+	}},
+	{"SB", [][]int16{
+		{16168, -960, 16153, -978, 16079, -892, 16058, -832, 16092, -832, 16128, -912},
+	}},
+	{"SB", [][]int16{
+		{16085, -987, 16046, -990, 15985, -979, 15964, -964, 15970, -924, 16036, -940, 16069, -961},
+	}},
+	{"SB", [][]int16{
+		{15964, -802, 15988, -834, 15992, -854, 15913, -811, 15859, -775, 15821, -742, 15836, -732, 15882, -756},
+	}},
+	{"SB", [][]int16{
+		{15714, -702, 15754, -735, 15734, -740, 15690, -718, 15649, -677, 15654, -660},
+	}},
+	{"SD", [][]int16{
+		{2457, 823, 2381, 867, 2346, 895, 2339, 927, 2356, 968, 2355, 1009, 2298, 1071, 2286, 1114, 2288, 1138, 2251, 1168, 2250, 1226, 2229, 1265, 2194, 1259, 2204, 1296, 2230, 1337, 2218, 1379, 2251, 1409, 2230, 1433, 2257, 1494, 2302, 1568, 2389, 1561, 2384, 1958, 2385, 2000, 2500, 2000, 2500, 2200, 2902, 2200, 3290, 2200, 3687, 2200, 3719, 2102, 3697, 2084, 3711, 1981, 3748, 1861, 3786, 1837, 3841, 1800, 3790, 1743, 3717, 1726, 3685, 1696, 3675, 1629, 3632, 1482, 3643, 1442, 3627, 1356, 3586, 1258, 3526, 1208, 3483, 1132, 3473, 1091, 3426, 1063, 3396, 958, 3397, 868, 3396, 946, 3382, 948, 3384, 998, 3372, 1033, 3321, 1072, 3309, 1144, 3321, 1218, 3274, 1225, 3267, 1202, 3207, 1197, 3231, 1168, 3240, 1108, 3185, 1053, 3135, 981, 3084, 971, 3000, 1029, 2962, 1008, 2952, 979, 2900, 960, 2897, 940, 2797, 940, 2783, 960, 2711, 964, 2675, 947, 2648, 955, 2596, 1014, 2579, 1041, 2507, 1027, 2479, 981, 2454, 892, 2419, 873, 2389, 862},
+	}},
+	{"SE", [][]int16{
+		{1103, 5886, 1147, 5943, 1230, 6012, 1263, 6129, 1199, 6180, 1193, 6313, 1258, 6407, 1357, 6405, 1392, 6445, 1356, 6479, 1511, 6619, 1611, 6730, 1677, 6801, 1773, 6801, 1799, 6857, 1988, 6841, 2003, 6907, 2065, 6911, 2198, 6862, 2354, 6794, 2357, 6640, 2390, 6601, 2218, 6572, 2121, 6503, 2137, 6441, 1978, 6361, 1785, 6275, 1712, 6134, 1783, 6064, 1879, 6008, 1787, 5895, 1683, 5872, 1645, 5704, 1588, 5610, 1467, 5620, 1410, 5541, 1294, 5536, 1263, 5631, 1179, 5744},
+	}},
+	{"SI", [][]int16{
+		{1381, 4651, 1463, 4643, 1514, 4666, 1601, 4668, 1620, 4685, 1637, 4684, 1656, 4650, 1577, 4624, 1567, 4583, 1532, 4573, 1533, 4545, 1494, 4547, 1460, 4563, 1441, 4547, 1372, 4550, 1394, 4559, 1370, 4602},
+	}},
+	{"SK", [][]int16{
+		{2256, 4909, 2228, 4883, 2209, 4842, 2187, 4832, 2080, 4862, 2047, 4856, 2024, 4833, 1977, 4820, 1966, 4827, 1917, 4811, 1878, 4808, 1870, 4788, 1786, 4776, 1749, 4787, 1698, 4812, 1688, 4847, 1696, 4860, 1710, 4882, 1755, 4880, 1789, 4890, 1791, 4900, 1810, 4904, 1817, 4927, 1840, 4932, 1855, 4950, 1885, 4950, 1891, 4944, 1932, 4957, 1983, 4922, 2042, 4943, 2089, 4933, 2161, 4947},
+	}},
+	{"SL", [][]int16{
+		{-1325, 890, -1271, 934, -1260, 962, -1243, 984, -1215, 986, -1192, 1005, -1112, 1005, -1084, 969, -1062, 927, -1065, 898, -1049, 872, -1051, 835, -1023, 841, -1070, 794, -1115, 740, -1120, 711, -1144, 679, -1171, 686, -1243, 726, -1295, 780, -1312, 816},
+	}},
+	{"SN", [][]int16{
+		{-1671, 1359, -1713, 1437, -1763, 1473, -1719, 1492, -1670, 1562, -1646, 1614, -1612, 1646, -1562, 1637, -1514, 1659, -1458, 1660, -1410, 1630, -1344, 1604, -1283, 1530, -1217, 1462, -1212, 1399, -1193, 1342, -1155, 1314, -1147, 1275, -1151, 1244, -1166, 1239, -1220, 1247, -1228, 1235, -1250, 1233, -1322, 1258, -1370, 1259, -1555, 1263, -1582, 1252, -1615, 1255, -1668, 1238, -1684, 1315, -1593, 1313, -1569, 1327, -1551, 1328, -1514, 1351, -1471, 1330, -1428, 1328, -1384, 1351, -1405, 1379, -1438, 1363, -1469, 1363, -1508, 1388, -1540, 1386, -1562, 1362},
+	}},
+	{"SO", [][]int16{
+		{4159, -168, 4099, -86, 4098, 278, 4186, 392, 4213, 423, 4277, 425, 4366, 496, 4496, 500, 4779, 800, 4849, 884, 4894, 945, 4894, 997, 4894, 1098, 4894, 1139, 4895, 1141, 4927, 1143, 4973, 1158, 5026, 1168, 5073, 1202, 5111, 1202, 5113, 1175, 5104, 1117, 5105, 1064, 5083, 1028, 5055, 920, 5007, 808, 4945, 680, 4859, 534, 4774, 422, 4656, 286, 4556, 205, 4407, 105, 4314, 29, 4204, -92, 4181, -145},
+	}},
+	{"SO", [][]int16{
+		{4895, 1141, 4894, 1139, 4894, 1098, 4894, 997, 4894, 945, 4849, 884, 4779, 800, 4695, 800, 4368, 918, 4330, 954, 4293, 1002, 4256, 1057, 4278, 1093, 4315, 1146, 4347, 1128, 4367, 1086, 4412, 1045, 4461, 1044, 4556, 1070, 4665, 1082, 4753, 1113, 4802, 1119, 4838, 1138},
+	}},
+	{"SR", [][]int16{
+		{-5452, 231, -5510, 252, -5557, 242, -5597, 251, -5607, 222, -5591, 202, -5600, 182, -5654, 190, -5715, 277, -5728, 333, -5760, 333, -5804, 406, -5786, 458, -5791, 481, -5731, 507, -5715, 597, -5595, 577, -5584, 595, -5503, 603, -5396, 576, -5448, 490, -5440, 421, -5401, 362, -5418, 319, -5427, 273},
+	}},
+	{"SS", [][]int16{
+		{3083, 351, 2995, 417, 2972, 460, 2916, 439, 2870, 446, 2843, 429, 2798, 441, 2737, 523, 2721, 555, 2647, 595, 2621, 655, 2580, 698, 2512, 750, 2511, 783, 2457, 823, 2389, 862, 2419, 873, 2454, 892, 2479, 981, 2507, 1027, 2579, 1041, 2596, 1014, 2648, 955, 2675, 947, 2711, 964, 2783, 960, 2797, 940, 2897, 940, 2900, 960, 2952, 979, 2962, 1008, 3000, 1029, 3084, 971, 3135, 981, 3185, 1053, 3240, 1108, 3231, 1168, 3207, 1197, 3267, 1202, 3274, 1225, 3321, 1218, 3309, 1144, 3321, 1072, 3372, 1033, 3384, 998, 3382, 948, 3396, 946, 3397, 868, 3383, 838, 3329, 835, 3295, 778, 3357, 771, 3408, 723, 3425, 683, 3471, 659, 3530, 551, 3462, 485, 3401, 425, 3339, 379, 3269, 379, 3188, 356, 3125, 378},
+	}},
+	{"SV", [][]int16{
+		{-8935, 1442, -8906, 1434, -8884, 1414, -8854, 1398, -8850, 1385, -8807, 1396, -8786, 1389, -8772, 1379, -8779, 1338, -8790, 1315, -8848, 1316, -8884, 1326, -8926, 1346, -8981, 1352, -9010, 1374, -9006, 1388, -8972, 1413, -8953, 1424, -8959, 1436},
+	}},
+	{"SY", [][]int16{
+		{3572, 3271, 3570, 3272, 3584, 3287, 3582, 3328, 3607, 3382, 3661, 3420, 3645, 3459, 3600, 3464, 3591, 3541, 3615, 3582, 3642, 3604, 3669, 3626, 3674, 3682, 3707, 3662, 3817, 3690, 3870, 3671, 3952, 3672, 4067, 3709, 4121, 3707, 4235, 3723, 4184, 3661, 4129, 3636, 4138, 3563, 4101, 3442, 3879, 3338, 3683, 3231},
+	}},
+	{"SZ", [][]int16{
+		{3207, -2673, 3187, -2718, 3128, -2729, 3069, -2674, 3068, -2640, 3095, -2602, 3104, -2573, 3133, -2566, 3184, -2584, 3199, -2629},
+	}},
+	{"TD", [][]int16{
+		{2384, 1958, 2389, 1561, 2302, 1568, 2257, 1494, 2230, 1433, 2251, 1409, 2218, 1379, 2230, 1337, 2204, 1296, 2194, 1259, 2229, 1265, 2250, 1226, 2251, 1168, 2288, 1138, 2286, 1114, 2223, 1097, 2172, 1057, 2100, 948, 2006, 901, 1909, 907, 1881, 898, 1891, 863, 1839, 828, 1796, 789, 1671, 751, 1646, 773, 1629, 775, 1611, 750, 1528, 742, 1544, 769, 1512, 838, 1498, 880, 1454, 897, 1395, 955, 1417, 1002, 1463, 992, 1491, 999, 1547, 998, 1492, 1089, 1496, 1156, 1489, 1222, 1450, 1286, 1460, 1333, 1395, 1335, 1396, 1400, 1354, 1437, 1397, 1568, 1525, 1663, 1530, 1793, 1569, 1996, 1590, 2039, 1549, 2073, 1547, 2105, 1510, 2131, 1485, 2286, 1586, 2341, 1985, 2150},
+	}},
+	{"TF", [][]int16{
+		{6894, -4862, 6958, -4894, 7053, -4906, 7056, -4926, 7028, -4971, 6874, -4978, 6872, -4924, 6887, -4883},
+	}},
+	{"TG", [][]int16{
+		{90, 1100, 77, 1047, 108, 1018, 143, 983, 146, 933, 166, 913, 162, 683, 187, 614, 106, 593, 84, 628, 57, 691, 49, 741, 71, 831, 46, 868, 37, 947, 37, 1019, -5, 1071, 2, 1102},
+	}},
+	{"TH", [][]int16{
+		{10522, 1427, 10428, 1442, 10299, 1423, 10235, 1339, 10258, 1219, 10169, 1265, 10083, 1263, 10098, 1341, 10010, 1341, 10002, 1231, 9948, 1085, 9915, 996, 9922, 924, 9987, 921, 10028, 830, 10046, 743, 10102, 686, 10162, 674, 10214, 622, 10181, 581, 10115, 569, 10108, 620, 10026, 664, 10009, 646, 9969, 685, 9952, 734, 9899, 791, 9850, 838, 9834, 779, 9815, 835, 9826, 897, 9855, 993, 9904, 1096, 9959, 1189, 9920, 1280, 9921, 1327, 9910, 1383, 9843, 1462, 9819, 1512, 9854, 1531, 9890, 1618, 9849, 1684, 9786, 1757, 9738, 1845, 9780, 1863, 9825, 1971, 9896, 1975, 9954, 2019, 10012, 2042, 10055, 2011, 10061, 1951, 10128, 1946, 10104, 1841, 10106, 1751, 10211, 1811, 10241, 1793, 10300, 1796, 10320, 1831, 10396, 1824, 10472, 1743, 10478, 1644, 10559, 1557, 10554, 1472},
+	}},
+	{"TJ", [][]int16{
+		{6783, 3714, 6839, 3816, 6818, 3890, 6744, 3914, 6770, 3958, 6854, 3953, 6901, 4009, 6933, 4073, 7067, 4096, 7046, 4050, 7060, 4022, 7101, 4024, 7065, 3994, 6956, 4010, 6946, 3953, 7055, 3960, 7178, 3928, 7368, 3943, 7393, 3851, 7426, 3861, 7486, 3838, 7483, 3799, 7498, 3742, 7395, 3742, 7326, 3750, 7264, 3705, 7219, 3695, 7184, 3674, 7145, 3707, 7154, 3791, 7124, 3795, 7135, 3826, 7081, 3849, 7038, 3814, 7027, 3774, 7012, 3759, 6952, 3761, 6920, 3715, 6886, 3734, 6814, 3702},
+	}},
+	{"TL", [][]int16{
+		{12497, -889, 12509, -866, 12595, -843, 12664, -840, 12696, -827, 12734, -840, 12697, -867, 12593, -911, 12509, -939, 12507, -909},
+	}},
+	{"TM", [][]int16{
+		{5250, 4178, 5294, 4212, 5408, 4232, 5476, 4204, 5546, 4126, 5597, 4131, 5710, 4132, 5693, 4183, 5779, 4217, 5863, 4275, 5998, 4222, 6008, 4143, 6047, 4122, 6155, 4127, 6188, 4108, 6237, 4005, 6352, 3936, 6417, 3889, 6522, 3840, 6655, 3797, 6652, 3736, 6622, 3739, 6575, 3766, 6559, 3731, 6475, 3711, 6455, 3631, 6398, 3601, 6319, 3586, 6298, 3540, 6223, 3527, 6121, 3565, 6112, 3649, 6038, 3653, 5923, 3741, 5844, 3752, 5733, 3803, 5662, 3812, 5618, 3794, 5551, 3796, 5480, 3739, 5392, 3720, 5374, 3791, 5388, 3895, 5310, 3929, 5336, 3998, 5269, 4003, 5292, 4088, 5386, 4063, 5474, 4095, 5401, 4155, 5372, 4212, 5292, 4187, 5281, 4114},
+	}},
+	{"TN", [][]int16{
+		{948, 3031, 906, 3210, 844, 3251, 843, 3275, 761, 3334, 752, 3410, 814, 3466, 838, 3548, 822, 3643, 842, 3695, 951, 3735, 1021, 3723, 1018, 3672, 1103, 3709, 1110, 3690, 1060, 3641, 1059, 3595, 1094, 3570, 1081, 3483, 1015, 3433, 1034, 3379, 1086, 3377, 1111, 3329, 1149, 3314, 1143, 3237, 1094, 3208, 1064, 3176, 995, 3138, 1006, 3096, 997, 3054},
+	}},
+	{"TR", [][]int16{
+		{4477, 3717, 4429, 3700, 4394, 3726, 4278, 3739, 4235, 3723, 4121, 3707, 4067, 3709, 3952, 3672, 3870, 3671, 3817, 3690, 3707, 3662, 3674, 3682, 3669, 3626, 3642, 3604, 3615, 3582, 3578, 3627, 3616, 3665, 3555, 3657, 3471, 3680, 3403, 3622, 3251, 3611, 3170, 3664, 3062, 3668, 3039, 3626, 2970, 3614, 2873, 3668, 2764, 3666, 2705, 3765, 2632, 3821, 2680, 3899, 2617, 3946, 2728, 4042, 2882, 4046, 2924, 4122, 3115, 4109, 3235, 4174, 3351, 4202, 3517, 4204, 3691, 4134, 3835, 4095, 3951, 4110, 4037, 4101, 4155, 4154, 4262, 4158, 4358, 4109, 4375, 4074, 4366, 4025, 4440, 4001, 4479, 3971, 4411, 3943, 4442, 3828, 4423, 3797},
+	}},
+	{"TR", [][]int16{
+		{2612, 4183, 2714, 4214, 2800, 4201, 2812, 4162, 2899, 4130, 2881, 4105, 2762, 4100, 2719, 4069, 2636, 4015, 2604, 4062, 2606, 4082, 2629, 4094, 2660, 4156},
+	}},
+	{"TT", [][]int16{
+		{-6168, 1076, -6110, 1089, -6090, 1086, -6094, 1011, -6177, 1000, -6195, 1009, -6166, 1036},
+	}},
+	{"TW", [][]int16{
+		{12178, 2439, 12118, 2279, 12075, 2197, 12022, 2281, 12011, 2356, 12069, 2454, 12150, 2530, 12195, 2500},
+	}},
+	{"TZ", [][]int16{
+		{3390, -95, 3407, -106, 3770, -310, 3777, -368, 3920, -468, 3874, -591, 3880, -648, 3944, -684, 3947, -710, 3919, -770, 3925, -801, 3919, -849, 3954, -911, 3995, -1010, 4032, -1032, 3952, -1090, 3843, -1129, 3783, -1127, 3747, -1157, 3678, -1159, 3651, -1172, 3531, -1144, 3456, -1152, 3428, -1016, 3394, -969, 3374, -942, 3276, -923, 3219, -893, 3156, -876, 3116, -859, 3074, -834, 3020, -708, 2962, -652, 2942, -594, 2952, -542, 2934, -450, 2975, -445, 3012, -409, 3051, -357, 3075, -336, 3074, -303, 3053, -281, 3047, -241, 3076, -229, 3082, -170, 3042, -113, 3077, -101, 3187, -103},
+	}},
+	{"UA", [][]int16{
+		{3179, 5210, 3216, 5206, 3241, 5229, 3272, 5224, 3375, 5234, 3439, 5177, 3414, 5157, 3422, 5126, 3502, 5121, 3538, 5077, 3536, 5058, 3663, 5023, 3739, 5038, 3801, 4992, 3859, 4993, 4007, 4960, 4008, 4931, 3967, 4878, 3990, 4823, 3974, 4790, 3877, 4783, 3826, 4755, 3822, 4710, 3743, 4702, 3676, 4670, 3582, 4665, 3496, 4627, 3501, 4574, 3486, 4577, 3473, 4597, 3441, 4601, 3370, 4622, 3344, 4597, 3330, 4608, 3174, 4633, 3168, 4671, 3075, 4658, 3038, 4603, 2960, 4529, 2915, 4546, 2868, 4530, 2823, 4549, 2849, 4560, 2866, 4594, 2893, 4626, 2886, 4644, 2907, 4652, 2917, 4638, 2976, 4635, 3002, 4642, 2984, 4653, 2991, 4667, 2956, 4693, 2942, 4735, 2905, 4751, 2912, 4785, 2867, 4812, 2826, 4816, 2752, 4847, 2686, 4837, 2662, 4822, 2620, 4822, 2595, 4799, 2521, 4789, 2487, 4774, 2440, 4798, 2376, 4799, 2314, 4810, 2271, 4788, 2264, 4815, 2209, 4842, 2228, 4883, 2256, 4909, 2278, 4903, 2252, 4948, 2343, 5031, 2392, 5042, 2403, 5071, 2353, 5158, 2401, 5162, 2455, 5189, 2533, 5191, 2634, 5183, 2745, 5159, 2824, 5157, 2862, 5143, 2899, 5160, 2925, 5137, 3016, 5142, 3056, 5132, 3062, 5182, 3093, 5204},
+	}},
+	{"UG", [][]int16{
+		{3390, -95, 3187, -103, 3077, -101, 3042, -113, 2982, -144, 2958, -134, 2959, -59, 2982, -21, 2988, 60, 3009, 106, 3047, 158, 3085, 185, 3117, 220, 3077, 234, 3083, 351, 3125, 378, 3188, 356, 3269, 379, 3339, 379, 3401, 425, 3448, 356, 3460, 305, 3504, 191, 3467, 118, 3418, 52, 3389, 11},
+	}},
+	{"US", [][]int16{
+		{-12284, 4900, -12000, 4900, -11703, 4900, -11605, 4900, -11300, 4900, -11005, 4900, -10705, 4900, -10405, 4900, -10065, 4900, -9723, 4900, -9516, 4900, -9516, 4938, -9482, 4939, -9464, 4884, -9433, 4867, -9363, 4861, -9261, 4845, -9164, 4814, -9083, 4827, -8960, 4801, -8927, 4802, -8838, 4830, -8744, 4794, -8646, 4755, -8565, 4722, -8488, 4690, -8478, 4664, -8454, 4654, -8460, 4644, -8434, 4641, -8414, 4651, -8409, 4628, -8389, 4612, -8362, 4612, -8347, 4599, -8359, 4582, -8255, 4535, -8234, 4444, -8214, 4357, -8243, 4298, -8290, 4243, -8312, 4208, -8314, 4198, -8303, 4183, -8269, 4168, -8244, 4168, -8128, 4221, -8025, 4237, -7894, 4286, -7892, 4296, -7901, 4327, -7917, 4347, -7872, 4363, -7774, 4363, -7682, 4363, -7650, 4402, -7638, 4410, -7532, 4482, -7487, 4500, -7335, 4501, -7151, 4501, -7140, 4526, -7108, 4531, -7066, 4546, -7031, 4592, -7000, 4669, -6924, 4745, -6890, 4718, -6823, 4735, -6779, 4707, -6779, 4570, -6714, 4514, -6696, 4481, -6803, 4433, -6906, 4398, -7012, 4368, -7065, 4309, -7081, 4287, -7082, 4234, -7050, 4180, -7008, 4178, -7018, 4214, -6988, 4192, -6997, 4164, -7064, 4148, -7112, 4149, -7186, 4132, -7230, 4127, -7288, 4122, -7371, 4093, -7224, 4112, -7194, 4093, -7334, 4063, -7398, 4063, -7395, 4075, -7426, 4047, -7396, 4043, -7418, 3971, -7491, 3894, -7498, 3920, -7520, 3925, -7553, 3950, -7532, 3896, -7507, 3878, -7506, 3840, -7538, 3802, -7594, 3722, -7603, 3726, -7572, 3794, -7623, 3832, -7635, 3915, -7654, 3872, -7633, 3808, -7699, 3824, -7630, 3792, -7626, 3697, -7597, 3690, -7587, 3655, -7573, 3555, -7636, 3481, -7740, 3451, -7805, 3393, -7855, 3386, -7906, 3349, -7920, 3316, -8030, 3251, -8086, 3203, -8134, 3144, -8149, 3073, -8131, 3004, -8098, 2918, -8054, 2847, -8053, 2804, -8006, 2688, -8009, 2621, -8013, 2582, -8038, 2521, -8068, 2508, -8117, 2520, -8133, 2564, -8171, 2587, -8224, 2673, -8271, 2750, -8286, 2789, -8265, 2855, -8293, 2910, -8371, 2994, -8410, 3009, -8511, 2964, -8529, 2969, -8577, 3015, -8640, 3040, -8753, 3027, -8842, 3038, -8918, 3032, -8959, 3016, -8941, 2989, -8943, 2949, -8922, 2929, -8941, 2916, -8978, 2931, -9015, 2912, -9088, 2915, -9163, 2968, -9250, 2955, -9323, 2978, -9385, 2971, -9469, 2948, -9560, 2874, -9659, 2831, -9714, 2783, -9737, 2738, -9738, 2669, -9733, 2621, -9714, 2587, -9753, 2584, -9824, 2606, -9902, 2637, -9930, 2684, -9952, 2754, -10011, 2811, -10046, 2870, -10096, 2938, -10166, 2978, -10248, 2976, -10311, 2897, -10394, 2927, -10446, 2957, -10471, 3012, -10504, 3064, -10563, 3108, -10614, 3140, -10651, 3175, -10824, 3175, -10824, 3134, -10904, 3134, -11102, 3133, -11330, 3204, -11482, 3253, -11472, 3272, -11599, 3261, -11713, 3254, -11730, 3305, -11794, 3362, -11841, 3374, -11852, 3403, -11908, 3408, -11944, 3435, -12037, 3445, -12062, 3461, -12074, 3516, -12171, 3616, -12255, 3755, -12251, 3778, -12295, 3811, -12373, 3895, -12387, 3977, -12440, 4031, -12418, 4114, -12421, 4200, -12453, 4277, -12414, 4371, -12402, 4462, -12390, 4552, -12408, 4686, -12440, 4772, -12469, 4818, -12457, 4838, -12312, 4804, -12259, 4710, -12234, 4736, -12250, 4818},
+	}},
+	{"US", [][]int16{
+		{-15540, 2008, -15522, 1999, -15506, 1986, -15481, 1951, -15483, 1945, -15522, 1924, -15554, 1908, -15569, 1892, -15594, 1906, -15591, 1934, -15607, 1970, -15602, 1981, -15585, 1998, -15592, 2017, -15586, 2027, -15579, 2025},
+	}},
+	{"US", [][]int16{
+		{-15600, 2076, -15608, 2064, -15641, 2057, -15659, 2078, -15670, 2086, -15671, 2093, -15661, 2101, -15626, 2092},
+	}},
+	{"US", [][]int16{
+		{-15676, 2118, -15679, 2107, -15733, 2110, -15725, 2122},
+	}},
+	{"US", [][]int16{
+		{-15803, 2172, -15794, 2165, -15765, 2132, -15771, 2126, -15778, 2128, -15813, 2131, -15825, 2154, -15829, 2158},
+	}},
+	{"US", [][]int16{
+		{-15937, 2221, -15935, 2198, -15946, 2188, -15980, 2207, -15975, 2214, -15960, 2224},
+	}},
+	{"US", [][]int16{
+		{-16647, 6038, -16567, 6029, -16558, 5991, -16619, 5975, -16685, 5994, -16746, 6021},
+	}},
+	{"US", [][]int16{
+		{-15323, 5797, -15256, 5790, -15214, 5759, -15301, 5712, -15401, 5673, -15452, 5699, -15467, 5746, -15376, 5782},
+	}},
+	{"US", [][]int16{
+		{-14099, 6971, -14099, 6600, -14100, 6031, -14001, 6028, -13904, 6000, -13834, 5956, -13745, 5890, -13648, 5946, -13548, 5979, -13494, 5927, -13427, 5886, -13336, 5841, -13273, 5769, -13171, 5655, -13001, 5592, -12998, 5528, -13054, 5480, -13109, 5518, -13197, 5550, -13225, 5637, -13354, 5718, -13408, 5812, -13504, 5819, -13663, 5821, -13780, 5850, -13987, 5954, -14083, 5973, -14257, 6008, -14396, 6000, -14593, 6046, -14711, 6088, -14822, 6067, -14802, 5998, -14857, 5991, -14973, 5971, -15061, 5937, -15172, 5916, -15186, 5974, -15141, 6073, -15035, 6103, -15062, 6128, -15190, 6073, -15258, 6006, -15402, 5935, -15329, 5886, -15423, 5815, -15531, 5773, -15631, 5742, -15656, 5698, -15812, 5646, -15843, 5599, -15960, 5557, -16029, 5564, -16122, 5536, -16224, 5502, -16307, 5469, -16479, 5440, -16494, 5457, -16385, 5504, -16287, 5535, -16180, 5589, -16056, 5601, -16007, 5642, -15868, 5702, -15846, 5722, -15772, 5757, -15755, 5833, -15704, 5892, -15819, 5862, -15852, 5879, -15906, 5842, -15971, 5893, -15998, 5857, -16036, 5907, -16136, 5867, -16197, 5867, -16205, 5927, -16187, 5963, -16252, 5999, -16382, 5980, -16466, 6027, -16535, 6051, -16535, 6107, -16612, 6150, -16573, 6207, -16492, 6263, -16456, 6315, -16375, 6322, -16307, 6306, -16226, 6354, -16153, 6346, -16077, 6377, -16096, 6422, -16152, 6440, -16078, 6479, -16139, 6478, -16245, 6456, -16276, 6434, -16355, 6456, -16496, 6445, -16643, 6469, -16685, 6509, -16811, 6567, -16671, 6609, -16447, 6658, -16365, 6658, -16379, 6608, -16168, 6612, -16249, 6674, -16372, 6712, -16443, 6762, -16539, 6804, -16676, 6836, -16620, 6888, -16443, 6892, -16317, 6937, -16293, 6986, -16191, 7033, -16093, 7045, -15904, 7089, -15812, 7082, -15658, 7136, -15507, 7115, -15434, 7070, -15390, 7089, -15221, 7083, -15227, 7060, -15074, 7043, -14972, 7053, -14761, 7021, -14569, 7012, -14492, 6999, -14359, 7015, -14207, 6985},
+	}},
+	{"US", [][]int16{
+		{-17173, 6378, -17111, 6359, -17049, 6369, -16968, 6343, -16869, 6330, -16877, 6319, -16953, 6298, -17029, 6319, -17067, 6338, -17155, 6332, -17179, 6341},
+	}},
+	{"UY", [][]int16{
+		{-5763, -3022, -5698, -3011, -5597, -3088, -5560, -3085, -5457, -3149, -5379, -3205, -5321, -3273, -5365, -3320, -5337, -3377, -5381, -3440, -5494, -3495, -5567, -3475, -5622, -3486, -5714, -3443, -5782, -3446, -5843, -3391, -5835, -3326, -5813, -3304, -5814, -3204, -5787, -3102},
+	}},
+	{"UZ", [][]int16{
+		{5597, 4131, 5593, 4500, 5850, 4559, 5869, 4550, 6024, 4478, 6106, 4441, 6201, 4350, 6319, 4365, 6490, 4373, 6610, 4300, 6602, 4199, 6651, 4199, 6671, 4117, 6799, 4114, 6826, 4066, 6863, 4067, 6907, 4138, 7039, 4208, 7096, 4227, 7126, 4217, 7042, 4152, 7116, 4114, 7187, 4139, 7306, 4087, 7177, 4015, 7101, 4024, 7060, 4022, 7046, 4050, 7067, 4096, 6933, 4073, 6901, 4009, 6854, 3953, 6770, 3958, 6744, 3914, 6818, 3890, 6839, 3816, 6783, 3714, 6708, 3736, 6652, 3736, 6655, 3797, 6522, 3840, 6417, 3889, 6352, 3936, 6237, 4005, 6188, 4108, 6155, 4127, 6047, 4122, 6008, 4143, 5998, 4222, 5863, 4275, 5779, 4217, 5693, 4183, 5710, 4132},
+	}},
+	{"VE", [][]int16{
+		{-6073, 520, -6060, 492, -6097, 454, -6209, 416, -6280, 401, -6309, 377, -6389, 402, -6463, 415, -6482, 406, -6437, 380, -6441, 313, -6427, 250, -6342, 241, -6337, 220, -6408, 192, -6420, 149, -6461, 133, -6535, 110, -6555, 79, -6633, 72, -6688, 125, -6718, 225, -6745, 260, -6781, 282, -6730, 332, -6734, 354, -6762, 384, -6782, 450, -6774, 522, -6752, 556, -6734, 610, -6770, 627, -6827, 615, -6899, 621, -6939, 610, -7009, 696, -7067, 709, -7196, 699, -7220, 734, -7244, 742, -7248, 763, -7236, 800, -7244, 841, -7266, 863, -7279, 909, -7330, 915, -7303, 974, -7291, 1045, -7261, 1082, -7223, 1111, -7197, 1161, -7133, 1178, -7136, 1154, -7195, 1142, -7162, 1097, -7163, 1045, -7207, 987, -7170, 907, -7126, 914, -7104, 986, -7135, 1021, -7140, 1097, -7016, 1138, -7029, 1185, -6994, 1216, -6958, 1146, -6888, 1144, -6823, 1089, -6819, 1055, -6730, 1055, -6623, 1065, -6566, 1020, -6489, 1008, -6433, 1039, -6432, 1064, -6308, 1070, -6188, 1072, -6273, 1042, -6239, 995, -6159, 987, -6083, 938, -6067, 858, -6015, 860, -5976, 837, -6055, 778, -6064, 742, -6030, 704, -6054, 686, -6116, 670, -6114, 623, -6141, 596},
+	}},
+	{"VN", [][]int16{
+		{10433, 1049, 10520, 1089, 10625, 1096, 10581, 1157, 10749, 1234, 10761, 1354, 10738, 1420, 10756, 1520, 10731, 1591, 10656, 1660, 10593, 1749, 10509, 1867, 10390, 1927, 10418, 1962, 10482, 1989, 10444, 2076, 10320, 2077, 10275, 2168, 10217, 2246, 10271, 2271, 10350, 2270, 10448, 2282, 10533, 2335, 10581, 2298, 10673, 2279, 10657, 2222, 10704, 2181, 10805, 2155, 10672, 2070, 10588, 1975, 10566, 1906, 10643, 1800, 10736, 1670, 10827, 1608, 10888, 1528, 10934, 1343, 10920, 1167, 10837, 1101, 10722, 1036, 10641, 953, 10516, 860, 10480, 924, 10508, 992},
+	}},
+	{"VU", [][]int16{
+		{16722, -1589, 16784, -1647, 16752, -1660, 16718, -1616},
+	}},
+	{"VU", [][]int16{
+		{16679, -1567, 16665, -1539, 16663, -1463, 16711, -1493, 16727, -1574, 16700, -1561},
+	}},
+	{"XK", [][]int16{
+		{2059, 4186, 2052, 4222, 2028, 4232, 2007, 4259, 2026, 4281, 2050, 4288, 2064, 4322, 2081, 4327, 2096, 4313, 2114, 4307, 2127, 4291, 2144, 4286, 2163, 4268, 2178, 4268, 2166, 4244, 2154, 4232, 2158, 4225, 2135, 4221, 2076, 4205, 2072, 4185},
+	}},
+	{"YE", [][]int16{
+		{5200, 1900, 5278, 1735, 5311, 1665, 5239, 1638, 5219, 1594, 5217, 1560, 5117, 1518, 4957, 1471, 4868, 1400, 4824, 1395, 4794, 1401, 4735, 1359, 4672, 1340, 4588, 1335, 4563, 1329, 4541, 1303, 4514, 1295, 4499, 1270, 4449, 1272, 4418, 1259, 4348, 1264, 4322, 1322, 4325, 1377, 4309, 1406, 4289, 1480, 4260, 1521, 4281, 1526, 4270, 1572, 4282, 1591, 4278, 1635, 4322, 1667, 4312, 1709, 4338, 1758, 4379, 1732, 4406, 1741, 4522, 1743, 4540, 1733, 4637, 1723, 4675, 1728, 4700, 1695, 4747, 1712, 4818, 1817, 4912, 1862},
+	}},
+	{"ZA", [][]int16{
+		{1634, -2858, 1682, -2808, 1722, -2836, 1739, -2878, 1784, -2886, 1846, -2905, 1900, -2897, 1989, -2846, 1990, -2477, 2017, -2492, 2076, -2587, 2067, -2648, 2089, -2683, 2161, -2673, 2211, -2628, 2258, -2598, 2282, -2550, 2331, -2527, 2373, -2539, 2421, -2567, 2503, -2572, 2566, -2549, 2577, -2517, 2594, -2470, 2649, -2462, 2679, -2424, 2712, -2357, 2802, -2283, 2943, -2209, 2984, -2210, 3032, -2227, 3066, -2215, 3119, -2225, 3167, -2366, 3193, -2437, 3175, -2548, 3184, -2584, 3133, -2566, 3104, -2573, 3095, -2602, 3068, -2640, 3069, -2674, 3128, -2729, 3187, -2718, 3207, -2673, 3283, -2674, 3258, -2747, 3246, -2830, 3220, -2875, 3152, -2926, 3133, -2940, 3090, -2991, 3062, -3042, 3006, -3114, 2893, -3217, 2822, -3277, 2746, -3323, 2642, -3361, 2591, -3367, 2578, -3394, 2517, -3380, 2468, -3399, 2359, -3379, 2299, -3392, 2257, -3386, 2154, -3426, 2069, -3442, 2007, -3480, 1962, -3482, 1919, -3446, 1886, -3444, 1842, -3400, 1838, -3414, 1824, -3387, 1825, -3328, 1793, -3261, 1825, -3243, 1822, -3166, 1757, -3073, 1706, -2988},
+		{2898, -2896, 2854, -2865, 2807, -2885, 2753, -2924, 2700, -2988, 2775, -3065, 2811, -3055, 2829, -3023, 2885, -3007, 2902, -2974, 2933, -2926},
+	}},
+	{"ZM", [][]int16{
+		{3074, -834, 3116, -859, 3156, -876, 3219, -893, 3276, -923, 3323, -968, 3349, -1053, 3332, -1080, 3311, -1161, 3331, -1244, 3299, -1278, 3269, -1371, 3321, -1397, 3018, -1480, 3027, -1551, 2952, -1564, 2895, -1604, 2883, -1639, 2847, -1647, 2760, -1729, 2704, -1794, 2671, -1796, 2638, -1785, 2526, -1774, 2508, -1766, 2508, -1758, 2468, -1735, 2403, -1730, 2322, -1752, 2256, -1690, 2189, -1608, 2193, -1290, 2402, -1291, 2393, -1257, 2408, -1219, 2390, -1172, 2402, -1124, 2391, -1093, 2426, -1095, 2431, -1126, 2478, -1124, 2542, -1133, 2575, -1178, 2655, -1192, 2716, -1161, 2739, -1213, 2816, -1227, 2852, -1270, 2893, -1325, 2970, -1326, 2962, -1218, 2934, -1236, 2864, -1197, 2837, -1179, 2850, -1079, 2867, -961, 2845, -916, 2873, -853, 2900, -841, 3035, -824},
+	}},
+	{"ZW", [][]int16{
+		{3119, -2225, 3066, -2215, 3032, -2227, 2984, -2210, 2943, -2209, 2879, -2164, 2802, -2149, 2773, -2085, 2772, -2050, 2730, -2039, 2616, -1929, 2585, -1871, 2565, -1854, 2526, -1774, 2638, -1785, 2671, -1796, 2704, -1794, 2760, -1729, 2847, -1647, 2883, -1639, 2895, -1604, 2952, -1564, 3027, -1551, 3034, -1588, 3117, -1586, 3164, -1607, 3185, -1632, 3233, -1639, 3285, -1671, 3285, -1798, 3265, -1867, 3261, -1942, 3277, -1972, 3266, -2030, 3251, -2040, 3224, -2112},
+	}},
+}
@@ -0,0 +1,70 @@
+package geo
+
+import "testing"
+
+func TestOfflineReverseCountry(t *testing.T) {
+	tests := []struct {
+		ll       LatLng
+		expected string
+	}{
+		{LatLng{40.7128, -74.0060}, "US"},
+		{LatLng{45.4215, -75.6972}, "CA"},
+		{LatLng{48.8566, 2.3522}, "FR"},
+		{LatLng{-33.8688, 151.2093}, "AU"},
+		{LatLng{35.6762, 139.6503}, "JP"},
+		{LatLng{-29.3100, 27.4800}, "LS"},
+		{LatLng{-26.2041, 28.0473}, "ZA"},
+		{LatLng{64.7, 177.5}, "RU"},
+		{LatLng{-17.8, 178.0}, "FJ"},
+		{LatLng{-23.5505, -46.6333}, "BR"},
+	}
+	for _, test := range tests {
+		got, ok := OfflineReverseCountry(test.ll)
+		if !ok || got != test.expected {
+			t.Errorf("%v: Expected: %s, Got: %s (%v)", test.ll, test.expected, got, ok)
+		}
+	}
+	if got, ok := OfflineReverseCountry(LatLng{30, -40}); ok {
+		t.Errorf("Expected no country in the Atlantic, Got: %s", got)
+	}
+}
+
+func TestOfflineTimezone(t *testing.T) {
+	tests := []struct {
+		ll       LatLng
+		expected string
+	}{
+		{LatLng{48.8566, 2.3522}, "Europe/Paris"},
+		{LatLng{35.6762, 139.6503}, "Asia/Tokyo"},
+		{LatLng{40.7128, -74.0060}, "America/New_York"},
+		{LatLng{41.2565, -95.9345}, "America/Chicago"},
+		{LatLng{38.5816, -121.4944}, "America/Los_Angeles"},
+		{LatLng{43.6532, -79.3832}, "America/Toronto"},
+		{LatLng{49.2827, -123.1207}, "America/Vancouver"},
+		{LatLng{-31.9505, 115.8605}, "Australia/Perth"},
+		{LatLng{55.7558, 37.6173}, "Europe/Moscow"},
+		{LatLng{43.1155, 131.8855}, "Asia/Vladivostok"},
+	}
+	for _, test := range tests {
+		got, ok := OfflineTimezone(test.ll)
+		if !ok || got != test.expected {
+			t.Errorf("%v: Expected: %s, Got: %s (%v)", test.ll, test.expected, got, ok)
+		}
+	}
+	if got, ok := OfflineTimezone(LatLng{30, -40}); ok {
+		t.Errorf("Expected no time zone in the Atlantic, Got: %s", got)
+	}
+	for _, cp := range countryPolygons {
+		_, single := countryTimezones[cp.code]
+		if _, multi := timezonePoints[cp.code]; single == multi && cp.code != "AQ" {
+			t.Errorf("%s: Expected exactly one of a zone or zone points", cp.code)
+		}
+	}
+	for cc, points := range timezonePoints {
+		for _, p := range points {
+			if got, _ := OfflineReverseCountry(p.LatLng); got != cc {
+				t.Errorf("%s: Expected the point for %s at %v inside it, Got: %s", cc, p.zone, p.LatLng, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package geo
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Output columns a CSVMapping can append. CSVFormattedAddress is an alias of
+// CSVFormatted.
+const (
+	CSVLat              = "lat"
+	CSVLng              = "lng"
+	CSVFormatted        = "formatted"
+	CSVFormattedAddress = "formatted_address"
+	CSVPostalCode       = "postal_code"
+	CSVCountryCode      = "country_code"
+	CSVConfidence       = "confidence"
+	CSVStatus           = "status"
+	CSVError            = "error"
+)
+
+// CSVStatusError is the status column for rows that failed with something
+// other than an API status, such as a network error.
+const CSVStatusError = "ERROR"
+
+// DefaultCSVOutputColumns are appended when a CSVMapping names none.
+var DefaultCSVOutputColumns = []string{CSVLat, CSVLng, CSVFormatted, CSVPostalCode, CSVCountryCode, CSVConfidence, CSVStatus, CSVError}
+
+var csvFields = map[string]func(a *Address, err error) string{
+	CSVLat: func(a *Address, err error) string {
+		if a == nil {
+			return ""
+		}
+		return strconv.FormatFloat(a.Lat, 'f', -1, 64)
+	},
+	CSVLng: func(a *Address, err error) string {
+		if a == nil {
+			return ""
+		}
+		return strconv.FormatFloat(a.Lng, 'f', -1, 64)
+	},
+	CSVFormatted:        csvFormatted,
+	CSVFormattedAddress: csvFormatted,
+	CSVPostalCode: func(a *Address, err error) string {
+		if a == nil || a.Response == nil || len(a.Response.Results) == 0 {
+			return ""
+		}
+		return a.Response.Results[0].ParsedAddress().PostalCode
+	},
+	CSVCountryCode: func(a *Address, err error) string {
+		if a == nil {
+			return ""
+		}
+		return a.CountryCode()
+	},
+	CSVConfidence: func(a *Address, err error) string {
+		if a == nil || a.Response == nil || len(a.Response.Results) == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(a.Response.Results[0].Confidence, 'f', -1, 64)
+	},
+	CSVStatus: func(a *Address, err error) string {
+		var ge *GeocoderError
+		switch {
+		case err == nil:
+			return StatusOk
+		case errors.As(err, &ge):
+			return ge.Status
+		}
+		return CSVStatusError
+	},
+	CSVError: func(a *Address, err error) string {
+		var ge *GeocoderError
+		if err == nil || errors.As(err, &ge) {
+			return ""
+		}
+		return err.Error()
+	},
+}
+
+func csvFormatted(a *Address, err error) string {
+	if a == nil {
+		return ""
+	}
+	return a.Address
+}
+
+type (
+	// CSVMapping describes how to geocode a CSV file: the values of
+	// AddressColumns are joined with ", " (skipping empty ones) to form each
+	// row's query, and OutputColumns, or DefaultCSVOutputColumns if it is
+	// empty, are appended to each row.
+	CSVMapping struct {
+		AddressColumns []string
+		OutputColumns  []string
+	}
+
+	// CSVSchema is a CSVMapping bound to the header of a particular file.
+	CSVSchema struct {
+		header  []string
+		address []int
+		output  []string
+	}
+)
+
+// Bind resolves m against the header row of a file.
+func (m CSVMapping) Bind(header []string) (*CSVSchema, error) {
+	if len(m.AddressColumns) == 0 {
+		return nil, &InvalidInputError{Field: "CSV mapping", Reason: "no address columns given"}
+	}
+	s := &CSVSchema{header: header, output: m.OutputColumns}
+	if len(s.output) == 0 {
+		s.output = DefaultCSVOutputColumns
+	}
+	for _, name := range m.AddressColumns {
+		col := -1
+		for i, h := range header {
+			if strings.TrimSpace(h) == name {
+				col = i
+				break
+			}
+		}
+		if col < 0 {
+			return nil, &InvalidInputError{Field: "CSV mapping", Reason: strconv.Quote(name) + " is not a column of the input"}
+		}
+		s.address = append(s.address, col)
+	}
+	for _, name := range s.output {
+		if csvFields[name] == nil {
+			return nil, &InvalidInputError{Field: "CSV mapping", Reason: strconv.Quote(name) + " is not an output column"}
+		}
+	}
+	return s, nil
+}
+
+// Header returns the output header: the input header followed by the
+// output columns.
+func (s *CSVSchema) Header() []string {
+	return append(append([]string{}, s.header...), s.output...)
+}
+
+// Query returns the geocoding query for row.
+func (s *CSVSchema) Query(row []string) string {
+	parts := []string{}
+	for _, col := range s.address {
+		if col < len(row) {
+			if v := strings.TrimSpace(row[col]); v != "" {
+				parts = append(parts, v)
+			}
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Row returns row with the output columns for a geocoding result appended.
+func (s *CSVSchema) Row(row []string, a *Address, err error) []string {
+	out := append([]string{}, row...)
+	for _, name := range s.output {
+		out = append(out, csvFields[name](a, err))
+	}
+	return out
+}
+
+// RunCSV geocodes every row of the CSV file r as described by m and writes
+// the rows, with the output columns appended, to w in input order. If ctx
+// ends first, every row is still written and the *PartialBatchError is
+// returned afterwards.
+func (s *Scheduler) RunCSV(ctx context.Context, r io.Reader, w io.Writer, m CSVMapping, opts ...RequestOption) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+	schema, err := m.Bind(header)
+	if err != nil {
+		return err
+	}
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return err
+	}
+	queries := make([]string, len(rows))
+	for i, row := range rows {
+		queries[i] = schema.Query(row)
+	}
+	results, runErr := s.Run(ctx, queries, opts...)
+
+	cw := csv.NewWriter(w)
+	cw.Write(schema.Header())
+	for i, row := range rows {
+		cw.Write(schema.Row(row, results[i].Address, results[i].Err))
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return runErr
+}
@@ -0,0 +1,64 @@
+package geo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSchedulerRunCSV(t *testing.T) {
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		switch q {
+		case "24 Sussex Dr, Ottawa, K1M 1M4":
+			r := Result{
+				FormattedAddress: "24 Sussex Dr, Ottawa, ON K1M 1M4, Canada",
+				AddressComponents: []AddressComponent{
+					{LongName: "K1M 1M4", ShortName: "K1M 1M4", Types: []string{"postal_code"}},
+					{LongName: "Canada", ShortName: "CA", Types: []string{"country", "political"}},
+				},
+				Geometry: GeometryData{Location: LatLng{45.44, -75.69}, LocationType: LocationTypeRooftop},
+			}
+			return newAddress(&Response{Status: StatusOk, Results: []Result{r}}), nil
+		case "Nowhere":
+			return nil, &GeocoderError{Status: StatusZeroResults}
+		}
+		return nil, errors.New("connection reset")
+	})
+	in := "id,street,city,zip\n1,24 Sussex Dr,Ottawa,K1M 1M4\n2,,Nowhere,\n3,Broken,,\n"
+	var out bytes.Buffer
+	m := CSVMapping{AddressColumns: []string{"street", "city", "zip"}}
+	if err := NewScheduler(g, 2).RunCSV(context.Background(), strings.NewReader(in), &out, m); err != nil {
+		t.Fatal(err)
+	}
+	expected := "id,street,city,zip,lat,lng,formatted,postal_code,country_code,confidence,status,error\n" +
+		"1,24 Sussex Dr,Ottawa,K1M 1M4,45.44,-75.69,\"24 Sussex Dr, Ottawa, ON K1M 1M4, Canada\",K1M 1M4,CA,1,OK,\n" +
+		"2,,Nowhere,,,,,,,,ZERO_RESULTS,\n" +
+		"3,Broken,,,,,,,,,ERROR,connection reset\n"
+	if out.String() != expected {
+		t.Errorf("Expected: %s, Got: %s", expected, out.String())
+	}
+}
+
+func TestCSVMappingBind(t *testing.T) {
+	header := []string{"id", "address"}
+	tests := []CSVMapping{
+		{},
+		{AddressColumns: []string{"street"}},
+		{AddressColumns: []string{"address"}, OutputColumns: []string{"lat", "altitude"}},
+	}
+	for _, m := range tests {
+		var ie *InvalidInputError
+		if _, err := m.Bind(header); !errors.As(err, &ie) {
+			t.Errorf("%+v: Expected: an InvalidInputError, Got: %v", m, err)
+		}
+	}
+	s, err := CSVMapping{AddressColumns: []string{"address"}, OutputColumns: []string{CSVFormattedAddress}}.Bind(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := strings.Join(s.Header(), ","); h != "id,address,formatted_address" {
+		t.Errorf("Expected: id,address,formatted_address, Got: %s", h)
+	}
+}
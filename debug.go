@@ -0,0 +1,180 @@
+package geo
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redacted replaces secrets in debug records.
+const Redacted = "REDACTED"
+
+// maxDebugBody is the most of each body a DebugRecord keeps.
+const maxDebugBody = 64 << 10
+
+// redactedParams and redactedHeaders carry credentials, as do the JSON
+// fields matched by redactedBodyFields, such as the access token Apple's
+// token exchange returns.
+var (
+	redactedParams     = []string{"key", "signature", "client", "access_token", "auth-token"}
+	redactedHeaders    = []string{"Authorization", "X-Goog-Api-Key", "Cookie"}
+	redactedBodyFields = regexp.MustCompile(`("(?:accessToken|access_token|refreshToken|refresh_token|idToken|id_token)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+type (
+	// DebugRecord is one request as the Client sent it, with credentials
+	// replaced by Redacted, and the response it got. StatusCode is zero if
+	// no response arrived, in which case Err says why.
+	DebugRecord struct {
+		Time        time.Time
+		Method      string
+		URL         string
+		Header      http.Header
+		RequestBody []byte
+		StatusCode  int
+		Body        []byte
+		Duration    time.Duration
+		Err         string
+	}
+
+	// DebugRecorder keeps the last Size requests a Client makes, and writes
+	// each to Writer if it is set, for diagnosing unexpected answers. It is
+	// safe for concurrent use.
+	DebugRecorder struct {
+		Size   int
+		Writer io.Writer
+
+		mu   sync.Mutex
+		ring []DebugRecord
+		next int
+	}
+)
+
+// NewDebugRecorder returns a DebugRecorder keeping the last size requests.
+func NewDebugRecorder(size int) *DebugRecorder {
+	return &DebugRecorder{Size: size}
+}
+
+// WithDebug records every request the Client makes in d.
+func WithDebug(d *DebugRecorder) ClientOption {
+	return func(c *Client) {
+		c.Debug = d
+	}
+}
+
+// Records returns the recorded requests, oldest first.
+func (d *DebugRecorder) Records() []DebugRecord {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append(append([]DebugRecord{}, d.ring[d.next:]...), d.ring[:d.next]...)
+}
+
+func (d *DebugRecorder) add(r DebugRecord) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.Size > 0 {
+		if len(d.ring) < d.Size {
+			d.ring = append(d.ring, r)
+		} else {
+			d.ring[d.next] = r
+			d.next = (d.next + 1) % d.Size
+		}
+	}
+	if d.Writer != nil {
+		fmt.Fprintf(d.Writer, "# %s %s\n%s\n%s\n\n", r.Time.Format(time.RFC3339), r.summary(), r.Curl(), r.Body)
+	}
+}
+
+// record adds the outcome of an attempt at req to c.Debug.
+func (c *Client) record(req *http.Request, payload []byte, start time.Time, resp *http.Response, body []byte, err error) {
+	r := DebugRecord{
+		Time:        start,
+		Method:      req.Method,
+		URL:         redactURL(req.URL),
+		Header:      redactHeader(req.Header),
+		RequestBody: redactBody(truncateBody(payload)),
+		Body:        redactBody(truncateBody(body)),
+		Duration:    time.Since(start),
+	}
+	if resp != nil {
+		r.StatusCode = resp.StatusCode
+	}
+	if err != nil {
+		r.Err = err.Error()
+	}
+	c.Debug.add(r)
+}
+
+func (r DebugRecord) summary() string {
+	if r.StatusCode == 0 {
+		return fmt.Sprintf("failed after %v: %s", r.Duration.Round(time.Millisecond), r.Err)
+	}
+	return fmt.Sprintf("%d in %v", r.StatusCode, r.Duration.Round(time.Millisecond))
+}
+
+// Curl returns a curl command reproducing the request. Substitute the
+// credentials for Redacted before running it.
+func (r DebugRecord) Curl() string {
+	parts := []string{"curl"}
+	if r.Method != "" && r.Method != http.MethodGet {
+		parts = append(parts, "-X", r.Method)
+	}
+	names := make([]string, 0, len(r.Header))
+	for k := range r.Header {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		for _, v := range r.Header[k] {
+			parts = append(parts, "-H", shellQuote(k+": "+v))
+		}
+	}
+	if len(r.RequestBody) > 0 {
+		parts = append(parts, "--data-raw", shellQuote(string(r.RequestBody)))
+	}
+	return strings.Join(append(parts, shellQuote(r.URL)), " ")
+}
+
+func redactURL(u *url.URL) string {
+	cu := *u
+	q := cu.Query()
+	for _, p := range redactedParams {
+		if q.Has(p) {
+			q.Set(p, Redacted)
+		}
+	}
+	cu.RawQuery = q.Encode()
+	return cu.String()
+}
+
+func redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, k := range redactedHeaders {
+		if out.Get(k) != "" {
+			out.Set(k, Redacted)
+		}
+	}
+	return out
+}
+
+func redactBody(b []byte) []byte {
+	return redactedBodyFields.ReplaceAll(b, []byte(`$1"`+Redacted+`"`))
+}
+
+func truncateBody(b []byte) []byte {
+	if len(b) > maxDebugBody {
+		b = b[:maxDebugBody]
+	}
+	return append([]byte(nil), b...)
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,100 @@
+package geo
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDebugRecorder(t *testing.T) {
+	c, _ := newTestClient(t, `{"status":"ZERO_RESULTS","results":[]}`)
+	var log bytes.Buffer
+	d := NewDebugRecorder(2)
+	d.Writer = &log
+	c.Debug = d
+	c.Header = map[string][]string{"X-Goog-Api-Key": {"secret"}}
+	for _, q := range []string{"one", "two", "three"} {
+		c.Geocode(context.Background(), q)
+	}
+
+	records := d.Records()
+	if len(records) != 2 {
+		t.Fatalf("Expected: 2 records, Got: %d", len(records))
+	}
+	last := records[1]
+	u, _ := url.Parse(last.URL)
+	if u.Query().Get("address") != "three" || records[0].Method != "GET" {
+		t.Errorf("Expected: the last two requests, oldest first, Got: %v then %v", records[0].URL, last.URL)
+	}
+	if u.Query().Get("key") != Redacted || last.Header.Get("X-Goog-Api-Key") != Redacted {
+		t.Errorf("Expected: credentials redacted, Got: %s %v", last.URL, last.Header)
+	}
+	if last.StatusCode != 200 || !strings.Contains(string(last.Body), "ZERO_RESULTS") {
+		t.Errorf("Unexpected response: %d %s", last.StatusCode, last.Body)
+	}
+	if strings.Contains(log.String(), "test-key") || strings.Contains(log.String(), "secret") {
+		t.Errorf("Expected: no credentials in the log, Got: %s", log.String())
+	}
+	if n := strings.Count(log.String(), "curl "); n != 3 {
+		t.Errorf("Expected: 3 logged requests, Got: %d", n)
+	}
+}
+
+func TestDebugRecordCurl(t *testing.T) {
+	r := DebugRecord{
+		Method:      "POST",
+		URL:         "https://example.com/v1:validate?key=REDACTED",
+		Header:      map[string][]string{"Content-Type": {"application/json"}},
+		RequestBody: []byte(`{"q":"O'Brien St"}`),
+	}
+	expected := `curl -X POST -H 'Content-Type: application/json' --data-raw '{"q":"O'\''Brien St"}' 'https://example.com/v1:validate?key=REDACTED'`
+	if got := r.Curl(); got != expected {
+		t.Errorf("Expected: %s, Got: %s", expected, got)
+	}
+}
+
+func TestDebugRecorderRedactsTokens(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	p8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/token" {
+			w.Write([]byte(`{"accessToken": "SECRET-ACCESS-TOKEN", "expiresInSeconds": 1800}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"coordinate": {"latitude": 1, "longitude": 2}, "formattedAddressLines": ["x"]}]}`))
+	}))
+	defer srv.Close()
+
+	var log bytes.Buffer
+	d := NewDebugRecorder(5)
+	d.Writer = &log
+	a, err := NewAppleGeocoder(NewClient("", WithBaseURL(srv.URL), WithDebug(d)), "TEAM123456", "KEY1234567", p8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Geocode(context.Background(), "x"); err != nil {
+		t.Fatal(err)
+	}
+	records := d.Records()
+	if len(records) != 2 || !strings.Contains(string(records[0].Body), `"accessToken": "`+Redacted+`"`) {
+		t.Errorf("Expected: the token response with its token redacted, Got: %+v", records)
+	}
+	if strings.Contains(log.String(), "SECRET-ACCESS-TOKEN") {
+		t.Errorf("Expected: no access token in the log, Got: %s", log.String())
+	}
+	for _, r := range records {
+		if strings.Contains(string(r.Body), "SECRET-ACCESS-TOKEN") || strings.Contains(r.Curl(), "SECRET-ACCESS-TOKEN") {
+			t.Errorf("Expected: no access token in the record, Got: %s %s", r.Curl(), r.Body)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+type (
+	// Route is one way of getting from the origin to the destination.
+	// Polyline is the decoded overview path, and WaypointOrder gives the
+	// order waypoints were visited in when WithOptimizedWaypoints was used.
+	Route struct {
+		Summary       string
+		Legs          []RouteLeg
+		Polyline      []LatLng
+		Bounds        BoundingBox
+		WaypointOrder []int
+		Warnings      []string
+		Copyrights    string
+	}
+
+	// RouteLeg is the part of a Route between two consecutive stops.
+	RouteLeg struct {
+		StartAddress      string
+		EndAddress        string
+		StartLocation     LatLng
+		EndLocation       LatLng
+		Distance          Distance
+		Duration          time.Duration
+		DurationInTraffic time.Duration
+		Steps             []RouteStep
+	}
+
+	// RouteStep is a single instruction within a RouteLeg.
+	RouteStep struct {
+		Instructions  string
+		Maneuver      string
+		TravelMode    string
+		StartLocation LatLng
+		EndLocation   LatLng
+		Distance      Distance
+		Duration      time.Duration
+		Polyline      []LatLng
+	}
+
+	encodedPolyline struct {
+		Points string `json:"points"`
+	}
+
+	directionsResponse struct {
+		Status string `json:"status"`
+		Routes []struct {
+			Summary          string          `json:"summary"`
+			Bounds           BoundingBox     `json:"bounds"`
+			OverviewPolyline encodedPolyline `json:"overview_polyline"`
+			WaypointOrder    []int           `json:"waypoint_order"`
+			Warnings         []string        `json:"warnings"`
+			Copyrights       string          `json:"copyrights"`
+			Legs             []struct {
+				StartAddress      string    `json:"start_address"`
+				EndAddress        string    `json:"end_address"`
+				StartLocation     LatLng    `json:"start_location"`
+				EndLocation       LatLng    `json:"end_location"`
+				Distance          textValue `json:"distance"`
+				Duration          textValue `json:"duration"`
+				DurationInTraffic textValue `json:"duration_in_traffic"`
+				Steps             []struct {
+					HTMLInstructions string          `json:"html_instructions"`
+					Maneuver         string          `json:"maneuver"`
+					TravelMode       string          `json:"travel_mode"`
+					StartLocation    LatLng          `json:"start_location"`
+					EndLocation      LatLng          `json:"end_location"`
+					Distance         textValue       `json:"distance"`
+					Duration         textValue       `json:"duration"`
+					Polyline         encodedPolyline `json:"polyline"`
+				} `json:"steps"`
+			} `json:"legs"`
+		} `json:"routes"`
+	}
+)
+
+// Directions returns routes from origin to destination, with polylines
+// already decoded.
+func (c *Client) Directions(ctx context.Context, origin, destination LatLng, opts ...RequestOption) ([]Route, error) {
+	o := newRequestOptions(opts)
+	if err := validateLatLng("origin", origin); err != nil {
+		return nil, err
+	}
+	if err := validateLatLng("destination", destination); err != nil {
+		return nil, err
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	o.apply(params)
+	params.Set("origin", latLngParam(origin))
+	params.Set("destination", latLngParam(destination))
+	if len(o.waypoints) > 0 {
+		w := latLngsParam(o.waypoints)
+		if o.optimizeWaypoints {
+			w = "optimize:true|" + w
+		}
+		params.Set("waypoints", w)
+	}
+	var r directionsResponse
+	if err := c.getJSON(ctx, "/maps/api/directions/json", params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+
+	routes := make([]Route, len(r.Routes))
+	for i, rr := range r.Routes {
+		line, err := DecodePolyline(rr.OverviewPolyline.Points)
+		if err != nil {
+			return nil, err
+		}
+		route := Route{
+			Summary:       rr.Summary,
+			Polyline:      line,
+			Bounds:        rr.Bounds,
+			WaypointOrder: rr.WaypointOrder,
+			Warnings:      rr.Warnings,
+			Copyrights:    rr.Copyrights,
+		}
+		for _, l := range rr.Legs {
+			leg := RouteLeg{
+				StartAddress:      l.StartAddress,
+				EndAddress:        l.EndAddress,
+				StartLocation:     l.StartLocation,
+				EndLocation:       l.EndLocation,
+				Distance:          Distance(l.Distance.Value),
+				Duration:          l.Duration.seconds(),
+				DurationInTraffic: l.DurationInTraffic.seconds(),
+			}
+			for _, s := range l.Steps {
+				stepLine, err := DecodePolyline(s.Polyline.Points)
+				if err != nil {
+					return nil, err
+				}
+				leg.Steps = append(leg.Steps, RouteStep{
+					Instructions:  s.HTMLInstructions,
+					Maneuver:      s.Maneuver,
+					TravelMode:    s.TravelMode,
+					StartLocation: s.StartLocation,
+					EndLocation:   s.EndLocation,
+					Distance:      Distance(s.Distance.Value),
+					Duration:      s.Duration.seconds(),
+					Polyline:      stepLine,
+				})
+			}
+			route.Legs = append(route.Legs, leg)
+		}
+		routes[i] = route
+	}
+	return routes, nil
+}
+
+// Duration returns the total duration of all legs.
+func (r *Route) Duration() time.Duration {
+	var d time.Duration
+	for _, l := range r.Legs {
+		d += l.Duration
+	}
+	return d
+}
+
+// Distance returns the total distance of all legs.
+func (r *Route) Distance() Distance {
+	var d Distance
+	for _, l := range r.Legs {
+		d += l.Distance
+	}
+	return d
+}
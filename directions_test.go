@@ -0,0 +1,52 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDirections(t *testing.T) {
+	c, last := newTestClient(t, `{
+		"status": "OK",
+		"routes": [{
+			"summary": "I-5 N",
+			"bounds": {"southwest": {"lat": 38.5, "lng": -126.453}, "northeast": {"lat": 43.252, "lng": -120.2}},
+			"overview_polyline": {"points": "_p~iF~ps|U_ulLnnqC_mqNvxq`+"`@"+`"},
+			"waypoint_order": [1, 0],
+			"legs": [
+				{"start_address": "A", "end_address": "B", "distance": {"value": 1000}, "duration": {"value": 60},
+				 "steps": [{"html_instructions": "Head <b>north</b>", "travel_mode": "DRIVING", "distance": {"value": 1000}, "duration": {"value": 60}, "polyline": {"points": "_p~iF~ps|U"}}]},
+				{"start_address": "B", "end_address": "C", "distance": {"value": 500}, "duration": {"value": 30}, "steps": []}
+			]
+		}]
+	}`)
+	routes, err := c.Directions(context.Background(), LatLng{38.5, -120.2}, LatLng{43.252, -126.453},
+		WithWaypoints(LatLng{40, -121}, LatLng{41, -122}), WithOptimizedWaypoints(), WithMode(TravelModeDriving))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if got := q.Get("waypoints"); got != "optimize:true|40,-121|41,-122" {
+		t.Errorf("Unexpected waypoints: %s", got)
+	}
+	if len(routes) != 1 {
+		t.Fatalf("Expected: 1 route, Got: %d", len(routes))
+	}
+	r := routes[0]
+	if len(r.Polyline) != 3 || r.Polyline[2] != (LatLng{43.252, -126.453}) {
+		t.Errorf("Unexpected polyline: %v", r.Polyline)
+	}
+	if len(r.WaypointOrder) != 2 || r.WaypointOrder[0] != 1 {
+		t.Errorf("Unexpected waypoint order: %v", r.WaypointOrder)
+	}
+	if r.Distance() != 1500 || r.Duration() != 90*time.Second {
+		t.Errorf("Expected: 1500m 1m30s, Got: %vm %v", r.Distance(), r.Duration())
+	}
+	if s := r.Legs[0].Steps[0]; s.Instructions != "Head <b>north</b>" || len(s.Polyline) != 1 {
+		t.Errorf("Unexpected step: %+v", s)
+	}
+	if r.Bounds.Northeast.Lat != 43.252 {
+		t.Errorf("Unexpected bounds: %v", r.Bounds)
+	}
+}
@@ -0,0 +1,50 @@
+package geo
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// DistanceTo returns the great-circle distance in meters between l and o.
+func (l LatLng) DistanceTo(o LatLng) float64 {
+	lat1, lat2 := toRadians(l.Lat), toRadians(o.Lat)
+	dLat := lat2 - lat1
+	dLng := toRadians(o.Lng - l.Lng)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func latLngToXYZ(ll LatLng) [3]float64 {
+	lat, lng := toRadians(ll.Lat), toRadians(ll.Lng)
+	return [3]float64{math.Cos(lat) * math.Cos(lng), math.Cos(lat) * math.Sin(lng), math.Sin(lat)}
+}
+
+func xyzToLatLng(p [3]float64) LatLng {
+	return LatLng{
+		Lat: toDegrees(math.Atan2(p[2], math.Hypot(p[0], p[1]))),
+		Lng: toDegrees(math.Atan2(p[1], p[0])),
+	}
+}
+
+// destination returns the point meters from l along the initial bearing
+// (in radians clockwise from north).
+func (l LatLng) destination(bearing, meters float64) LatLng {
+	lat1, lng1 := toRadians(l.Lat), toRadians(l.Lng)
+	d := meters / EarthRadius
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(bearing))
+	lng2 := lng1 + math.Atan2(math.Sin(bearing)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return LatLng{Lat: toDegrees(lat2), Lng: normalizeLng(toDegrees(lng2))}
+}
+
+// normalizeLng wraps a longitude into [-180, 180).
+func normalizeLng(lng float64) float64 {
+	return math.Mod(math.Mod(lng+180, 360)+360, 360) - 180
+}
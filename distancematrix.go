@@ -0,0 +1,96 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+type (
+	// DistanceMatrixResult holds one row per origin and one element per
+	// destination within each row.
+	DistanceMatrixResult struct {
+		OriginAddresses      []string
+		DestinationAddresses []string
+		Rows                 [][]DistanceMatrixElement
+	}
+
+	// DistanceMatrixElement is the trip between one origin and destination.
+	// DurationInTraffic is only set for driving requests with a departure
+	// time.
+	DistanceMatrixElement struct {
+		Status            string
+		Distance          Distance
+		Duration          time.Duration
+		DurationInTraffic time.Duration
+	}
+
+	textValue struct {
+		Text  string  `json:"text"`
+		Value float64 `json:"value"`
+	}
+
+	distanceMatrixResponse struct {
+		Status               string   `json:"status"`
+		OriginAddresses      []string `json:"origin_addresses"`
+		DestinationAddresses []string `json:"destination_addresses"`
+		Rows                 []struct {
+			Elements []struct {
+				Status            string    `json:"status"`
+				Distance          textValue `json:"distance"`
+				Duration          textValue `json:"duration"`
+				DurationInTraffic textValue `json:"duration_in_traffic"`
+			} `json:"elements"`
+		} `json:"rows"`
+	}
+)
+
+func (v textValue) seconds() time.Duration {
+	return time.Duration(v.Value) * time.Second
+}
+
+// DistanceMatrix returns travel distances and durations between every origin
+// and destination. Elements that couldn't be routed have a non-OK Status.
+func (c *Client) DistanceMatrix(ctx context.Context, origins, destinations []LatLng, opts ...RequestOption) (*DistanceMatrixResult, error) {
+	if len(origins) == 0 || len(destinations) == 0 {
+		return nil, NoPointsError
+	}
+	if err := validateLatLngs("origins", origins); err != nil {
+		return nil, err
+	}
+	if err := validateLatLngs("destinations", destinations); err != nil {
+		return nil, err
+	}
+	o := newRequestOptions(opts)
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	o.apply(params)
+	params.Set("origins", latLngsParam(origins))
+	params.Set("destinations", latLngsParam(destinations))
+	var r distanceMatrixResponse
+	if err := c.getJSON(ctx, "/maps/api/distancematrix/json", params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+	res := &DistanceMatrixResult{
+		OriginAddresses:      r.OriginAddresses,
+		DestinationAddresses: r.DestinationAddresses,
+		Rows:                 make([][]DistanceMatrixElement, len(r.Rows)),
+	}
+	for i, row := range r.Rows {
+		res.Rows[i] = make([]DistanceMatrixElement, len(row.Elements))
+		for j, e := range row.Elements {
+			res.Rows[i][j] = DistanceMatrixElement{
+				Status:            e.Status,
+				Distance:          Distance(e.Distance.Value),
+				Duration:          e.Duration.seconds(),
+				DurationInTraffic: e.DurationInTraffic.seconds(),
+			}
+		}
+	}
+	return res, nil
+}
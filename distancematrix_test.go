@@ -0,0 +1,49 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDistanceMatrix(t *testing.T) {
+	c, last := newTestClient(t, `{
+		"destination_addresses": ["New York, NY, USA", "Nowhere"],
+		"origin_addresses": ["Washington, DC, USA"],
+		"rows": [{"elements": [
+			{"distance": {"text": "225 mi", "value": 361715}, "duration": {"text": "3 hours 49 mins", "value": 13725}, "duration_in_traffic": {"text": "4 hours", "value": 14400}, "status": "OK"},
+			{"status": "ZERO_RESULTS"}
+		]}],
+		"status": "OK"
+	}`)
+	res, err := c.DistanceMatrix(context.Background(),
+		[]LatLng{{38.9072, -77.0369}},
+		[]LatLng{{40.7128, -74.006}, {0, 0}},
+		WithMode(TravelModeDriving), WithDepartureTime(time.Time{}), WithTrafficModel(TrafficModelPessimistic), WithAvoid(AvoidTolls, AvoidFerries))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	for k, v := range map[string]string{
+		"origins":        "38.9072,-77.0369",
+		"destinations":   "40.7128,-74.006|0,0",
+		"mode":           "driving",
+		"departure_time": "now",
+		"traffic_model":  "pessimistic",
+		"avoid":          "tolls|ferries",
+	} {
+		if q.Get(k) != v {
+			t.Errorf("%s: Expected: %s, Got: %s", k, v, q.Get(k))
+		}
+	}
+	if len(res.Rows) != 1 || len(res.Rows[0]) != 2 {
+		t.Fatalf("Unexpected rows: %v", res.Rows)
+	}
+	e := res.Rows[0][0]
+	if e.Distance != 361715 || e.Duration != 13725*time.Second || e.DurationInTraffic != 4*time.Hour {
+		t.Errorf("Unexpected element: %+v", e)
+	}
+	if res.Rows[0][1].Status != StatusZeroResults {
+		t.Errorf("Expected: ZERO_RESULTS, Got: %s", res.Rows[0][1].Status)
+	}
+}
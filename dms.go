@@ -0,0 +1,226 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var InvalidCoordinatesError = errors.New("Unable to parse coordinates.")
+
+// DMS formats l in degrees, minutes and seconds, e.g. 40°26′46″N 79°58′56″W.
+// precision is the number of decimal places kept on the seconds.
+func (l LatLng) DMS(precision int) string {
+	return formatSexagesimal(l.Lat, "NS", precision, true) + " " + formatSexagesimal(l.Lng, "EW", precision, true)
+}
+
+// DDM formats l in degrees and decimal minutes, e.g. 40°26.767′N 79°58.933′W.
+// precision is the number of decimal places kept on the minutes.
+func (l LatLng) DDM(precision int) string {
+	return formatSexagesimal(l.Lat, "NS", precision, false) + " " + formatSexagesimal(l.Lng, "EW", precision, false)
+}
+
+func formatSexagesimal(deg float64, hemispheres string, precision int, seconds bool) string {
+	if precision < 0 {
+		precision = 0
+	}
+	hemi := hemispheres[0]
+	if deg < 0 {
+		hemi = hemispheres[1]
+	}
+	// Work in integer units of the last printed digit so rounding carries
+	// cleanly into minutes and degrees.
+	scale := int64(math.Pow(10, float64(precision)))
+	perMinute := scale
+	if seconds {
+		perMinute *= 60
+	}
+	units := int64(math.Round(math.Abs(deg) * 60 * float64(perMinute)))
+	d := units / (60 * perMinute)
+	units -= d * 60 * perMinute
+	if !seconds {
+		return fmt.Sprintf("%d°%.*f′%c", d, precision, float64(units)/float64(scale), hemi)
+	}
+	m := units / perMinute
+	units -= m * perMinute
+	return fmt.Sprintf("%d°%d′%.*f″%c", d, m, precision, float64(units)/float64(scale), hemi)
+}
+
+type coordToken struct {
+	kind byte // 'n'umber, 'u'nit, 'h'emisphere or 's'eparator
+	num  float64
+	neg  bool
+	unit int // 0 degrees, 1 minutes, 2 seconds
+	hemi byte
+}
+
+// ParseDMS parses a latitude/longitude pair written in degrees-minutes-seconds
+// ("40°26′46″N 79°58′56″W", "40 26 46 N, 79 58 56 W"), degrees and decimal
+// minutes ("N40 26.767 W79 58.933") or decimal degrees ("40.446, -79.982").
+// Hemisphere letters may come before or after each value, and a pair given
+// as longitude first is accepted if its hemispheres say so.
+func ParseDMS(s string) (LatLng, error) {
+	tokens, err := tokenizeCoordinates(s)
+	if err != nil {
+		return LatLng{}, err
+	}
+	first, second, err := splitCoordinates(tokens)
+	if err != nil {
+		return LatLng{}, err
+	}
+	a, hemiA, err := parseAngle(first)
+	if err != nil {
+		return LatLng{}, err
+	}
+	b, hemiB, err := parseAngle(second)
+	if err != nil {
+		return LatLng{}, err
+	}
+	if hemiA == 'E' || hemiA == 'W' || hemiB == 'N' || hemiB == 'S' {
+		if hemiA == 'N' || hemiA == 'S' || hemiB == 'E' || hemiB == 'W' {
+			return LatLng{}, InvalidCoordinatesError
+		}
+		a, b = b, a
+	}
+	if math.Abs(a) > 90 || math.Abs(b) > 180 {
+		return LatLng{}, InvalidCoordinatesError
+	}
+	return LatLng{Lat: a, Lng: b}, nil
+}
+
+func tokenizeCoordinates(s string) ([]coordToken, error) {
+	tokens := []coordToken{}
+	lastUnit := -1
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r), r == '(', r == ')', r == '[', r == ']':
+		case r == ',' || r == ';' || r == '/':
+			tokens = append(tokens, coordToken{kind: 's'})
+		case r == '-' || r == '+' || r == '−' || r == '.' || unicode.IsDigit(r):
+			j := i
+			if r == '-' || r == '+' || r == '−' {
+				j++
+			}
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			start := i
+			if r == '-' || r == '+' || r == '−' {
+				start++
+			}
+			v, err := strconv.ParseFloat(string(runes[start:j]), 64)
+			if err != nil {
+				return nil, InvalidCoordinatesError
+			}
+			tokens = append(tokens, coordToken{kind: 'n', num: v, neg: r == '-' || r == '−'})
+			i = j - 1
+		case r == '°' || r == 'º' || (r == 'd' || r == 'D') && i > 0 && unicode.IsDigit(runes[i-1]):
+			tokens = append(tokens, coordToken{kind: 'u', unit: 0})
+			lastUnit = 0
+		case r == '\'' || r == '′' || r == '’' || (r == 'm' || r == 'M') && i > 0 && unicode.IsDigit(runes[i-1]):
+			if r == '\'' && i+1 < len(runes) && runes[i+1] == '\'' {
+				i++
+				tokens = append(tokens, coordToken{kind: 'u', unit: 2})
+				lastUnit = 2
+				continue
+			}
+			tokens = append(tokens, coordToken{kind: 'u', unit: 1})
+			lastUnit = 1
+		case r == '"' || r == '″' || r == '”' || r == 's' && lastUnit == 1:
+			tokens = append(tokens, coordToken{kind: 'u', unit: 2})
+			lastUnit = 2
+		case strings.ContainsRune("NSEWnsew", r):
+			tokens = append(tokens, coordToken{kind: 'h', hemi: byte(unicode.ToUpper(r))})
+			lastUnit = -1
+		default:
+			return nil, InvalidCoordinatesError
+		}
+	}
+	return tokens, nil
+}
+
+// splitCoordinates divides the tokens of a coordinate pair into the tokens
+// of each half.
+func splitCoordinates(tokens []coordToken) ([]coordToken, []coordToken, error) {
+	for i, t := range tokens {
+		if t.kind == 's' {
+			return tokens[:i], tokens[i+1:], nil
+		}
+	}
+	hemis := []int{}
+	degrees := []int{}
+	numbers := []int{}
+	for i, t := range tokens {
+		switch t.kind {
+		case 'h':
+			hemis = append(hemis, i)
+		case 'n':
+			numbers = append(numbers, i)
+			if i+1 < len(tokens) && tokens[i+1].kind == 'u' && tokens[i+1].unit == 0 {
+				degrees = append(degrees, i)
+			}
+		}
+	}
+	switch {
+	case len(hemis) == 2 && hemis[0] == 0:
+		return tokens[:hemis[1]], tokens[hemis[1]:], nil
+	case len(hemis) >= 1 && hemis[0] != 0:
+		return tokens[:hemis[0]+1], tokens[hemis[0]+1:], nil
+	case len(degrees) == 2:
+		return tokens[:degrees[1]], tokens[degrees[1]:], nil
+	case len(hemis) == 0 && len(degrees) == 0 && len(numbers)%2 == 0 && len(numbers) >= 2 && len(numbers) <= 6:
+		mid := numbers[len(numbers)/2]
+		return tokens[:mid], tokens[mid:], nil
+	}
+	return nil, nil, InvalidCoordinatesError
+}
+
+// parseAngle converts the tokens of a single angle into signed decimal
+// degrees, returning the hemisphere letter if one was given.
+func parseAngle(tokens []coordToken) (float64, byte, error) {
+	var parts [3]float64
+	var hemi byte
+	count := 0
+	next := 0
+	neg := false
+	for i, t := range tokens {
+		switch t.kind {
+		case 'h':
+			if hemi != 0 {
+				return 0, 0, InvalidCoordinatesError
+			}
+			hemi = t.hemi
+		case 'n':
+			if next > 2 || (count > 0 && t.neg) {
+				return 0, 0, InvalidCoordinatesError
+			}
+			idx := next
+			if i+1 < len(tokens) && tokens[i+1].kind == 'u' {
+				idx = tokens[i+1].unit
+				if idx < next {
+					return 0, 0, InvalidCoordinatesError
+				}
+			}
+			parts[idx] = t.num
+			next = idx + 1
+			neg = neg || t.neg
+			count++
+		}
+	}
+	if count == 0 || parts[1] >= 60 || parts[2] >= 60 {
+		return 0, 0, InvalidCoordinatesError
+	}
+	if neg && hemi != 0 {
+		return 0, 0, InvalidCoordinatesError
+	}
+	v := parts[0] + parts[1]/60 + parts[2]/3600
+	if neg || hemi == 'S' || hemi == 'W' {
+		v = -v
+	}
+	return v, hemi, nil
+}
@@ -0,0 +1,56 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDMS(t *testing.T) {
+	ll := LatLng{40.446111, -79.982222}
+	if got := ll.DMS(0); got != "40°26′46″N 79°58′56″W" {
+		t.Errorf("Expected: 40°26′46″N 79°58′56″W, Got: %s", got)
+	}
+	if got := ll.DMS(2); got != "40°26′46.00″N 79°58′56.00″W" {
+		t.Errorf("Expected: 40°26′46.00″N 79°58′56.00″W, Got: %s", got)
+	}
+	if got := ll.DDM(3); got != "40°26.767′N 79°58.933′W" {
+		t.Errorf("Expected: 40°26.767′N 79°58.933′W, Got: %s", got)
+	}
+	// Rounding must carry into the minutes and degrees.
+	if got := (LatLng{-33.9999999, 151}).DMS(0); got != "34°0′0″S 151°0′0″E" {
+		t.Errorf("Expected: 34°0′0″S 151°0′0″E, Got: %s", got)
+	}
+}
+
+func TestParseDMS(t *testing.T) {
+	expected := LatLng{40.446111, -79.982222}
+	inputs := []string{
+		"40°26′46″N 79°58′56″W",
+		"40°26'46\"N, 79°58'56\"W",
+		"40 26 46 N 79 58 56 W",
+		"N40 26 46 W79 58 56",
+		"40d26m46s N 79d58m56s W",
+		"40°26.7667′N 79°58.9333′W",
+		"N 40 26.7667, W 79 58.9333",
+		"40.446111, -79.982222",
+		"40.446111 -79.982222",
+		"40.446111N 79.982222W",
+		"79°58′56″W 40°26′46″N",
+		"40° 26′ 46″ -79° 58′ 56″",
+	}
+	for _, input := range inputs {
+		got, err := ParseDMS(input)
+		if err != nil {
+			t.Errorf("%s: %v", input, err)
+			continue
+		}
+		if math.Abs(got.Lat-expected.Lat) > 1e-4 || math.Abs(got.Lng-expected.Lng) > 1e-4 {
+			t.Errorf("%s Expected: %v, Got: %v", input, expected, got)
+		}
+	}
+	for _, input := range []string{"", "hello", "40°61′N 79°W", "95, 10", "N40 N79", "40.5", "d40, 79", "40 d26, 79", "40 26 m, 79 58", "40°26 M, 79"} {
+		if _, err := ParseDMS(input); err != InvalidCoordinatesError {
+			t.Errorf("%s Expected: %v, Got: %v", input, InvalidCoordinatesError, err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+var NoPointsError = errors.New("At least one point is required.")
+
+// MaxElevationPoints is the most locations, path points or samples the
+// Elevation API accepts in one request.
+const MaxElevationPoints = 512
+
+type (
+	// ElevationResult is the elevation in meters above sea level at Location.
+	// Resolution is the distance in meters between the data points the value
+	// was interpolated from.
+	ElevationResult struct {
+		Elevation  float64 `json:"elevation"`
+		Location   LatLng  `json:"location"`
+		Resolution float64 `json:"resolution"`
+	}
+
+	elevationResponse struct {
+		Status  string            `json:"status"`
+		Results []ElevationResult `json:"results"`
+	}
+)
+
+// Elevation returns the elevation at each of points, in order.
+func (c *Client) Elevation(ctx context.Context, points ...LatLng) ([]ElevationResult, error) {
+	if len(points) == 0 {
+		return nil, NoPointsError
+	}
+	if err := validatePoints("locations", points, MaxElevationPoints); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("locations", latLngsParam(points))
+	return c.elevation(ctx, params)
+}
+
+// ElevationAlongPath returns samples evenly spaced elevations along path.
+func (c *Client) ElevationAlongPath(ctx context.Context, path []LatLng, samples int) ([]ElevationResult, error) {
+	if len(path) == 0 {
+		return nil, NoPointsError
+	}
+	if err := validatePoints("path", path, MaxElevationPoints); err != nil {
+		return nil, err
+	}
+	if samples <= 0 || samples > MaxElevationPoints {
+		return nil, &InvalidInputError{Field: "samples", Reason: "must be between 1 and " + strconv.Itoa(MaxElevationPoints)}
+	}
+	params := url.Values{}
+	params.Set("path", latLngsParam(path))
+	params.Set("samples", strconv.Itoa(samples))
+	return c.elevation(ctx, params)
+}
+
+func (c *Client) elevation(ctx context.Context, params url.Values) ([]ElevationResult, error) {
+	var r elevationResponse
+	if err := c.getJSON(ctx, "/maps/api/elevation/json", params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+	return r.Results, nil
+}
@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"context"
+	"testing"
+)
+
+const elevationBody = `{
+	"results": [
+		{"elevation": 1608.637939453125, "location": {"lat": 39.7391536, "lng": -104.9847034}, "resolution": 4.771975994110107},
+		{"elevation": -50.78903579711914, "location": {"lat": 36.455556, "lng": -116.866667}, "resolution": 19.08790397644043}
+	],
+	"status": "OK"
+}`
+
+func TestElevation(t *testing.T) {
+	c, last := newTestClient(t, elevationBody)
+	res, err := c.Elevation(context.Background(), LatLng{39.7391536, -104.9847034}, LatLng{36.455556, -116.866667})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (*last).URL.Query().Get("locations"); got != "39.7391536,-104.9847034|36.455556,-116.866667" {
+		t.Errorf("Unexpected locations: %s", got)
+	}
+	if len(res) != 2 || res[0].Elevation != 1608.637939453125 || res[1].Location.Lat != 36.455556 {
+		t.Errorf("Unexpected results: %v", res)
+	}
+	if _, err := c.Elevation(context.Background()); err != NoPointsError {
+		t.Errorf("Expected: %v, Got: %v", NoPointsError, err)
+	}
+}
+
+func TestElevationAlongPath(t *testing.T) {
+	c, last := newTestClient(t, elevationBody)
+	if _, err := c.ElevationAlongPath(context.Background(), []LatLng{{36.578581, -118.291994}, {36.23998, -116.83171}}, 2); err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if q.Get("path") != "36.578581,-118.291994|36.23998,-116.83171" || q.Get("samples") != "2" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+}
+
+func TestElevationAlongPathValidation(t *testing.T) {
+	c, last := newTestClient(t, elevationBody)
+	path := []LatLng{{36.578581, -118.291994}, {36.23998, -116.83171}}
+	tests := []struct {
+		path    []LatLng
+		samples int
+		field   string
+	}{
+		{path, 0, "samples"},
+		{path, -1, "samples"},
+		{path, MaxElevationPoints + 1, "samples"},
+		{[]LatLng{{91, 0}, {0, 0}}, 2, "path"},
+		{make([]LatLng, MaxElevationPoints+1), 2, "path"},
+	}
+	for _, test := range tests {
+		_, err := c.ElevationAlongPath(context.Background(), test.path, test.samples)
+		if ie, ok := err.(*InvalidInputError); !ok || ie.Field != test.field {
+			t.Errorf("%d points, %d samples Expected: invalid %s, Got: %v", len(test.path), test.samples, test.field, err)
+		}
+	}
+	if *last != nil {
+		t.Errorf("Expected: no requests for invalid input, Got: %v", (*last).URL)
+	}
+}
@@ -0,0 +1,159 @@
+package geo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// EnrichedAddress is a geocoded address merged with the output of an
+	// Enricher's steps. Fields for steps that didn't run or failed are left
+	// zero, and the failures are collected in Errors.
+	EnrichedAddress struct {
+		*Address
+		Timezone  *TimezoneResult
+		Elevation *ElevationResult
+		Country   string
+		Errors    []error
+	}
+
+	// EnrichStep looks up extra data for a geocoded address and returns a
+	// function that records it on the merged record. Steps run concurrently;
+	// the returned functions are applied one at a time.
+	EnrichStep func(ctx context.Context, a *Address) (func(*EnrichedAddress), error)
+
+	// Enricher geocodes an address and then runs Steps on the result
+	// concurrently, merging everything into one record. With Strict set,
+	// any step failure fails the whole call.
+	Enricher struct {
+		Geocoder Geocoder
+		Steps    []EnrichStep
+		Strict   bool
+	}
+)
+
+// NewEnricher returns an Enricher that geocodes with g and then runs steps.
+func NewEnricher(g Geocoder, steps ...EnrichStep) *Enricher {
+	return &Enricher{Geocoder: g, Steps: steps}
+}
+
+// Enrich geocodes q and enriches the result.
+func (e *Enricher) Enrich(ctx context.Context, q string, opts ...RequestOption) (*EnrichedAddress, error) {
+	a, err := e.Geocoder.Geocode(ctx, q, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnrichAddress(ctx, a)
+}
+
+// EnrichAddress runs the steps on an address that has already been
+// geocoded.
+func (e *Enricher) EnrichAddress(ctx context.Context, a *Address) (*EnrichedAddress, error) {
+	out := &EnrichedAddress{Address: a}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, step := range e.Steps {
+		wg.Add(1)
+		go func(step EnrichStep) {
+			defer wg.Done()
+			apply, err := step(ctx, a)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				out.Errors = append(out.Errors, err)
+				return
+			}
+			apply(out)
+		}(step)
+	}
+	wg.Wait()
+	if e.Strict && len(out.Errors) > 0 {
+		return nil, out.Errors[0]
+	}
+	return out, nil
+}
+
+// TimezoneStep looks up the time zone at the address as of t, or as of the
+// time of the call if t is zero.
+func TimezoneStep(c *Client, t time.Time) EnrichStep {
+	return func(ctx context.Context, a *Address) (func(*EnrichedAddress), error) {
+		at := t
+		if at.IsZero() {
+			at = time.Now()
+		}
+		tz, err := c.Timezone(ctx, LatLng{a.Lat, a.Lng}, at)
+		if err != nil {
+			return nil, err
+		}
+		return func(e *EnrichedAddress) { e.Timezone = tz }, nil
+	}
+}
+
+// ElevationStep looks up the elevation at the address.
+func ElevationStep(c *Client) EnrichStep {
+	return func(ctx context.Context, a *Address) (func(*EnrichedAddress), error) {
+		res, err := c.Elevation(ctx, LatLng{a.Lat, a.Lng})
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			return nil, &GeocoderError{Status: StatusZeroResults}
+		}
+		return func(e *EnrichedAddress) { e.Elevation = &res[0] }, nil
+	}
+}
+
+// OfflineCountryStep sets Country from the address's own country component,
+// falling back to OfflineReverseCountry. It makes no requests.
+func OfflineCountryStep() EnrichStep {
+	return func(ctx context.Context, a *Address) (func(*EnrichedAddress), error) {
+		code := a.CountryCode()
+		if code == "" {
+			code, _ = OfflineReverseCountry(LatLng{a.Lat, a.Lng})
+		}
+		return func(e *EnrichedAddress) { e.Country = code }, nil
+	}
+}
+
+// OfflineTimezoneStep sets Timezone from OfflineTimezone, with its offsets as
+// of t, or as of the time of the call if t is zero. It makes no requests but
+// needs the system's time zone database, and leaves Timezone's Name empty.
+func OfflineTimezoneStep(t time.Time) EnrichStep {
+	return func(ctx context.Context, a *Address) (func(*EnrichedAddress), error) {
+		id, ok := OfflineTimezone(LatLng{a.Lat, a.Lng})
+		if !ok {
+			return nil, &GeocoderError{Status: StatusZeroResults}
+		}
+		loc, err := time.LoadLocation(id)
+		if err != nil {
+			return nil, err
+		}
+		at := t
+		if at.IsZero() {
+			at = time.Now()
+		}
+		at = at.In(loc)
+		_, offset := at.Zone()
+		raw := offset
+		if at.IsDST() {
+			// The standard offset is the one in effect in whichever of
+			// January and July isn't daylight saving time.
+			for _, m := range []time.Month{time.January, time.July} {
+				if s := time.Date(at.Year(), m, 1, 0, 0, 0, 0, loc); !s.IsDST() {
+					_, raw = s.Zone()
+					break
+				}
+			}
+		}
+		tz := &TimezoneResult{
+			ID:        id,
+			Location:  loc,
+			RawOffset: time.Duration(raw) * time.Second,
+			DSTOffset: time.Duration(offset-raw) * time.Second,
+		}
+		return func(e *EnrichedAddress) { e.Timezone = tz }, nil
+	}
+}
@@ -0,0 +1,89 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnricher(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "timezone"):
+			w.Write([]byte(`{"status": "OK", "timeZoneId": "America/Toronto", "rawOffset": -18000}`))
+		case strings.Contains(r.URL.Path, "elevation"):
+			w.Write([]byte(`{"status": "OK", "results": [{"elevation": 76.5}]}`))
+		}
+	}))
+	defer srv.Close()
+	c := NewClient("test-key", WithBaseURL(srv.URL))
+
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		return &Address{Lat: 45.5, Lng: -73.6, Address: q}, nil
+	})
+	e := NewEnricher(g, TimezoneStep(c, time.Unix(0, 0)), ElevationStep(c), OfflineCountryStep())
+	got, err := e.Enrich(context.Background(), "Montreal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Address.Address != "Montreal" || got.Timezone == nil || got.Timezone.ID != "America/Toronto" {
+		t.Errorf("Unexpected timezone: %+v", got.Timezone)
+	}
+	if got.Elevation == nil || got.Elevation.Elevation != 76.5 {
+		t.Errorf("Unexpected elevation: %+v", got.Elevation)
+	}
+	if got.Country != "CA" || len(got.Errors) != 0 {
+		t.Errorf("Expected: CA with no errors, Got: %s %v", got.Country, got.Errors)
+	}
+}
+
+func TestEnricherStepErrors(t *testing.T) {
+	boom := errors.New("boom")
+	failing := func(ctx context.Context, a *Address) (func(*EnrichedAddress), error) {
+		return nil, boom
+	}
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		return &Address{Lat: 48.85, Lng: 2.35}, nil
+	})
+	e := NewEnricher(g, failing, OfflineCountryStep())
+	got, err := e.Enrich(context.Background(), "Paris")
+	if err != nil || len(got.Errors) != 1 || got.Country != "FR" {
+		t.Errorf("Expected: partial record, Got: %+v, %v", got, err)
+	}
+	e.Strict = true
+	if _, err := e.Enrich(context.Background(), "Paris"); err != boom {
+		t.Errorf("Expected: %v, Got: %v", boom, err)
+	}
+}
+
+func TestOfflineTimezoneStep(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Paris"); err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+	paris := &Address{Lat: 48.85, Lng: 2.35}
+	tests := []struct {
+		at       time.Time
+		raw, dst time.Duration
+	}{
+		{time.Date(2020, 1, 15, 12, 0, 0, 0, time.UTC), time.Hour, 0},
+		{time.Date(2020, 7, 15, 12, 0, 0, 0, time.UTC), time.Hour, time.Hour},
+	}
+	for _, test := range tests {
+		apply, err := OfflineTimezoneStep(test.at)(context.Background(), paris)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := &EnrichedAddress{Address: paris}
+		apply(e)
+		if e.Timezone.ID != "Europe/Paris" || e.Timezone.RawOffset != test.raw || e.Timezone.DSTOffset != test.dst {
+			t.Errorf("%v: Unexpected timezone: %+v", test.at, e.Timezone)
+		}
+	}
+	if _, err := OfflineTimezoneStep(time.Time{})(context.Background(), &Address{Lat: 30, Lng: -40}); err == nil {
+		t.Error("Expected an error at sea")
+	}
+}
@@ -1,13 +1,14 @@
 package geo
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"encoding/json"
 	"errors"
 	"net/url"
+	"time"
 )
 
 const (
@@ -23,24 +24,44 @@ var (
 	BodyReadError     = errors.New("Unable to read the response body.")
 )
 
+// DefaultClient serves the package-level functions such as Geocode. Replace
+// or configure it at startup to give existing call sites a key, a cache or
+// a rate limit; an explicit apiKey argument still takes precedence.
+var DefaultClient = NewClient("")
+
+// RetainRawResponses makes Geocode and friends keep the undecoded JSON body
+// in Response.Raw, for fields this package doesn't model.
+var RetainRawResponses = false
+
 type (
+	// Address is a geocoding answer. FetchedAt is when it came from the
+	// provider and ValidUntil, if set, when the provider's terms require it
+	// to be looked up again; see DueForRefresh.
 	Address struct {
-		Lat      float64   `json:"lat"`
-		Lng      float64   `json:"lng"`
-		Address  string    `json:"address"`
-		Response *Response `json:"response"`
+		Lat        float64   `json:"lat"`
+		Lng        float64   `json:"lng"`
+		Address    string    `json:"address"`
+		Response   *Response `json:"response"`
+		FetchedAt  time.Time `json:"fetched_at,omitzero"`
+		ValidUntil time.Time `json:"valid_until,omitzero"`
 	}
 
 	Response struct {
-		Status  string   `json:"status"`
-		Results []Result `json:"results"`
+		Status       string          `json:"status"`
+		ErrorMessage string          `json:"error_message,omitempty"`
+		Results      []Result        `json:"results"`
+		Raw          json.RawMessage `json:"-"`
 	}
 
 	Result struct {
 		Types             []string           `json:"types"`
 		FormattedAddress  string             `json:"formatted_address"`
+		PlaceID           string             `json:"place_id"`
+		PartialMatch      bool               `json:"partial_match"`
 		AddressComponents []AddressComponent `json:"address_components"`
 		Geometry          GeometryData       `json:"geometry"`
+		Confidence        float64            `json:"confidence"`
+		Precision         Precision          `json:"precision"`
 	}
 
 	AddressComponent struct {
@@ -50,16 +71,10 @@ type (
 	}
 
 	GeometryData struct {
-		Location     LatLng `json:"location"`
-		LocationType string `json:"location_type"`
-		Viewport     struct {
-			Southwest LatLng `json:"southwest"`
-			Northeast LatLng `json:"northeast"`
-		} `json:"viewport"`
-		Bounds struct {
-			Southwest LatLng `json:"southwest"`
-			Northeast LatLng `json:"northeast"`
-		} `json:"bounds"`
+		Location     LatLng      `json:"location"`
+		LocationType string      `json:"location_type"`
+		Viewport     BoundingBox `json:"viewport"`
+		Bounds       BoundingBox `json:"bounds"`
 	}
 
 	LatLng struct {
@@ -101,71 +116,81 @@ type ComponentFilter struct {
 }
 
 func (c *ComponentFilter) String() string {
+	return c.join(url.QueryEscape)
+}
+
+// join renders the filter as a components parameter, passing each value
+// through escape.
+func (c *ComponentFilter) join(escape func(string) string) string {
 	parts := []string{}
 	if c.AdministrativeArea != "" {
-		parts = append(parts, "administrative_area:"+url.QueryEscape(c.AdministrativeArea))
+		parts = append(parts, "administrative_area:"+escape(c.AdministrativeArea))
 	}
 	if c.Country != "" {
-		parts = append(parts, "country:"+url.QueryEscape(c.Country))
+		parts = append(parts, "country:"+escape(c.Country))
 	}
 	if c.Locality != "" {
-		parts = append(parts, "locality:"+url.QueryEscape(c.Locality))
+		parts = append(parts, "locality:"+escape(c.Locality))
 	}
 	if c.PostalCode != "" {
-		parts = append(parts, "postal_code:"+url.QueryEscape(c.PostalCode))
+		parts = append(parts, "postal_code:"+escape(c.PostalCode))
 	}
 	if c.Route != "" {
-		parts = append(parts, "route:"+url.QueryEscape(c.Route))
+		parts = append(parts, "route:"+escape(c.Route))
 	}
 	return strings.Join(parts, "|")
 }
 
 func GeocodeAuthenticatedWithComponents(q string, components ComponentFilter, apiKey string) (*Address, error) {
-	if apiKey != "" {
-		apiKey = "&key=" + url.QueryEscape(strings.TrimSpace(apiKey))
-	}
-	if q != "" {
-		q = "&address=" + url.QueryEscape(strings.TrimSpace(q))
-	}
-	componentsStr := components.String()
-	if componentsStr != "" {
-		componentsStr = "&components=" + componentsStr
-	}
-	return fetch("https://maps.googleapis.com/maps/api/geocode/json?sensor=false" + apiKey + q + componentsStr)
+	return defaultClient(apiKey).Geocode(context.Background(), q, WithComponents(components))
 }
 
 func ReverseGeocodeAuthenticated(ll string, apiKey string) (*Address, error) {
-	if apiKey != "" {
-		apiKey = "&key=" + url.QueryEscape(strings.TrimSpace(apiKey))
+	latLng, ok := TryParseCoordinates(ll)
+	if !ok {
+		return nil, &GeocoderError{Status: StatusInvalidRequest}
 	}
-	latLng := "&latlng=" + url.QueryEscape(strings.TrimSpace(ll))
-	return fetch("https://maps.googleapis.com/maps/api/geocode/json?sensor=false" + latLng + apiKey)
+	return defaultClient(apiKey).ReverseGeocode(context.Background(), latLng)
 }
 
-func fetch(url string) (*Address, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, RemoteServerError
-	}
-
-	defer resp.Body.Close()
-
-	var g = new(Response)
-	err = json.NewDecoder(resp.Body).Decode(g)
+// defaultClient returns DefaultClient, or a copy of it using apiKey if one
+// is given.
+func defaultClient(apiKey string) *Client {
+	c := DefaultClient
+	if c == nil {
+		c = NewClient("")
+	}
+	if apiKey = strings.TrimSpace(apiKey); apiKey != "" || RetainRawResponses {
+		cc := *c
+		if apiKey != "" {
+			cc.APIKey, cc.Keys = apiKey, nil
+		}
+		cc.RetainRaw = cc.RetainRaw || RetainRawResponses
+		c = &cc
+	}
+	return c
+}
 
-	if err != nil {
-		return nil, err
+// decode unmarshals a Geocoding API body into g, ignoring unknown fields and
+// keeping a copy of body in Raw if retainRaw is set.
+func (g *Response) decode(body []byte, retainRaw bool) error {
+	if err := json.Unmarshal(body, g); err != nil {
+		return err
 	}
-
-	if g.Status != StatusOk {
-		return nil, &GeocoderError{Status: g.Status}
+	if retainRaw {
+		g.Raw = append(json.RawMessage(nil), body...)
 	}
+	return nil
+}
 
+func newAddress(g *Response) *Address {
+	for i := range g.Results {
+		g.Results[i].scoreGoogle()
+	}
 	return &Address{
 		Lat:      g.Results[0].Geometry.Location.Lat,
 		Lng:      g.Results[0].Geometry.Location.Lng,
 		Address:  g.Results[0].FormattedAddress,
 		Response: g,
-	}, nil
-
+	}
 }
@@ -1,7 +1,10 @@
 package geo
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -52,3 +55,58 @@ func TestReverseGeocode(t *testing.T) {
 	}
 
 }
+
+func TestDefaultClientDecoding(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Case") {
+		case "empty":
+			w.Write([]byte(`{"status": "OK", "results": []}`))
+		default:
+			w.Write([]byte(`{"status": "OK", "plus_code": {"global_code": "87G8Q2PW+W6"}, "results": [{"formatted_address": "x", "unknown_field": 1}]}`))
+		}
+	}))
+	defer srv.Close()
+	defer func(c *Client) { DefaultClient = c }(DefaultClient)
+
+	DefaultClient = NewClient("", WithBaseURL(srv.URL), WithHeader("Case", "empty"))
+	if _, err := GeocodeAuthenticated("x", "test-key"); err == nil {
+		t.Errorf("Expected an error for an OK response with no results")
+	}
+
+	DefaultClient = NewClient("", WithBaseURL(srv.URL))
+	RetainRawResponses = true
+	defer func() { RetainRawResponses = false }()
+	addr, err := Geocode("x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var extra struct {
+		PlusCode struct {
+			GlobalCode string `json:"global_code"`
+		} `json:"plus_code"`
+	}
+	if err := json.Unmarshal(addr.Response.Raw, &extra); err != nil || extra.PlusCode.GlobalCode != "87G8Q2PW+W6" {
+		t.Errorf("Expected the raw body to be retained, Got: %s", addr.Response.Raw)
+	}
+}
+
+func TestDefaultClientKey(t *testing.T) {
+	var key string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.URL.Query().Get("key")
+		w.Write([]byte(`{"status": "OK", "results": [{"formatted_address": "x"}]}`))
+	}))
+	defer srv.Close()
+	defer func(c *Client) { DefaultClient = c }(DefaultClient)
+
+	DefaultClient = NewClient("configured", WithBaseURL(srv.URL))
+	if _, err := ReverseGeocode("1.5,2.5"); err != nil || key != "configured" {
+		t.Errorf("Expected: configured, Got: %s (%v)", key, err)
+	}
+	if _, err := ReverseGeocodeAuthenticated("1.5,2.5", " explicit "); err != nil || key != "explicit" {
+		t.Errorf("Expected: explicit, Got: %s (%v)", key, err)
+	}
+	if _, err := ReverseGeocode("somewhere"); err == nil {
+		t.Errorf("Expected an error for an unparseable location")
+	}
+}
@@ -0,0 +1,189 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Geocode looks up a free-text address with the Geocoding API, after
+// running it through the Client's Normalizer. Coordinate queries are
+// answered locally when the Client has WithCoordinateQueries. If the request fails
+// and the Client has a Fallback, the Fallback answers instead.
+func (c *Client) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	var a *Address
+	var err error
+	if c.Cache != nil {
+		g := c.cached()
+		a, err = g.Geocode(ctx, q, opts...)
+	} else {
+		a, err = c.geocodeQuery(ctx, q, opts)
+	}
+	if c.useFallback(ctx, "geocode", err) {
+		return c.Fallback.Geocode(ctx, q, opts...)
+	}
+	return a, err
+}
+
+func (c *Client) geocodeQuery(ctx context.Context, q string, opts []RequestOption) (*Address, error) {
+	q = c.normalizeQuery(q)
+	if addr, ok := c.coordinateAddress(q); ok {
+		return addr, nil
+	}
+	o := newRequestOptions(opts)
+	if err := validateQuery(q, o); err != nil {
+		return nil, err
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	o.apply(params)
+	params.Set("address", q)
+	return c.geocode(ctx, params, o)
+}
+
+// GeocodeMultiLanguage geocodes q once per language, in parallel, and
+// returns the addresses keyed by language, for storing localized formatted
+// addresses of the same place. If any request fails, the others are
+// canceled and its error is returned.
+func (c *Client) GeocodeMultiLanguage(ctx context.Context, q string, langs []string, opts ...RequestOption) (map[string]*Address, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type answer struct {
+		lang string
+		addr *Address
+		err  error
+	}
+	answers := make(chan answer, len(langs))
+	for _, lang := range langs {
+		go func(lang string) {
+			a, err := c.Geocode(ctx, q, append(opts[:len(opts):len(opts)], WithLanguage(lang))...)
+			answers <- answer{lang, a, err}
+		}(lang)
+	}
+	out := make(map[string]*Address, len(langs))
+	for range langs {
+		ans := <-answers
+		if ans.err != nil {
+			return nil, ans.err
+		}
+		out[ans.lang] = ans.addr
+	}
+	return out, nil
+}
+
+// ReverseGeocode looks up the addresses at ll, asking the Client's
+// Fallback, if it has one, should the request fail.
+func (c *Client) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	var a *Address
+	var err error
+	if c.Cache != nil {
+		g := c.cached()
+		a, err = g.ReverseGeocode(ctx, ll, opts...)
+	} else {
+		a, err = c.reverseGeocode(ctx, ll, opts)
+	}
+	if c.useFallback(ctx, "reverse", err) {
+		return c.Fallback.ReverseGeocode(ctx, ll, opts...)
+	}
+	return a, err
+}
+
+func (c *Client) reverseGeocode(ctx context.Context, ll LatLng, opts []RequestOption) (*Address, error) {
+	o := newRequestOptions(opts)
+	if err := validateLatLng("latlng", ll); err != nil {
+		return nil, err
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	o.apply(params)
+	params.Set("latlng", latLngParam(ll))
+	if len(o.resultTypes) > 0 {
+		params.Set("result_type", strings.Join(o.resultTypes, "|"))
+	}
+	if o.minPrecision > PrecisionUnknown {
+		types := []string{}
+		for p := o.minPrecision; p <= PrecisionRooftop; p++ {
+			types = append(types, precisionLocationTypes[p])
+		}
+		params.Set("location_type", strings.Join(types, "|"))
+	}
+	return c.geocode(ctx, params, o)
+}
+
+// ReverseGeocodeNearest is ReverseGeocode, except that results located
+// farther than radius from ll are dropped, failing with ZERO_RESULTS
+// if none are left. Use it when no address is better than one across the
+// river.
+func (c *Client) ReverseGeocodeNearest(ctx context.Context, ll LatLng, radius Distance, opts ...RequestOption) (*Address, error) {
+	return c.ReverseGeocode(ctx, ll, append(opts[:len(opts):len(opts)], withinRadius(ll, radius))...)
+}
+
+// DefaultBatchConcurrency is how many requests ReverseGeocodeBatch makes at
+// once.
+const DefaultBatchConcurrency = 8
+
+// ReverseGeocodeBatch reverse geocodes points with a Scheduler, returning a
+// result for each in input order. Requests are paced by the Client's
+// Limiter, if it has one, and throttled requests back off and retry. If ctx
+// ends first, the results so far are returned with a *PartialBatchError.
+func (c *Client) ReverseGeocodeBatch(ctx context.Context, points []LatLng, opts ...RequestOption) ([]BatchResult, error) {
+	return NewScheduler(c, DefaultBatchConcurrency).RunReverse(ctx, points, opts...)
+}
+
+// GeocodeByPlaceID looks up the address of a Google place ID, such as one
+// returned by Autocomplete.
+func (c *Client) GeocodeByPlaceID(ctx context.Context, placeID string, opts ...RequestOption) (*Address, error) {
+	if strings.TrimSpace(placeID) == "" {
+		return nil, &InvalidInputError{Field: "place_id", Reason: "must not be empty"}
+	}
+	o := newRequestOptions(opts)
+	params := url.Values{}
+	o.apply(params)
+	params.Set("place_id", placeID)
+	return c.geocode(ctx, params, o)
+}
+
+func (c *Client) geocode(ctx context.Context, params url.Values, o *requestOptions) (*Address, error) {
+	g := new(Response)
+	if err := c.getJSON(ctx, "/maps/api/geocode/json", params, g); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(g.Status); err != nil {
+		return nil, err
+	}
+	if len(g.Results) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	a := newAddress(g)
+	a.stamp(time.Now(), c.Validity)
+	if !o.filtering() {
+		return a, nil
+	}
+	kept := g.Results[:0]
+	for _, r := range g.Results {
+		if o.keep(&r) {
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	g.Results = kept
+	a.Lat, a.Lng = kept[0].Geometry.Location.Lat, kept[0].Geometry.Location.Lng
+	a.Address = kept[0].FormattedAddress
+	return a, nil
+}
+
+// Geocoder is anything that can forward and reverse geocode. *Client is the
+// Google implementation; geotest provides an in-memory one for tests.
+type Geocoder interface {
+	Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error)
+	ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error)
+}
+
+var _ Geocoder = (*Client)(nil)
@@ -0,0 +1,210 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGeocodeByPlaceID(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{
+		"formatted_address": "277 Bedford Ave, Brooklyn, NY 11211, USA",
+		"place_id": "ChIJd8BlQ2BZwokRAFUEcm_qrcA",
+		"geometry": {"location": {"lat": 40.7142205, "lng": -73.9612903}, "location_type": "ROOFTOP"}
+	}]}`)
+	addr, err := c.GeocodeByPlaceID(context.Background(), "ChIJd8BlQ2BZwokRAFUEcm_qrcA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (*last).URL.Query().Get("place_id"); got != "ChIJd8BlQ2BZwokRAFUEcm_qrcA" {
+		t.Errorf("Unexpected place_id: %s", got)
+	}
+	if addr.Address != "277 Bedford Ave, Brooklyn, NY 11211, USA" || addr.Lat != 40.7142205 {
+		t.Errorf("Unexpected address: %v", addr)
+	}
+	if addr.Response.Results[0].PlaceID != "ChIJd8BlQ2BZwokRAFUEcm_qrcA" {
+		t.Errorf("Unexpected place ID: %s", addr.Response.Results[0].PlaceID)
+	}
+
+	c, _ = newTestClient(t, `{"status": "OK", "results": []}`)
+	if _, err := c.GeocodeByPlaceID(context.Background(), "x"); err == nil {
+		t.Errorf("Expected an error for an empty result set")
+	}
+}
+
+func TestGeocodeByPlaceIDValidation(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	for _, placeID := range []string{"", " ", "\t\n"} {
+		_, err := c.GeocodeByPlaceID(context.Background(), placeID)
+		if ie, ok := err.(*InvalidInputError); !ok || ie.Field != "place_id" {
+			t.Errorf("%q Expected: invalid place_id, Got: %v", placeID, err)
+		}
+	}
+	if *last != nil {
+		t.Errorf("Expected: no requests for invalid input, Got: %v", (*last).URL)
+	}
+}
+
+func TestClientGeocode(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "555 W 18th St, New York, NY 10011, USA", "geometry": {"location": {"lat": 40.7453721, "lng": -74.0078293}}}]}`)
+	addr, err := c.Geocode(context.Background(), " 555 w 18th st, ny, ny ", WithComponents(ComponentFilter{Country: "US", Locality: "New York"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if q.Get("address") != "555 w 18th st, ny, ny" || q.Get("components") != "country:US|locality:New York" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+	if addr.Lat != 40.7453721 || addr.Lng != -74.0078293 {
+		t.Errorf("Unexpected address: %v", addr)
+	}
+
+	if _, err := c.ReverseGeocode(context.Background(), LatLng{40.7453721, -74.0078293}); err != nil {
+		t.Fatal(err)
+	}
+	if got := (*last).URL.Query().Get("latlng"); got != "40.7453721,-74.0078293" {
+		t.Errorf("Unexpected latlng: %s", got)
+	}
+}
+
+func TestClientRetainsRawResponses(t *testing.T) {
+	c, _ := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	addr, err := c.Geocode(context.Background(), "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.Response.Raw != nil {
+		t.Errorf("Expected no raw body by default")
+	}
+	WithRawResponses()(c)
+	addr, _ = c.Geocode(context.Background(), "x")
+	if len(addr.Response.Raw) == 0 {
+		t.Errorf("Expected the raw body to be retained")
+	}
+}
+
+func TestGeocodeResultFilters(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [
+		{"formatted_address": "Main St", "types": ["route"], "geometry": {"location": {"lat": 1, "lng": 1}, "location_type": "GEOMETRIC_CENTER"}},
+		{"formatted_address": "1 Main St", "types": ["street_address"], "geometry": {"location": {"lat": 2, "lng": 2}, "location_type": "ROOFTOP"}},
+		{"formatted_address": "3 Main St", "types": ["street_address"], "geometry": {"location": {"lat": 3, "lng": 3}, "location_type": "RANGE_INTERPOLATED"}}
+	]}`)
+	a, err := c.Geocode(context.Background(), "main st", WithResultTypes("street_address"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Response.Results) != 2 || a.Address != "1 Main St" || a.Lat != 2 {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+	if (*last).URL.Query().Get("result_type") != "" {
+		t.Errorf("Expected: no server-side filter for forward geocoding")
+	}
+
+	a, err = c.ReverseGeocode(context.Background(), LatLng{1, 1}, WithMinPrecision(PrecisionInterpolated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Response.Results) != 2 {
+		t.Errorf("Expected: 2, Got: %d", len(a.Response.Results))
+	}
+	if got := (*last).URL.Query().Get("location_type"); got != "RANGE_INTERPOLATED|ROOFTOP" {
+		t.Errorf("Expected: RANGE_INTERPOLATED|ROOFTOP, Got: %s", got)
+	}
+
+	_, err = c.Geocode(context.Background(), "main st", WithResultTypes("route"), WithMinPrecision(PrecisionRooftop))
+	if ge, ok := err.(*GeocoderError); !ok || ge.Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, err)
+	}
+}
+
+func TestGeocodeWithinBounds(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [
+		{"formatted_address": "Springfield, IL", "geometry": {"location": {"lat": 39.8, "lng": -89.6}}},
+		{"formatted_address": "Springfield, MA", "geometry": {"location": {"lat": 42.1, "lng": -72.6}}}
+	]}`)
+	newEngland := BoundingBox{Southwest: LatLng{41, -74}, Northeast: LatLng{45, -70}}
+	a, err := c.Geocode(context.Background(), "Springfield", WithinBounds(newEngland))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Address != "Springfield, MA" || len(a.Response.Results) != 1 {
+		t.Errorf("Expected: Springfield, MA, Got: %v", a)
+	}
+	if got := (*last).URL.Query().Get("bounds"); got != "41,-74|45,-70" {
+		t.Errorf("Expected: 41,-74|45,-70, Got: %s", got)
+	}
+
+	texas := BoundingBox{Southwest: LatLng{26, -106}, Northeast: LatLng{36, -94}}
+	_, err = c.Geocode(context.Background(), "Springfield", WithinBounds(texas))
+	if ge, ok := err.(*GeocoderError); !ok || ge.Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, err)
+	}
+}
+
+func TestReverseGeocodeNearest(t *testing.T) {
+	c, _ := newTestClient(t, `{"status": "OK", "results": [
+		{"formatted_address": "across the river", "geometry": {"location": {"lat": 45.43, "lng": -75.70}}},
+		{"formatted_address": "here", "geometry": {"location": {"lat": 45.4201, "lng": -75.6901}}}
+	]}`)
+	ll := LatLng{45.42, -75.69}
+	a, err := c.ReverseGeocodeNearest(context.Background(), ll, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Address != "here" || len(a.Response.Results) != 1 {
+		t.Errorf("Expected: here, Got: %v", a)
+	}
+	_, err = c.ReverseGeocodeNearest(context.Background(), LatLng{0, 0}, 100)
+	if ge, ok := err.(*GeocoderError); !ok || ge.Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, err)
+	}
+
+	opts := make([]RequestOption, 1, 2)
+	opts[0] = WithLanguage("en")
+	c.ReverseGeocodeNearest(context.Background(), ll, 100, opts...)
+	if spare := opts[:2]; spare[1] != nil {
+		t.Error("Expected: the caller's options left untouched, Got: the radius filter appended into them")
+	}
+}
+
+func TestGeocodeMultiLanguage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names := map[string]string{"en": "Cairo, Egypt", "ar": "القاهرة، مصر", "fr": "Le Caire, Égypte"}
+		name, ok := names[r.URL.Query().Get("language")]
+		if !ok {
+			w.Write([]byte(`{"status": "INVALID_REQUEST"}`))
+			return
+		}
+		w.Write([]byte(`{"status": "OK", "results": [{"formatted_address": "` + name + `"}]}`))
+	}))
+	defer srv.Close()
+	c := NewClient("test-key", WithBaseURL(srv.URL))
+
+	got, err := c.GeocodeMultiLanguage(context.Background(), "Cairo", []string{"en", "ar", "fr"}, WithRegion("eg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got["ar"].Address != "القاهرة، مصر" || got["fr"].Address != "Le Caire, Égypte" {
+		t.Errorf("Unexpected addresses: %v", got)
+	}
+	if _, err := c.GeocodeMultiLanguage(context.Background(), "Cairo", []string{"en", "xx"}); err == nil {
+		t.Error("Expected an error for an unsupported language")
+	}
+}
+
+func TestGeocodeExtraParams(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	extra := url.Values{"extra_computations": {"ADDRESS_DESCRIPTORS", "BUILDING_AND_ENTRANCES"}, "address": {"ignored"}, "note": {"a&b=c"}}
+	if _, err := c.Geocode(context.Background(), "1 Main St", WithExtraParams(extra)); err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if got := q["extra_computations"]; len(got) != 2 || got[1] != "BUILDING_AND_ENTRANCES" {
+		t.Errorf("Expected: both extra_computations, Got: %v", got)
+	}
+	if q.Get("address") != "1 Main St" || q.Get("note") != "a&b=c" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+}
@@ -0,0 +1,212 @@
+// Package geofence tracks positions against a set of named circular,
+// rectangular and polygonal fences.
+package geofence
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/reillywatson/geo"
+)
+
+type (
+	// Circle is a fence of Radius around Center.
+	Circle struct {
+		Center geo.LatLng
+		Radius geo.Distance
+	}
+
+	// Fences is a registry of named fences, indexed for fast point lookups.
+	// It is safe for concurrent use.
+	Fences struct {
+		mu     sync.RWMutex
+		tree   *geo.RTree
+		shapes map[string]geo.Shape
+	}
+
+	// EventKind says whether a position entered or left a fence.
+	EventKind int
+
+	// Event is a transition reported by Tracker.Update.
+	Event struct {
+		Fence    string
+		Kind     EventKind
+		Position geo.LatLng
+	}
+
+	// Tracker follows a single stream of positions and reports fence
+	// transitions. It is not safe for concurrent use.
+	Tracker struct {
+		fences *Fences
+		inside map[string]bool
+	}
+)
+
+const (
+	Enter EventKind = iota
+	Exit
+)
+
+func (k EventKind) String() string {
+	if k == Enter {
+		return "enter"
+	}
+	return "exit"
+}
+
+// Bounds returns a bounding box enclosing the circle.
+func (c Circle) Bounds() geo.BoundingBox {
+	dLat := c.Radius.Meters() / geo.EarthRadius * 180 / math.Pi
+	south, north := c.Center.Lat-dLat, c.Center.Lat+dLat
+	if south <= -90 || north >= 90 {
+		return geo.BoundingBox{
+			Southwest: geo.LatLng{Lat: math.Max(south, -90), Lng: -180},
+			Northeast: geo.LatLng{Lat: math.Min(north, 90), Lng: 180},
+		}
+	}
+	dLng := dLat / math.Cos(math.Max(math.Abs(south), math.Abs(north))*math.Pi/180)
+	if dLng >= 180 {
+		return geo.BoundingBox{
+			Southwest: geo.LatLng{Lat: south, Lng: -180},
+			Northeast: geo.LatLng{Lat: north, Lng: 180},
+		}
+	}
+	return geo.BoundingBox{
+		Southwest: geo.LatLng{Lat: south, Lng: wrapLng(c.Center.Lng - dLng)},
+		Northeast: geo.LatLng{Lat: north, Lng: wrapLng(c.Center.Lng + dLng)},
+	}
+}
+
+// Contains reports whether ll is within Radius of Center.
+func (c Circle) Contains(ll geo.LatLng) bool {
+	return c.Center.Distance(ll) <= c.Radius
+}
+
+func wrapLng(lng float64) float64 {
+	if lng < -180 {
+		return lng + 360
+	}
+	if lng > 180 {
+		return lng - 360
+	}
+	return lng
+}
+
+// New returns an empty set of fences.
+func New() *Fences {
+	return &Fences{tree: geo.NewRTree(), shapes: map[string]geo.Shape{}}
+}
+
+// Add registers s under name, replacing any existing fence with that name.
+func (f *Fences) Add(name string, s geo.Shape) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.shapes[name]; ok {
+		f.tree.Remove(name)
+	}
+	f.shapes[name] = s
+	f.tree.Insert(s, name)
+}
+
+// AddCircle registers a circular fence.
+func (f *Fences) AddCircle(name string, center geo.LatLng, radius geo.Distance) {
+	f.Add(name, Circle{Center: center, Radius: radius})
+}
+
+// AddBox registers a rectangular fence.
+func (f *Fences) AddBox(name string, b geo.BoundingBox) {
+	f.Add(name, b)
+}
+
+// AddPolygon registers a polygonal fence.
+func (f *Fences) AddPolygon(name string, p geo.Polygon) {
+	f.Add(name, p)
+}
+
+// Remove deletes the named fence, reporting whether it existed.
+func (f *Fences) Remove(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.shapes[name]; !ok {
+		return false
+	}
+	delete(f.shapes, name)
+	return f.tree.Remove(name)
+}
+
+// Get returns the named fence.
+func (f *Fences) Get(name string) (geo.Shape, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	s, ok := f.shapes[name]
+	return s, ok
+}
+
+// Len returns the number of registered fences.
+func (f *Fences) Len() int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.shapes)
+}
+
+// Containing returns the sorted names of every fence containing ll.
+func (f *Fences) Containing(ll geo.LatLng) []string {
+	f.mu.RLock()
+	entries := f.tree.Containing(ll)
+	f.mu.RUnlock()
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Value.(string)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewTracker returns a Tracker that starts outside every fence in f.
+func (f *Fences) NewTracker() *Tracker {
+	return &Tracker{fences: f, inside: map[string]bool{}}
+}
+
+// Update records a new position and returns the resulting transitions:
+// exits first, then enters, each sorted by fence name. Removing a fence
+// produces an Exit on the next update for trackers that were inside it.
+func (t *Tracker) Update(ll geo.LatLng) []Event {
+	now := map[string]bool{}
+	for _, name := range t.fences.Containing(ll) {
+		now[name] = true
+	}
+	var exits, enters []string
+	for name := range t.inside {
+		if !now[name] {
+			exits = append(exits, name)
+		}
+	}
+	for name := range now {
+		if !t.inside[name] {
+			enters = append(enters, name)
+		}
+	}
+	sort.Strings(exits)
+	sort.Strings(enters)
+	t.inside = now
+
+	events := make([]Event, 0, len(exits)+len(enters))
+	for _, name := range exits {
+		events = append(events, Event{Fence: name, Kind: Exit, Position: ll})
+	}
+	for _, name := range enters {
+		events = append(events, Event{Fence: name, Kind: Enter, Position: ll})
+	}
+	return events
+}
+
+// Inside returns the sorted names of the fences the tracker is currently in.
+func (t *Tracker) Inside() []string {
+	names := make([]string, 0, len(t.inside))
+	for name := range t.inside {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,68 @@
+package geofence
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/reillywatson/geo"
+)
+
+func testFences() *Fences {
+	f := New()
+	f.AddCircle("office", geo.LatLng{Lat: 40.7484, Lng: -73.9857}, 200)
+	f.AddBox("midtown", geo.BoundingBox{
+		Southwest: geo.LatLng{Lat: 40.74, Lng: -74.0},
+		Northeast: geo.LatLng{Lat: 40.77, Lng: -73.96},
+	})
+	f.AddPolygon("park", geo.Polygon{
+		{Lat: 40.7644, Lng: -73.9730}, {Lat: 40.8003, Lng: -73.9582},
+		{Lat: 40.7968, Lng: -73.9492}, {Lat: 40.7681, Lng: -73.9817},
+	})
+	f.AddCircle("dateline", geo.LatLng{Lat: 0, Lng: 179.999}, 1000)
+	return f
+}
+
+func TestContaining(t *testing.T) {
+	f := testFences()
+	got := f.Containing(geo.LatLng{Lat: 40.7485, Lng: -73.9856})
+	if expected := []string{"midtown", "office"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, got)
+	}
+	got = f.Containing(geo.LatLng{Lat: 0, Lng: -179.999})
+	if expected := []string{"dateline"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, got)
+	}
+	if !f.Remove("office") || f.Remove("office") {
+		t.Errorf("Expected Remove to succeed exactly once")
+	}
+	if got := f.Containing(geo.LatLng{Lat: 40.7485, Lng: -73.9856}); len(got) != 1 {
+		t.Errorf("Expected: [midtown], Got: %v", got)
+	}
+}
+
+func TestTracker(t *testing.T) {
+	f := testFences()
+	tr := f.NewTracker()
+	check := func(ll geo.LatLng, expected []Event) {
+		t.Helper()
+		got := tr.Update(ll)
+		if len(got) == 0 && len(expected) == 0 {
+			return
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected: %v, Got: %v", expected, got)
+		}
+	}
+	outside := geo.LatLng{Lat: 40.70, Lng: -74.01}
+	office := geo.LatLng{Lat: 40.7485, Lng: -73.9856}
+	edge := geo.LatLng{Lat: 40.7450, Lng: -73.9900}
+
+	check(outside, nil)
+	check(office, []Event{{"midtown", Enter, office}, {"office", Enter, office}})
+	check(office, nil)
+	check(edge, []Event{{"office", Exit, edge}})
+	check(outside, []Event{{"midtown", Exit, outside}})
+	if inside := tr.Inside(); len(inside) != 0 {
+		t.Errorf("Expected no fences, Got: %v", inside)
+	}
+}
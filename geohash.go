@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"errors"
+	"strings"
+)
+
+var InvalidGeohashError = errors.New("Invalid geohash.")
+
+// MaxGeohashPrecision is the longest geohash produced, about 19mm by 37mm
+// at the equator; longer ones exceed the precision of a float64.
+const MaxGeohashPrecision = 12
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// GeohashAt returns the geohash of length precision (1-12) of the cell
+// containing ll.
+func GeohashAt(ll LatLng, precision int) string {
+	if precision < 1 {
+		precision = 1
+	} else if precision > MaxGeohashPrecision {
+		precision = MaxGeohashPrecision
+	}
+	lat, lng := [2]float64{-90, 90}, [2]float64{-180, 180}
+	var b strings.Builder
+	even := true
+	for b.Len() < precision {
+		ch := 0
+		for bit := 4; bit >= 0; bit-- {
+			r, v := &lat, ll.Lat
+			if even {
+				r, v = &lng, ll.Lng
+			}
+			if mid := (r[0] + r[1]) / 2; v >= mid {
+				ch |= 1 << uint(bit)
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+		b.WriteByte(geohashAlphabet[ch])
+	}
+	return b.String()
+}
+
+// GeohashBounds returns the cell identified by a geohash.
+func GeohashBounds(hash string) (BoundingBox, error) {
+	if hash == "" {
+		return BoundingBox{}, InvalidGeohashError
+	}
+	lat, lng := [2]float64{-90, 90}, [2]float64{-180, 180}
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		ch := strings.IndexRune(geohashAlphabet, c)
+		if ch < 0 {
+			return BoundingBox{}, InvalidGeohashError
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &lat
+			if even {
+				r = &lng
+			}
+			if mid := (r[0] + r[1]) / 2; ch&(1<<uint(bit)) != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return BoundingBox{Southwest: LatLng{lat[0], lng[0]}, Northeast: LatLng{lat[1], lng[1]}}, nil
+}
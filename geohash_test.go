@@ -0,0 +1,44 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeohashAt(t *testing.T) {
+	tests := []struct {
+		ll        LatLng
+		precision int
+		expected  string
+	}{
+		{LatLng{57.64911, 10.40744}, 11, "u4pruydqqvj"},
+		{LatLng{42.605, -5.603}, 5, "ezs42"},
+		{LatLng{-25.382708, -49.265506}, 7, "6gkzwgj"},
+		{LatLng{0, 0}, 0, "s"},
+	}
+	for _, test := range tests {
+		if got := GeohashAt(test.ll, test.precision); got != test.expected {
+			t.Errorf("Expected: %s, Got: %s", test.expected, got)
+		}
+	}
+}
+
+func TestGeohashBounds(t *testing.T) {
+	b, err := GeohashBounds("ezs42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sw, ne := LatLng{42.583, -5.625}, LatLng{42.627, -5.581}
+	if math.Abs(b.Southwest.Lat-sw.Lat) > 0.001 || math.Abs(b.Southwest.Lng-sw.Lng) > 0.001 ||
+		math.Abs(b.Northeast.Lat-ne.Lat) > 0.001 || math.Abs(b.Northeast.Lng-ne.Lng) > 0.001 {
+		t.Errorf("Expected: %v-%v, Got: %v", sw, ne, b)
+	}
+	if !b.Contains(LatLng{42.605, -5.603}) {
+		t.Errorf("Expected: %v to contain the encoded point", b)
+	}
+	for _, bad := range []string{"", "ezs4a"} {
+		if _, err := GeohashBounds(bad); err != InvalidGeohashError {
+			t.Errorf("%q: Expected: %v, Got: %v", bad, InvalidGeohashError, err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package geo
+
+import "context"
+
+type (
+	// GeolocationRequest describes what a device can observe. Any field may
+	// be left empty. With ConsiderIP set, Google falls back to the caller's
+	// IP address when the observations aren't enough.
+	GeolocationRequest struct {
+		HomeMobileCountryCode int               `json:"homeMobileCountryCode,omitempty"`
+		HomeMobileNetworkCode int               `json:"homeMobileNetworkCode,omitempty"`
+		RadioType             string            `json:"radioType,omitempty"`
+		Carrier               string            `json:"carrier,omitempty"`
+		ConsiderIP            bool              `json:"considerIp"`
+		CellTowers            []CellTower       `json:"cellTowers,omitempty"`
+		WiFiAccessPoints      []WiFiAccessPoint `json:"wifiAccessPoints,omitempty"`
+	}
+
+	// CellTower is a cell tower observation. Age is in milliseconds and
+	// SignalStrength in dBm.
+	CellTower struct {
+		CellID            int `json:"cellId"`
+		LocationAreaCode  int `json:"locationAreaCode"`
+		MobileCountryCode int `json:"mobileCountryCode"`
+		MobileNetworkCode int `json:"mobileNetworkCode"`
+		Age               int `json:"age,omitempty"`
+		SignalStrength    int `json:"signalStrength,omitempty"`
+		TimingAdvance     int `json:"timingAdvance,omitempty"`
+	}
+
+	// WiFiAccessPoint is a WiFi observation. Google requires at least two
+	// access points.
+	WiFiAccessPoint struct {
+		MACAddress         string `json:"macAddress"`
+		SignalStrength     int    `json:"signalStrength,omitempty"`
+		Age                int    `json:"age,omitempty"`
+		Channel            int    `json:"channel,omitempty"`
+		SignalToNoiseRatio int    `json:"signalToNoiseRatio,omitempty"`
+	}
+
+	// GeolocationResult is an estimated position. Accuracy is the radius in
+	// meters of a 68% confidence circle around Location.
+	GeolocationResult struct {
+		Location LatLng  `json:"location"`
+		Accuracy float64 `json:"accuracy"`
+	}
+
+	geolocationResponse struct {
+		GeolocationResult
+		Error *googleAPIError `json:"error"`
+	}
+)
+
+// Geolocate estimates a device's position from nearby cell towers and WiFi
+// access points using the Google Geolocation API.
+func (c *Client) Geolocate(ctx context.Context, req GeolocationRequest) (*GeolocationResult, error) {
+	var r geolocationResponse
+	if err := c.postJSON(ctx, geolocationAPIHost, "/geolocation/v1/geolocate", req, &r); err != nil {
+		return nil, err
+	}
+	if err := r.Error.err(); err != nil {
+		return nil, err
+	}
+	return &r.GeolocationResult, nil
+}
@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGeolocate(t *testing.T) {
+	var got GeolocationRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/geolocation/v1/geolocate" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{"location": {"lat": 37.421925, "lng": -122.0841293}, "accuracy": 34.0}`))
+	}))
+	defer srv.Close()
+	c := NewClient("k", WithBaseURL(srv.URL))
+
+	res, err := c.Geolocate(context.Background(), GeolocationRequest{
+		RadioType:  "lte",
+		CellTowers: []CellTower{{CellID: 170402199, LocationAreaCode: 35632, MobileCountryCode: 310, MobileNetworkCode: 410}},
+		WiFiAccessPoints: []WiFiAccessPoint{
+			{MACAddress: "3c:37:86:5d:75:d4", SignalStrength: -35},
+			{MACAddress: "30:86:2d:c4:29:d0", SignalStrength: -35},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.CellTowers) != 1 || got.CellTowers[0].CellID != 170402199 || len(got.WiFiAccessPoints) != 2 || got.RadioType != "lte" {
+		t.Errorf("Unexpected request: %+v", got)
+	}
+	if res.Location.Lat != 37.421925 || res.Accuracy != 34 {
+		t.Errorf("Unexpected result: %+v", res)
+	}
+}
+
+func TestGeolocateNotFound(t *testing.T) {
+	c, _ := newTestClient(t, `{"error": {"errors": [{"domain": "geolocation", "reason": "notFound", "message": "Not Found"}], "code": 404, "message": "Not Found"}}`)
+	_, err := c.Geolocate(context.Background(), GeolocationRequest{})
+	if ge, ok := err.(*GeocoderError); !ok || ge.Status != "notFound" {
+		t.Errorf("Expected: notFound, Got: %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+// Package geotest provides an in-memory geo.Geocoder for testing code that
+// depends on geocoding, without network access.
+package geotest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/reillywatson/geo"
+)
+
+type (
+	// Geocoder is a geo.Geocoder whose answers are programmed per query.
+	// Queries with no programmed answer fail with ZERO_RESULTS. It is safe
+	// for concurrent use.
+	Geocoder struct {
+		mu      sync.Mutex
+		forward map[string]*Stub
+		reverse map[geo.LatLng]*Stub
+		calls   []Call
+	}
+
+	// Stub is the programmed answer for one query.
+	Stub struct {
+		mu   sync.Mutex
+		addr *geo.Address
+		err  error
+	}
+
+	// Call records one request made to a Geocoder. Query is set for Geocode
+	// calls and LatLng for ReverseGeocode calls.
+	Call struct {
+		Method string
+		Query  string
+		LatLng geo.LatLng
+	}
+)
+
+// New returns a Geocoder with nothing programmed.
+func New() *Geocoder {
+	return &Geocoder{forward: map[string]*Stub{}, reverse: map[geo.LatLng]*Stub{}}
+}
+
+// OnGeocode returns the stub answering Geocode(q), creating it if needed.
+func (g *Geocoder) OnGeocode(q string) *Stub {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	s, ok := g.forward[q]
+	if !ok {
+		s = &Stub{}
+		g.forward[q] = s
+	}
+	return s
+}
+
+// OnReverseGeocode returns the stub answering ReverseGeocode(ll), creating it
+// if needed.
+func (g *Geocoder) OnReverseGeocode(ll geo.LatLng) *Stub {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	s, ok := g.reverse[ll]
+	if !ok {
+		s = &Stub{}
+		g.reverse[ll] = s
+	}
+	return s
+}
+
+// Return makes the stub answer with addr.
+func (s *Stub) Return(addr *geo.Address) *Stub {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addr, s.err = addr, nil
+	return s
+}
+
+// ReturnStatus makes the stub fail with a GeocoderError carrying status, e.g.
+// geo.StatusOverQueryLimit.
+func (s *Stub) ReturnStatus(status string) *Stub {
+	return s.ReturnError(&geo.GeocoderError{Status: status})
+}
+
+// ReturnError makes the stub fail with err.
+func (s *Stub) ReturnError(err error) *Stub {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addr, s.err = nil, err
+	return s
+}
+
+func (s *Stub) answer() (*geo.Address, error) {
+	if s == nil {
+		return nil, &geo.GeocoderError{Status: geo.StatusZeroResults}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.addr == nil {
+		return nil, &geo.GeocoderError{Status: geo.StatusZeroResults}
+	}
+	copied := *s.addr
+	return &copied, nil
+}
+
+// Geocode returns the answer programmed for q.
+func (g *Geocoder) Geocode(ctx context.Context, q string, opts ...geo.RequestOption) (*geo.Address, error) {
+	g.mu.Lock()
+	g.calls = append(g.calls, Call{Method: "Geocode", Query: q})
+	s := g.forward[q]
+	g.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.answer()
+}
+
+// ReverseGeocode returns the answer programmed for ll.
+func (g *Geocoder) ReverseGeocode(ctx context.Context, ll geo.LatLng, opts ...geo.RequestOption) (*geo.Address, error) {
+	g.mu.Lock()
+	g.calls = append(g.calls, Call{Method: "ReverseGeocode", LatLng: ll})
+	s := g.reverse[ll]
+	g.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.answer()
+}
+
+// Calls returns every call made so far, in order.
+func (g *Geocoder) Calls() []Call {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]Call(nil), g.calls...)
+}
+
+// GeocodeCount returns how many times Geocode was called with q.
+func (g *Geocoder) GeocodeCount(q string) int {
+	n := 0
+	for _, c := range g.Calls() {
+		if c.Method == "Geocode" && c.Query == q {
+			n++
+		}
+	}
+	return n
+}
+
+// Reset forgets recorded calls, keeping programmed answers.
+func (g *Geocoder) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.calls = nil
+}
+
+// Address builds an Address with a single rooftop-precision result, for use
+// as a programmed answer.
+func Address(formatted string, lat, lng float64) *geo.Address {
+	r := geo.Result{
+		Types:            []string{"street_address"},
+		FormattedAddress: formatted,
+		Confidence:       1,
+		Precision:        geo.PrecisionRooftop,
+	}
+	r.Geometry.Location = geo.LatLng{Lat: lat, Lng: lng}
+	r.Geometry.LocationType = geo.LocationTypeRooftop
+	return &geo.Address{
+		Lat:      lat,
+		Lng:      lng,
+		Address:  formatted,
+		Response: &geo.Response{Status: geo.StatusOk, Results: []geo.Result{r}},
+	}
+}
+
+var _ geo.Geocoder = (*Geocoder)(nil)
@@ -0,0 +1,48 @@
+package geotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reillywatson/geo"
+)
+
+func TestGeocoder(t *testing.T) {
+	g := New()
+	g.OnGeocode("555 w 18th st").Return(Address("555 W 18th St, New York, NY 10011, USA", 40.7453721, -74.0078293))
+	g.OnGeocode("busy").ReturnStatus(geo.StatusOverQueryLimit)
+	boom := errors.New("boom")
+	g.OnReverseGeocode(geo.LatLng{Lat: 1, Lng: 2}).ReturnError(boom)
+
+	ctx := context.Background()
+	addr, err := g.Geocode(ctx, "555 w 18th st")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.Lat != 40.7453721 || addr.Response.Results[0].Precision != geo.PrecisionRooftop {
+		t.Errorf("Unexpected address: %v", addr)
+	}
+	if _, err := g.Geocode(ctx, "busy"); err == nil || err.(*geo.GeocoderError).Status != geo.StatusOverQueryLimit {
+		t.Errorf("Expected: OVER_QUERY_LIMIT, Got: %v", err)
+	}
+	if _, err := g.Geocode(ctx, "nowhere"); err == nil || err.(*geo.GeocoderError).Status != geo.StatusZeroResults {
+		t.Errorf("Expected: ZERO_RESULTS, Got: %v", err)
+	}
+	if _, err := g.ReverseGeocode(ctx, geo.LatLng{Lat: 1, Lng: 2}); err != boom {
+		t.Errorf("Expected: %v, Got: %v", boom, err)
+	}
+	g.Geocode(ctx, "555 w 18th st")
+
+	if n := g.GeocodeCount("555 w 18th st"); n != 2 {
+		t.Errorf("Expected: 2, Got: %d", n)
+	}
+	calls := g.Calls()
+	if len(calls) != 5 || calls[3].Method != "ReverseGeocode" || calls[3].LatLng.Lng != 2 {
+		t.Errorf("Unexpected calls: %v", calls)
+	}
+	g.Reset()
+	if len(g.Calls()) != 0 {
+		t.Errorf("Expected calls to be cleared")
+	}
+}
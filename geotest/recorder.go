@@ -0,0 +1,197 @@
+package geotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+)
+
+// Mode selects whether a Recorder talks to the network.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the fixture file only.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests upstream and records them for Save.
+	ModeRecord
+)
+
+// RecordEnv is the environment variable ModeFromEnv checks. Set it to 1 to
+// re-record fixtures against the real APIs.
+const RecordEnv = "GEOTEST_RECORD"
+
+// sensitiveParams are removed from recorded URLs and ignored when matching.
+var sensitiveParams = []string{"key", "signature", "client", "sessiontoken", "access_token", "auth-id", "auth-token"}
+
+type (
+	// Recorder is an http.RoundTripper that records real API traffic to a
+	// fixture file and replays it later, so integration tests run hermetically.
+	// API keys, signatures and other credentials are stripped from everything
+	// it writes.
+	Recorder struct {
+		Path      string
+		Mode      Mode
+		Transport http.RoundTripper
+
+		mu           sync.Mutex
+		interactions []Interaction
+		used         []bool
+	}
+
+	// Interaction is one recorded request and its response.
+	Interaction struct {
+		Method      string `json:"method"`
+		URL         string `json:"url"`
+		RequestBody string `json:"request_body,omitempty"`
+		StatusCode  int    `json:"status_code"`
+		ContentType string `json:"content_type,omitempty"`
+		Body        string `json:"body"`
+	}
+)
+
+// ModeFromEnv returns ModeRecord if RecordEnv is set to 1, and ModeReplay
+// otherwise.
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) == "1" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// NewRecorder returns a Recorder for the fixture at path. In ModeReplay the
+// fixture must already exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{Path: path, Mode: mode, Transport: http.DefaultTransport}
+	if mode == ModeRecord {
+		return r, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &r.interactions); err != nil {
+		return nil, err
+	}
+	r.used = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Client returns an http.Client that sends requests through r, for use with
+// geo.WithHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays req.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = b
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+	u := sanitizeURL(req.URL)
+	if r.Mode == ModeReplay {
+		return r.replay(req, u, string(reqBody))
+	}
+
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Method:      req.Method,
+		URL:         u,
+		RequestBody: string(reqBody),
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(body),
+	})
+	r.mu.Unlock()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// replay serves the first unused matching interaction, falling back to the
+// first match so repeated identical requests keep working.
+func (r *Recorder) replay(req *http.Request, u, body string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	match := -1
+	for i, in := range r.interactions {
+		if in.Method != req.Method || in.URL != u || in.RequestBody != body {
+			continue
+		}
+		if !r.used[i] {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("geotest: no recorded interaction for %s %s", req.Method, u)
+	}
+	r.used[match] = true
+	in := r.interactions[match]
+	header := http.Header{}
+	if in.ContentType != "" {
+		header.Set("Content-Type", in.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(in.Body))),
+		ContentLength: int64(len(in.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Save writes the recorded interactions to Path. It does nothing in
+// ModeReplay.
+func (r *Recorder) Save() error {
+	if r.Mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.interactions == nil {
+		return errors.New("geotest: nothing recorded")
+	}
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.Path, append(b, '\n'), 0644)
+}
+
+func sanitizeURL(u *url.URL) string {
+	q := u.Query()
+	for _, p := range sensitiveParams {
+		q.Del(p)
+	}
+	s := *u
+	s.RawQuery = q.Encode()
+	return s.String()
+}
@@ -0,0 +1,80 @@
+package geotest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reillywatson/geo"
+)
+
+func TestRecorder(t *testing.T) {
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status": "OK", "results": [{"formatted_address": "Ithaca, NY, USA", "geometry": {"location": {"lat": 42.44, "lng": -76.5}}}]}`))
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	ctx := context.Background()
+
+	rec, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := geo.NewClient("secret-key", geo.WithBaseURL(srv.URL), geo.WithHTTPClient(rec.Client()))
+	if _, err := c.Geocode(ctx, "Ithaca"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := os.ReadFile(path)
+	if strings.Contains(string(b), "secret-key") {
+		t.Errorf("Expected the API key to be stripped: %s", b)
+	}
+
+	rec, err = NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = geo.NewClient("other-key", geo.WithBaseURL(srv.URL), geo.WithHTTPClient(rec.Client()))
+	addr, err := c.Geocode(ctx, "Ithaca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.Address != "Ithaca, NY, USA" || hits != 1 {
+		t.Errorf("Expected a replayed response, Got: %v after %d hits", addr, hits)
+	}
+	if _, err := c.Geocode(ctx, "Elsewhere"); err == nil || hits != 1 {
+		t.Errorf("Expected unrecorded requests to fail without reaching the server, Got: %v", err)
+	}
+}
+
+func TestRecorderStripsSmartyCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"delivery_line_1": "1 Main St", "last_line": "Springfield IL 62701", "metadata": {"latitude": 39.8, "longitude": -89.6, "precision": "Zip9"}}]`))
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	rec, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := geo.NewSmartyGeocoder(geo.NewClient("", geo.WithBaseURL(srv.URL), geo.WithHTTPClient(rec.Client())), "secret-id", "secret-token")
+	if _, err := s.Geocode(context.Background(), "1 Main St, Springfield IL"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := os.ReadFile(path)
+	if strings.Contains(string(b), "secret-id") || strings.Contains(string(b), "secret-token") {
+		t.Errorf("Expected the Smarty credentials to be stripped: %s", b)
+	}
+}
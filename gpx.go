@@ -0,0 +1,147 @@
+package geo
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+type (
+	// GPX is the waypoints and tracks of a GPX 1.1 document.
+	GPX struct {
+		Waypoints []GPXPoint
+		Tracks    []GPXTrack
+	}
+
+	// GPXPoint is a waypoint or track point. Elevation is in meters; Time
+	// is zero if the point has none.
+	GPXPoint struct {
+		LatLng
+		Name      string
+		Desc      string
+		Elevation *float64
+		Time      time.Time
+	}
+
+	// GPXTrack is a named track made of one or more continuous segments.
+	GPXTrack struct {
+		Name     string
+		Segments [][]GPXPoint
+	}
+
+	gpxDoc struct {
+		XMLName   xml.Name   `xml:"gpx"`
+		Xmlns     string     `xml:"xmlns,attr,omitempty"`
+		Version   string     `xml:"version,attr,omitempty"`
+		Creator   string     `xml:"creator,attr,omitempty"`
+		Waypoints []gpxPoint `xml:"wpt"`
+		Tracks    []gpxTrack `xml:"trk"`
+	}
+
+	gpxPoint struct {
+		Lat       float64  `xml:"lat,attr"`
+		Lon       float64  `xml:"lon,attr"`
+		Elevation *float64 `xml:"ele,omitempty"`
+		Time      string   `xml:"time,omitempty"`
+		Name      string   `xml:"name,omitempty"`
+		Desc      string   `xml:"desc,omitempty"`
+	}
+
+	gpxTrack struct {
+		Name     string `xml:"name,omitempty"`
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	}
+)
+
+// ReadGPX decodes a GPX document.
+func ReadGPX(r io.Reader) (*GPX, error) {
+	var doc gpxDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	g := &GPX{}
+	for _, p := range doc.Waypoints {
+		g.Waypoints = append(g.Waypoints, p.point())
+	}
+	for _, t := range doc.Tracks {
+		track := GPXTrack{Name: t.Name}
+		for _, seg := range t.Segments {
+			points := make([]GPXPoint, len(seg.Points))
+			for i, p := range seg.Points {
+				points[i] = p.point()
+			}
+			track.Segments = append(track.Segments, points)
+		}
+		g.Tracks = append(g.Tracks, track)
+	}
+	return g, nil
+}
+
+func (p gpxPoint) point() GPXPoint {
+	t, _ := time.Parse(time.RFC3339, p.Time)
+	return GPXPoint{LatLng: LatLng{p.Lat, p.Lon}, Name: p.Name, Desc: p.Desc, Elevation: p.Elevation, Time: t}
+}
+
+func newGPXPoint(p GPXPoint) gpxPoint {
+	gp := gpxPoint{Lat: p.Lat, Lon: p.Lng, Elevation: p.Elevation, Name: p.Name, Desc: p.Desc}
+	if !p.Time.IsZero() {
+		gp.Time = p.Time.UTC().Format(time.RFC3339)
+	}
+	return gp
+}
+
+// Write encodes g as a GPX 1.1 document.
+func (g *GPX) Write(w io.Writer) error {
+	doc := gpxDoc{Xmlns: "http://www.topografix.com/GPX/1/1", Version: "1.1", Creator: "github.com/reillywatson/geo"}
+	for _, p := range g.Waypoints {
+		doc.Waypoints = append(doc.Waypoints, newGPXPoint(p))
+	}
+	for _, t := range g.Tracks {
+		gt := gpxTrack{Name: t.Name}
+		for _, seg := range t.Segments {
+			points := make([]gpxPoint, len(seg))
+			for i, p := range seg {
+				points[i] = newGPXPoint(p)
+			}
+			gt.Segments = append(gt.Segments, struct {
+				Points []gpxPoint `xml:"trkpt"`
+			}{points})
+		}
+		doc.Tracks = append(doc.Tracks, gt)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(doc)
+}
+
+// Points returns the location of every waypoint and track point, in
+// document order, e.g. for reverse geocoding a trace.
+func (g *GPX) Points() []LatLng {
+	points := []LatLng{}
+	for _, p := range g.Waypoints {
+		points = append(points, p.LatLng)
+	}
+	for _, t := range g.Tracks {
+		for _, seg := range t.Segments {
+			for _, p := range seg {
+				points = append(points, p.LatLng)
+			}
+		}
+	}
+	return points
+}
+
+// Bounds returns the smallest box containing every point in g.
+func (g *GPX) Bounds() BoundingBox {
+	return Polygon(g.Points()).Bounds()
+}
+
+// AddressWaypoint returns a waypoint at a, named by its formatted address.
+func AddressWaypoint(a *Address) GPXPoint {
+	return GPXPoint{LatLng: LatLng{a.Lat, a.Lng}, Name: a.Address}
+}
@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testGPX = `<?xml version="1.0"?>
+<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="43.6426" lon="-79.3871"><ele>120.5</ele><name>CN Tower</name></wpt>
+  <trk>
+    <name>Run</name>
+    <trkseg>
+      <trkpt lat="43.64" lon="-79.39"><time>2020-05-01T12:00:00Z</time></trkpt>
+      <trkpt lat="43.65" lon="-79.38"></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestReadGPX(t *testing.T) {
+	g, err := ReadGPX(strings.NewReader(testGPX))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Waypoints) != 1 || g.Waypoints[0].Name != "CN Tower" || g.Waypoints[0].Elevation == nil || *g.Waypoints[0].Elevation != 120.5 {
+		t.Errorf("Expected: the CN Tower waypoint, Got: %+v", g.Waypoints)
+	}
+	if len(g.Tracks) != 1 || len(g.Tracks[0].Segments) != 1 || len(g.Tracks[0].Segments[0]) != 2 {
+		t.Fatalf("Expected: one track with two points, Got: %+v", g.Tracks)
+	}
+	if expected := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC); !g.Tracks[0].Segments[0][0].Time.Equal(expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, g.Tracks[0].Segments[0][0].Time)
+	}
+	expected := []LatLng{{43.6426, -79.3871}, {43.64, -79.39}, {43.65, -79.38}}
+	if points := g.Points(); !reflect.DeepEqual(points, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, points)
+	}
+	bounds := BoundingBox{Southwest: LatLng{43.64, -79.39}, Northeast: LatLng{43.65, -79.38}}
+	if b := g.Bounds(); b != bounds {
+		t.Errorf("Expected: %v, Got: %v", bounds, b)
+	}
+}
+
+func TestGPXRoundTrip(t *testing.T) {
+	ele := 12.0
+	g := &GPX{
+		Waypoints: []GPXPoint{AddressWaypoint(&Address{Lat: 1, Lng: 2, Address: "A & B"})},
+		Tracks: []GPXTrack{{Name: "t", Segments: [][]GPXPoint{{
+			{LatLng: LatLng{3, 4}, Elevation: &ele, Time: time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)},
+		}}}},
+	}
+	var buf bytes.Buffer
+	if err := g.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadGPX(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, g) {
+		t.Errorf("Expected: %+v, Got: %+v", g, got)
+	}
+}
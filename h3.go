@@ -0,0 +1,954 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// H3Cell is an index in Uber's H3 hexagonal grid. The values are identical
+// to those produced by the reference H3 library, so they can be joined
+// against data aggregated elsewhere.
+type H3Cell uint64
+
+const H3MaxResolution = 15
+
+var InvalidH3CellError = errors.New("Invalid H3 cell.")
+
+const (
+	h3NumBaseCells   = 122
+	h3NumFaces       = 20
+	h3MaxFaceCoord   = 2
+	h3InvalidBase    = 127
+	h3ModeOffset     = 59
+	h3BaseCellOffset = 45
+	h3ResOffset      = 52
+	h3DigitBits      = 3
+	h3Init           = 35184372088831
+
+	h3Epsilon     = 0.0000000000000001
+	h3Sqrt7       = 2.6457513110645905905016157536392604257102
+	h3Sin60       = 0.8660254037844386467637231707529361834714
+	h3Ap7RotRads  = 0.333473172251832115336090755351601070065900389
+	h3Res0UGnomon = 0.38196601125010500003
+	h3FltEpsilon  = 1.1920928955078125e-07
+	h3FaceEdge    = 1
+	h3NewFace     = 2
+	h3QuadrantIJ  = 1
+	h3QuadrantKI  = 2
+	h3QuadrantJK  = 3
+)
+
+// H3 digits, naming the ijk+ axis direction of a child cell.
+const (
+	h3Center = iota
+	h3K
+	h3J
+	h3JK
+	h3I
+	h3IK
+	h3IJ
+	h3InvalidDigit
+)
+
+type (
+	h3CoordIJK struct {
+		i, j, k int
+	}
+
+	h3FaceIJK struct {
+		face  int
+		coord h3CoordIJK
+	}
+
+	h3FaceOrientIJK struct {
+		face      int
+		translate h3CoordIJK
+		ccwRot60  int
+	}
+
+	h3BaseCellRotation struct {
+		baseCell int
+		ccwRot60 int
+	}
+
+	h3BaseCellData struct {
+		home         h3FaceIJK
+		isPentagon   bool
+		cwOffsetPent [2]int
+	}
+)
+
+var (
+	h3UnitVecs = [7]h3CoordIJK{{0, 0, 0}, {0, 0, 1}, {0, 1, 0}, {0, 1, 1}, {1, 0, 0}, {1, 0, 1}, {1, 1, 0}}
+
+	h3MaxDimByCIIRes    = [17]int{2, -1, 14, -1, 98, -1, 686, -1, 4802, -1, 33614, -1, 235298, -1, 1647086, -1, 11529602}
+	h3UnitScaleByCIIRes = [17]int{1, -1, 7, -1, 49, -1, 343, -1, 2401, -1, 16807, -1, 117649, -1, 823543, -1, 5764801}
+
+	// h3Directions is the ccw order in which neighbors are visited.
+	h3Directions = [6]int{h3J, h3JK, h3K, h3IK, h3I, h3IJ}
+
+	h3NewDigitII = [7][7]int{
+		{h3Center, h3K, h3J, h3JK, h3I, h3IK, h3IJ},
+		{h3K, h3I, h3JK, h3IJ, h3IK, h3J, h3Center},
+		{h3J, h3JK, h3K, h3I, h3IJ, h3Center, h3IK},
+		{h3JK, h3IJ, h3I, h3IK, h3Center, h3K, h3J},
+		{h3I, h3IK, h3IJ, h3Center, h3J, h3JK, h3K},
+		{h3IK, h3J, h3Center, h3K, h3JK, h3IJ, h3I},
+		{h3IJ, h3Center, h3IK, h3J, h3K, h3I, h3JK},
+	}
+	h3NewAdjustmentII = [7][7]int{
+		{h3Center, h3Center, h3Center, h3Center, h3Center, h3Center, h3Center},
+		{h3Center, h3K, h3Center, h3K, h3Center, h3IK, h3Center},
+		{h3Center, h3Center, h3J, h3JK, h3Center, h3Center, h3J},
+		{h3Center, h3K, h3JK, h3JK, h3Center, h3Center, h3Center},
+		{h3Center, h3Center, h3Center, h3Center, h3I, h3I, h3IJ},
+		{h3Center, h3IK, h3Center, h3Center, h3I, h3IK, h3Center},
+		{h3Center, h3Center, h3J, h3Center, h3IJ, h3Center, h3IJ},
+	}
+	h3NewDigitIII = [7][7]int{
+		{h3Center, h3K, h3J, h3JK, h3I, h3IK, h3IJ},
+		{h3K, h3J, h3JK, h3I, h3IK, h3IJ, h3Center},
+		{h3J, h3JK, h3I, h3IK, h3IJ, h3Center, h3K},
+		{h3JK, h3I, h3IK, h3IJ, h3Center, h3K, h3J},
+		{h3I, h3IK, h3IJ, h3Center, h3K, h3J, h3JK},
+		{h3IK, h3IJ, h3Center, h3K, h3J, h3JK, h3I},
+		{h3IJ, h3Center, h3K, h3J, h3JK, h3I, h3IK},
+	}
+	h3NewAdjustmentIII = [7][7]int{
+		{h3Center, h3Center, h3Center, h3Center, h3Center, h3Center, h3Center},
+		{h3Center, h3K, h3Center, h3JK, h3Center, h3K, h3Center},
+		{h3Center, h3Center, h3J, h3J, h3Center, h3Center, h3IJ},
+		{h3Center, h3JK, h3J, h3JK, h3Center, h3Center, h3Center},
+		{h3Center, h3Center, h3Center, h3Center, h3I, h3IK, h3I},
+		{h3Center, h3K, h3Center, h3Center, h3IK, h3IK, h3Center},
+		{h3Center, h3Center, h3IJ, h3Center, h3I, h3Center, h3IJ},
+	}
+)
+
+// H3CellAt returns the cell at resolution res (0-15) containing ll.
+func H3CellAt(ll LatLng, res int) H3Cell {
+	if res < 0 || res > H3MaxResolution {
+		return 0
+	}
+	return h3FaceIJKToCell(h3GeoToFaceIJK(toRadians(ll.Lat), toRadians(ll.Lng), res), res)
+}
+
+// ParseH3Cell parses the hexadecimal string form of a cell.
+func ParseH3Cell(s string) (H3Cell, error) {
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil || !H3Cell(v).IsValid() {
+		return 0, InvalidH3CellError
+	}
+	return H3Cell(v), nil
+}
+
+func (c H3Cell) String() string {
+	return strconv.FormatUint(uint64(c), 16)
+}
+
+// Resolution returns the resolution of the cell.
+func (c H3Cell) Resolution() int {
+	return int(uint64(c)>>h3ResOffset) & 15
+}
+
+func (c H3Cell) baseCell() int {
+	return int(uint64(c)>>h3BaseCellOffset) & 127
+}
+
+func (c H3Cell) digit(res int) int {
+	return int(uint64(c)>>(uint(H3MaxResolution-res)*h3DigitBits)) & 7
+}
+
+func (c H3Cell) setDigit(res, digit int) H3Cell {
+	shift := uint(H3MaxResolution-res) * h3DigitBits
+	return H3Cell(uint64(c)&^(7<<shift) | uint64(digit)<<shift)
+}
+
+func (c H3Cell) setBaseCell(bc int) H3Cell {
+	return H3Cell(uint64(c)&^(127<<h3BaseCellOffset) | uint64(bc)<<h3BaseCellOffset)
+}
+
+// IsValid reports whether c is a well-formed H3 cell index.
+func (c H3Cell) IsValid() bool {
+	if uint64(c)>>63 != 0 || int(uint64(c)>>h3ModeOffset)&15 != 1 || int(uint64(c)>>56)&7 != 0 {
+		return false
+	}
+	bc := c.baseCell()
+	if bc >= h3NumBaseCells {
+		return false
+	}
+	res := c.Resolution()
+	leading := true
+	for r := 1; r <= res; r++ {
+		d := c.digit(r)
+		if d == h3InvalidDigit {
+			return false
+		}
+		if leading && d != h3Center {
+			leading = false
+			if h3BaseCells[bc].isPentagon && d == h3K {
+				return false
+			}
+		}
+	}
+	for r := res + 1; r <= H3MaxResolution; r++ {
+		if c.digit(r) != h3InvalidDigit {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPentagon reports whether c is one of the twelve pentagons present at
+// every resolution.
+func (c H3Cell) IsPentagon() bool {
+	return h3BaseCells[c.baseCell()].isPentagon && c.leadingNonZeroDigit() == h3Center
+}
+
+// Parent returns the ancestor of c at resolution res.
+func (c H3Cell) Parent(res int) H3Cell {
+	if res < 0 || res >= c.Resolution() {
+		return c
+	}
+	p := H3Cell(uint64(c)&^(15<<h3ResOffset) | uint64(res)<<h3ResOffset)
+	for r := res + 1; r <= c.Resolution(); r++ {
+		p = p.setDigit(r, 7)
+	}
+	return p
+}
+
+// Center returns the centre point of the cell.
+func (c H3Cell) Center() LatLng {
+	fijk := c.toFaceIJK()
+	x, y := h3IJKToHex2d(fijk.coord)
+	return h3ToLatLng(h3Hex2dToGeo(x, y, fijk.face, c.Resolution(), false))
+}
+
+// Boundary returns the outline of the cell, counter-clockwise. Cells that
+// straddle an icosahedron edge get extra vertices where the edge is crossed.
+func (c H3Cell) Boundary() Polygon {
+	fijk := c.toFaceIJK()
+	if c.IsPentagon() {
+		return h3PentagonBoundary(fijk, c.Resolution())
+	}
+	return h3HexagonBoundary(fijk, c.Resolution())
+}
+
+// KRing returns c and every cell within k steps of it, nearest first.
+func (c H3Cell) KRing(k int) []H3Cell {
+	seen := map[H3Cell]bool{c: true}
+	out := []H3Cell{c}
+	ring := []H3Cell{c}
+	for step := 0; step < k; step++ {
+		next := []H3Cell{}
+		for _, origin := range ring {
+			for _, dir := range h3Directions {
+				rotations := 0
+				n, ok := origin.neighbor(dir, &rotations)
+				if !ok || seen[n] {
+					continue
+				}
+				seen[n] = true
+				next = append(next, n)
+			}
+		}
+		out = append(out, next...)
+		ring = next
+	}
+	return out
+}
+
+func (c H3Cell) leadingNonZeroDigit() int {
+	for r := 1; r <= c.Resolution(); r++ {
+		if d := c.digit(r); d != h3Center {
+			return d
+		}
+	}
+	return h3Center
+}
+
+func (c H3Cell) rotate60ccw() H3Cell {
+	for r := 1; r <= c.Resolution(); r++ {
+		c = c.setDigit(r, h3Rotate60ccw(c.digit(r)))
+	}
+	return c
+}
+
+func (c H3Cell) rotate60cw() H3Cell {
+	for r := 1; r <= c.Resolution(); r++ {
+		c = c.setDigit(r, h3Rotate60cw(c.digit(r)))
+	}
+	return c
+}
+
+func (c H3Cell) rotatePent60ccw() H3Cell {
+	found := false
+	for r := 1; r <= c.Resolution(); r++ {
+		c = c.setDigit(r, h3Rotate60ccw(c.digit(r)))
+		if !found && c.digit(r) != h3Center {
+			found = true
+			if c.leadingNonZeroDigit() == h3K {
+				c = c.rotate60ccw()
+			}
+		}
+	}
+	return c
+}
+
+// neighbor returns the cell adjacent to c in direction dir, adjusting
+// rotations for any change of coordinate system on the way. It ports
+// h3NeighborRotations from the reference implementation.
+func (c H3Cell) neighbor(dir int, rotations *int) (H3Cell, bool) {
+	current := c
+	*rotations %= 6
+	for i := 0; i < *rotations; i++ {
+		dir = h3Rotate60ccw(dir)
+	}
+
+	newRotations := 0
+	oldBaseCell := current.baseCell()
+	oldLeadingDigit := current.leadingNonZeroDigit()
+
+	for r := current.Resolution() - 1; ; r-- {
+		if r == -1 {
+			current = current.setBaseCell(h3BaseCellNeighbors[oldBaseCell][dir])
+			newRotations = h3BaseCellNeighbor60CCWRots[oldBaseCell][dir]
+			if current.baseCell() == h3InvalidBase {
+				// Adjust for the deleted k vertex at the base cell level.
+				current = current.setBaseCell(h3BaseCellNeighbors[oldBaseCell][h3IK])
+				newRotations = h3BaseCellNeighbor60CCWRots[oldBaseCell][h3IK]
+				current = current.rotate60ccw()
+				*rotations++
+			}
+			break
+		}
+		oldDigit := current.digit(r + 1)
+		var nextDir int
+		if oldDigit == h3InvalidDigit {
+			return 0, false
+		} else if (r+1)%2 == 1 {
+			current = current.setDigit(r+1, h3NewDigitII[oldDigit][dir])
+			nextDir = h3NewAdjustmentII[oldDigit][dir]
+		} else {
+			current = current.setDigit(r+1, h3NewDigitIII[oldDigit][dir])
+			nextDir = h3NewAdjustmentIII[oldDigit][dir]
+		}
+		if nextDir == h3Center {
+			break
+		}
+		dir = nextDir
+	}
+
+	newBaseCell := current.baseCell()
+	if h3BaseCells[newBaseCell].isPentagon {
+		alreadyAdjusted := false
+		if current.leadingNonZeroDigit() == h3K {
+			if oldBaseCell != newBaseCell {
+				if h3IsCwOffset(newBaseCell, h3BaseCells[oldBaseCell].home.face) {
+					current = current.rotate60cw()
+				} else {
+					current = current.rotate60ccw()
+				}
+				alreadyAdjusted = true
+			} else {
+				switch oldLeadingDigit {
+				case h3JK:
+					current = current.rotate60ccw()
+					*rotations++
+				case h3IK:
+					current = current.rotate60cw()
+					*rotations += 5
+				default:
+					// The k direction is deleted from a pentagon.
+					return 0, false
+				}
+			}
+		}
+		for i := 0; i < newRotations; i++ {
+			current = current.rotatePent60ccw()
+		}
+		if oldBaseCell != newBaseCell {
+			if newBaseCell == 4 || newBaseCell == 117 {
+				// Polar pentagons have all i neighbors.
+				if oldBaseCell != 118 && oldBaseCell != 8 && current.leadingNonZeroDigit() != h3JK {
+					*rotations++
+				}
+			} else if current.leadingNonZeroDigit() == h3IK && !alreadyAdjusted {
+				*rotations++
+			}
+		}
+	} else {
+		for i := 0; i < newRotations; i++ {
+			current = current.rotate60ccw()
+		}
+	}
+	*rotations = (*rotations + newRotations) % 6
+	return current, true
+}
+
+func (c H3Cell) toFaceIJK() h3FaceIJK {
+	bc := c.baseCell()
+	if h3BaseCells[bc].isPentagon && c.leadingNonZeroDigit() == h3IK {
+		c = c.rotate60cw()
+	}
+	fijk := h3BaseCells[bc].home
+	res := c.Resolution()
+
+	possibleOverage := h3BaseCells[bc].isPentagon || (res != 0 && fijk.coord != h3CoordIJK{})
+	for r := 1; r <= res; r++ {
+		if r%2 == 1 {
+			fijk.coord = fijk.coord.downAp7()
+		} else {
+			fijk.coord = fijk.coord.downAp7r()
+		}
+		fijk.coord = fijk.coord.neighbor(c.digit(r))
+	}
+	if !possibleOverage {
+		return fijk
+	}
+
+	orig := fijk.coord
+	adjRes := res
+	if res%2 == 1 {
+		fijk.coord = fijk.coord.downAp7r()
+		adjRes++
+	}
+	pentLeading4 := h3BaseCells[bc].isPentagon && c.leadingNonZeroDigit() == h3I
+	if h3AdjustOverageClassII(&fijk, adjRes, pentLeading4, false) != 0 {
+		if h3BaseCells[bc].isPentagon {
+			for h3AdjustOverageClassII(&fijk, adjRes, false, false) != 0 {
+			}
+		}
+		if adjRes != res {
+			fijk.coord = fijk.coord.upAp7r()
+		}
+	} else if adjRes != res {
+		fijk.coord = orig
+	}
+	return fijk
+}
+
+func h3FaceIJKToCell(fijk h3FaceIJK, res int) H3Cell {
+	h := H3Cell(h3Init)
+	h = H3Cell(uint64(h)&^(15<<h3ModeOffset) | 1<<h3ModeOffset)
+	h = H3Cell(uint64(h)&^(15<<h3ResOffset) | uint64(res)<<h3ResOffset)
+
+	ijk := fijk.coord
+	for r := res - 1; r >= 0; r-- {
+		last := ijk
+		var center h3CoordIJK
+		if (r+1)%2 == 1 {
+			ijk = ijk.upAp7()
+			center = ijk.downAp7()
+		} else {
+			ijk = ijk.upAp7r()
+			center = ijk.downAp7r()
+		}
+		h = h.setDigit(r+1, last.sub(center).normalize().unitDigit())
+	}
+	if ijk.i > h3MaxFaceCoord || ijk.j > h3MaxFaceCoord || ijk.k > h3MaxFaceCoord {
+		return 0
+	}
+
+	rot := h3FaceIJKBaseCells[fijk.face][ijk.i][ijk.j][ijk.k]
+	h = h.setBaseCell(rot.baseCell)
+	if h3BaseCells[rot.baseCell].isPentagon {
+		if h.leadingNonZeroDigit() == h3K {
+			if h3IsCwOffset(rot.baseCell, fijk.face) {
+				h = h.rotate60cw()
+			} else {
+				h = h.rotate60ccw()
+			}
+		}
+		for i := 0; i < rot.ccwRot60; i++ {
+			h = h.rotatePent60ccw()
+		}
+	} else {
+		for i := 0; i < rot.ccwRot60; i++ {
+			h = h.rotate60ccw()
+		}
+	}
+	return h
+}
+
+func h3IsCwOffset(baseCell, face int) bool {
+	return h3BaseCells[baseCell].cwOffsetPent[0] == face || h3BaseCells[baseCell].cwOffsetPent[1] == face
+}
+
+func h3GeoToFaceIJK(lat, lng float64, res int) h3FaceIJK {
+	p := [3]float64{math.Cos(lat) * math.Cos(lng), math.Cos(lat) * math.Sin(lng), math.Sin(lat)}
+	face, sqd := 0, 5.0
+	for f := 0; f < h3NumFaces; f++ {
+		c := h3FaceCenterPoint[f]
+		d := (c[0]-p[0])*(c[0]-p[0]) + (c[1]-p[1])*(c[1]-p[1]) + (c[2]-p[2])*(c[2]-p[2])
+		if d < sqd {
+			face, sqd = f, d
+		}
+	}
+
+	r := math.Acos(1 - sqd/2)
+	if r < h3Epsilon {
+		return h3FaceIJK{face: face}
+	}
+	center := h3FaceCenterGeo[face]
+	theta := h3PosAngle(h3FaceAxesAzRadsCII[face][0] - h3PosAngle(h3Azimuth(center[0], center[1], lat, lng)))
+	if res%2 == 1 {
+		theta = h3PosAngle(theta - h3Ap7RotRads)
+	}
+	r = math.Tan(r) / h3Res0UGnomon
+	for i := 0; i < res; i++ {
+		r *= h3Sqrt7
+	}
+	return h3FaceIJK{face: face, coord: h3Hex2dToIJK(r*math.Cos(theta), r*math.Sin(theta))}
+}
+
+func h3Hex2dToIJK(x, y float64) h3CoordIJK {
+	var h h3CoordIJK
+	a1, a2 := math.Abs(x), math.Abs(y)
+	x2 := a2 / h3Sin60
+	x1 := a1 + x2/2
+	m1, m2 := int(x1), int(x2)
+	r1, r2 := x1-float64(m1), x2-float64(m2)
+
+	if r1 < 0.5 {
+		if r1 < 1.0/3.0 {
+			h.i = m1
+			if r2 < (1+r1)/2 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+		} else {
+			if r2 < 1-r1 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+			if 1-r1 <= r2 && r2 < 2*r1 {
+				h.i = m1 + 1
+			} else {
+				h.i = m1
+			}
+		}
+	} else {
+		if r1 < 2.0/3.0 {
+			if r2 < 1-r1 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+			if 2*r1-1 < r2 && r2 < 1-r1 {
+				h.i = m1
+			} else {
+				h.i = m1 + 1
+			}
+		} else {
+			h.i = m1 + 1
+			if r2 < r1/2 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+		}
+	}
+
+	if x < 0 {
+		if h.j%2 == 0 {
+			h.i -= 2 * (h.i - h.j/2)
+		} else {
+			h.i -= 2*(h.i-(h.j+1)/2) + 1
+		}
+	}
+	if y < 0 {
+		h.i -= (2*h.j + 1) / 2
+		h.j = -h.j
+	}
+	return h.normalize()
+}
+
+func h3IJKToHex2d(h h3CoordIJK) (float64, float64) {
+	i := float64(h.i - h.k)
+	j := float64(h.j - h.k)
+	return i - 0.5*j, j * h3Sin60
+}
+
+func h3Hex2dToGeo(x, y float64, face, res int, substrate bool) (float64, float64) {
+	r := math.Hypot(x, y)
+	if r < h3Epsilon {
+		return h3FaceCenterGeo[face][0], h3FaceCenterGeo[face][1]
+	}
+	theta := math.Atan2(y, x)
+	for i := 0; i < res; i++ {
+		r /= h3Sqrt7
+	}
+	if substrate {
+		r /= 3
+		if res%2 == 1 {
+			r /= h3Sqrt7
+		}
+	}
+	r = math.Atan(r * h3Res0UGnomon)
+	if !substrate && res%2 == 1 {
+		theta = h3PosAngle(theta + h3Ap7RotRads)
+	}
+	theta = h3PosAngle(h3FaceAxesAzRadsCII[face][0] - theta)
+	return h3AzDistance(h3FaceCenterGeo[face][0], h3FaceCenterGeo[face][1], theta, r)
+}
+
+func h3HexagonBoundary(center h3FaceIJK, res int) Polygon {
+	verts, adjRes := h3FaceIJKToVerts(center, res, false)
+	out := Polygon{}
+	lastFace, lastOverage := -1, 0
+	for vert := 0; vert < 7; vert++ {
+		v := vert % 6
+		fijk := verts[v]
+		overage := h3AdjustOverageClassII(&fijk, adjRes, false, true)
+
+		// A Class III edge crossing an icosahedron edge needs an extra vertex
+		// where it crosses, so each half can be projected on its own face.
+		if res%2 == 1 && vert > 0 && fijk.face != lastFace && lastOverage != h3FaceEdge {
+			x0, y0 := h3IJKToHex2d(verts[(v+5)%6].coord)
+			x1, y1 := h3IJKToHex2d(verts[v].coord)
+			face2 := lastFace
+			if lastFace == center.face {
+				face2 = fijk.face
+			}
+			ex0, ey0, ex1, ey1 := h3FaceEdgeVerts(h3AdjacentFaceDir[center.face][face2], adjRes)
+			ix, iy := h3Intersect(x0, y0, x1, y1, ex0, ey0, ex1, ey1)
+			atVertex := (math.Abs(x0-ix) < h3FltEpsilon && math.Abs(y0-iy) < h3FltEpsilon) ||
+				(math.Abs(x1-ix) < h3FltEpsilon && math.Abs(y1-iy) < h3FltEpsilon)
+			if !atVertex {
+				out = append(out, h3ToLatLng(h3Hex2dToGeo(ix, iy, center.face, adjRes, true)))
+			}
+		}
+		if vert < 6 {
+			x, y := h3IJKToHex2d(fijk.coord)
+			out = append(out, h3ToLatLng(h3Hex2dToGeo(x, y, fijk.face, adjRes, true)))
+		}
+		lastFace, lastOverage = fijk.face, overage
+	}
+	return out
+}
+
+func h3PentagonBoundary(center h3FaceIJK, res int) Polygon {
+	verts, adjRes := h3FaceIJKToVerts(center, res, true)
+	out := Polygon{}
+	var last h3FaceIJK
+	for vert := 0; vert < 6; vert++ {
+		fijk := verts[vert%5]
+		for h3AdjustOverageClassII(&fijk, adjRes, false, true) == h3NewFace {
+		}
+
+		// All Class III pentagon edges cross icosahedron edges.
+		if res%2 == 1 && vert > 0 {
+			x0, y0 := h3IJKToHex2d(last.coord)
+			orient := h3FaceNeighbors[fijk.face][h3AdjacentFaceDir[fijk.face][last.face]]
+			tmp := h3FaceIJK{face: orient.face, coord: fijk.coord}
+			for i := 0; i < orient.ccwRot60; i++ {
+				tmp.coord = tmp.coord.rotate60ccw()
+			}
+			tmp.coord = tmp.coord.add(orient.translate.scale(h3UnitScaleByCIIRes[adjRes] * 3)).normalize()
+			x1, y1 := h3IJKToHex2d(tmp.coord)
+			ex0, ey0, ex1, ey1 := h3FaceEdgeVerts(h3AdjacentFaceDir[tmp.face][fijk.face], adjRes)
+			ix, iy := h3Intersect(x0, y0, x1, y1, ex0, ey0, ex1, ey1)
+			out = append(out, h3ToLatLng(h3Hex2dToGeo(ix, iy, tmp.face, adjRes, true)))
+		}
+		if vert < 5 {
+			x, y := h3IJKToHex2d(fijk.coord)
+			out = append(out, h3ToLatLng(h3Hex2dToGeo(x, y, fijk.face, adjRes, true)))
+		}
+		last = fijk
+	}
+	return out
+}
+
+// h3FaceIJKToVerts returns the substrate grid vertices of the cell centred
+// on fijk, along with the Class II resolution they are expressed in.
+func h3FaceIJKToVerts(fijk h3FaceIJK, res int, pentagon bool) ([]h3FaceIJK, int) {
+	vertsCII := []h3CoordIJK{{2, 1, 0}, {1, 2, 0}, {0, 2, 1}, {0, 1, 2}, {1, 0, 2}, {2, 0, 1}}
+	vertsCIII := []h3CoordIJK{{5, 4, 0}, {1, 5, 0}, {0, 5, 4}, {0, 1, 5}, {4, 0, 5}, {5, 0, 1}}
+	verts := vertsCII
+	if res%2 == 1 {
+		verts = vertsCIII
+	}
+	if pentagon {
+		verts = verts[:5]
+	}
+	c := fijk.coord.downAp3().downAp3r()
+	if res%2 == 1 {
+		c = c.downAp7r()
+		res++
+	}
+	out := make([]h3FaceIJK, len(verts))
+	for v := range verts {
+		out[v] = h3FaceIJK{face: fijk.face, coord: c.add(verts[v]).normalize()}
+	}
+	return out, res
+}
+
+func h3FaceEdgeVerts(dir, res int) (x0, y0, x1, y1 float64) {
+	maxDim := float64(h3MaxDimByCIIRes[res])
+	v := [3][2]float64{
+		{3 * maxDim, 0},
+		{-1.5 * maxDim, 3 * h3Sin60 * maxDim},
+		{-1.5 * maxDim, -3 * h3Sin60 * maxDim},
+	}
+	switch dir {
+	case h3QuadrantIJ:
+		return v[0][0], v[0][1], v[1][0], v[1][1]
+	case h3QuadrantJK:
+		return v[1][0], v[1][1], v[2][0], v[2][1]
+	}
+	return v[2][0], v[2][1], v[0][0], v[0][1]
+}
+
+// h3AdjustOverageClassII moves fijk onto the neighboring face if its
+// coordinates fall beyond the edge of their current face.
+func h3AdjustOverageClassII(fijk *h3FaceIJK, res int, pentLeading4, substrate bool) int {
+	overage := 0
+	ijk := &fijk.coord
+	maxDim := h3MaxDimByCIIRes[res]
+	if substrate {
+		maxDim *= 3
+	}
+	sum := ijk.i + ijk.j + ijk.k
+	if substrate && sum == maxDim {
+		return h3FaceEdge
+	}
+	if sum > maxDim {
+		overage = h3NewFace
+		var orient h3FaceOrientIJK
+		if ijk.k > 0 {
+			if ijk.j > 0 {
+				orient = h3FaceNeighbors[fijk.face][h3QuadrantJK]
+			} else {
+				orient = h3FaceNeighbors[fijk.face][h3QuadrantKI]
+				if pentLeading4 {
+					// Adjust for the pentagonal missing sequence.
+					origin := h3CoordIJK{maxDim, 0, 0}
+					*ijk = ijk.sub(origin).rotate60cw().add(origin)
+				}
+			}
+		} else {
+			orient = h3FaceNeighbors[fijk.face][h3QuadrantIJ]
+		}
+		fijk.face = orient.face
+		for i := 0; i < orient.ccwRot60; i++ {
+			*ijk = ijk.rotate60ccw()
+		}
+		unitScale := h3UnitScaleByCIIRes[res]
+		if substrate {
+			unitScale *= 3
+		}
+		*ijk = ijk.add(orient.translate.scale(unitScale)).normalize()
+		if substrate && ijk.i+ijk.j+ijk.k == maxDim {
+			overage = h3FaceEdge
+		}
+	}
+	return overage
+}
+
+func (c h3CoordIJK) add(o h3CoordIJK) h3CoordIJK {
+	return h3CoordIJK{c.i + o.i, c.j + o.j, c.k + o.k}
+}
+
+func (c h3CoordIJK) sub(o h3CoordIJK) h3CoordIJK {
+	return h3CoordIJK{c.i - o.i, c.j - o.j, c.k - o.k}
+}
+
+func (c h3CoordIJK) scale(f int) h3CoordIJK {
+	return h3CoordIJK{c.i * f, c.j * f, c.k * f}
+}
+
+func (c h3CoordIJK) normalize() h3CoordIJK {
+	if c.i < 0 {
+		c.j -= c.i
+		c.k -= c.i
+		c.i = 0
+	}
+	if c.j < 0 {
+		c.i -= c.j
+		c.k -= c.j
+		c.j = 0
+	}
+	if c.k < 0 {
+		c.i -= c.k
+		c.j -= c.k
+		c.k = 0
+	}
+	min := c.i
+	if c.j < min {
+		min = c.j
+	}
+	if c.k < min {
+		min = c.k
+	}
+	if min > 0 {
+		c.i -= min
+		c.j -= min
+		c.k -= min
+	}
+	return c
+}
+
+func (c h3CoordIJK) unitDigit() int {
+	c = c.normalize()
+	for d := h3Center; d < h3InvalidDigit; d++ {
+		if c == h3UnitVecs[d] {
+			return d
+		}
+	}
+	return h3InvalidDigit
+}
+
+func (c h3CoordIJK) neighbor(digit int) h3CoordIJK {
+	if digit > h3Center && digit < h3InvalidDigit {
+		return c.add(h3UnitVecs[digit]).normalize()
+	}
+	return c
+}
+
+// combine returns i*iVec + j*jVec + k*kVec, normalized.
+func (c h3CoordIJK) combine(iVec, jVec, kVec h3CoordIJK) h3CoordIJK {
+	return iVec.scale(c.i).add(jVec.scale(c.j)).add(kVec.scale(c.k)).normalize()
+}
+
+func (c h3CoordIJK) upAp7() h3CoordIJK {
+	i, j := float64(c.i-c.k), float64(c.j-c.k)
+	return h3CoordIJK{int(math.Round((3*i - j) / 7)), int(math.Round((i + 2*j) / 7)), 0}.normalize()
+}
+
+func (c h3CoordIJK) upAp7r() h3CoordIJK {
+	i, j := float64(c.i-c.k), float64(c.j-c.k)
+	return h3CoordIJK{int(math.Round((2*i + j) / 7)), int(math.Round((3*j - i) / 7)), 0}.normalize()
+}
+
+func (c h3CoordIJK) downAp7() h3CoordIJK {
+	return c.combine(h3CoordIJK{3, 0, 1}, h3CoordIJK{1, 3, 0}, h3CoordIJK{0, 1, 3})
+}
+
+func (c h3CoordIJK) downAp7r() h3CoordIJK {
+	return c.combine(h3CoordIJK{3, 1, 0}, h3CoordIJK{0, 3, 1}, h3CoordIJK{1, 0, 3})
+}
+
+func (c h3CoordIJK) downAp3() h3CoordIJK {
+	return c.combine(h3CoordIJK{2, 0, 1}, h3CoordIJK{1, 2, 0}, h3CoordIJK{0, 1, 2})
+}
+
+func (c h3CoordIJK) downAp3r() h3CoordIJK {
+	return c.combine(h3CoordIJK{2, 1, 0}, h3CoordIJK{0, 2, 1}, h3CoordIJK{1, 0, 2})
+}
+
+func (c h3CoordIJK) rotate60ccw() h3CoordIJK {
+	return c.combine(h3CoordIJK{1, 1, 0}, h3CoordIJK{0, 1, 1}, h3CoordIJK{1, 0, 1})
+}
+
+func (c h3CoordIJK) rotate60cw() h3CoordIJK {
+	return c.combine(h3CoordIJK{1, 0, 1}, h3CoordIJK{1, 1, 0}, h3CoordIJK{0, 1, 1})
+}
+
+func h3Rotate60ccw(digit int) int {
+	switch digit {
+	case h3K:
+		return h3IK
+	case h3IK:
+		return h3I
+	case h3I:
+		return h3IJ
+	case h3IJ:
+		return h3J
+	case h3J:
+		return h3JK
+	case h3JK:
+		return h3K
+	}
+	return digit
+}
+
+func h3Rotate60cw(digit int) int {
+	switch digit {
+	case h3K:
+		return h3JK
+	case h3JK:
+		return h3J
+	case h3J:
+		return h3IJ
+	case h3IJ:
+		return h3I
+	case h3I:
+		return h3IK
+	case h3IK:
+		return h3K
+	}
+	return digit
+}
+
+func h3PosAngle(rads float64) float64 {
+	tmp := rads
+	if rads < 0 {
+		tmp = rads + 2*math.Pi
+	}
+	if rads >= 2*math.Pi {
+		tmp -= 2 * math.Pi
+	}
+	return tmp
+}
+
+func h3Azimuth(lat1, lng1, lat2, lng2 float64) float64 {
+	return math.Atan2(math.Cos(lat2)*math.Sin(lng2-lng1),
+		math.Cos(lat1)*math.Sin(lat2)-math.Sin(lat1)*math.Cos(lat2)*math.Cos(lng2-lng1))
+}
+
+func h3AzDistance(lat1, lng1, az, distance float64) (float64, float64) {
+	if distance < h3Epsilon {
+		return lat1, lng1
+	}
+	az = h3PosAngle(az)
+	var lat, lng float64
+	if az < h3Epsilon || math.Abs(az-math.Pi) < h3Epsilon {
+		if az < h3Epsilon {
+			lat = lat1 + distance
+		} else {
+			lat = lat1 - distance
+		}
+		if math.Abs(lat-math.Pi/2) < h3Epsilon {
+			return math.Pi / 2, 0
+		} else if math.Abs(lat+math.Pi/2) < h3Epsilon {
+			return -math.Pi / 2, 0
+		}
+		return lat, h3ConstrainLng(lng1)
+	}
+	sinlat := math.Max(-1, math.Min(1, math.Sin(lat1)*math.Cos(distance)+math.Cos(lat1)*math.Sin(distance)*math.Cos(az)))
+	lat = math.Asin(sinlat)
+	if math.Abs(lat-math.Pi/2) < h3Epsilon {
+		return math.Pi / 2, 0
+	} else if math.Abs(lat+math.Pi/2) < h3Epsilon {
+		return -math.Pi / 2, 0
+	}
+	sinlng := math.Max(-1, math.Min(1, math.Sin(az)*math.Sin(distance)/math.Cos(lat)))
+	coslng := math.Max(-1, math.Min(1, (math.Cos(distance)-math.Sin(lat1)*math.Sin(lat))/math.Cos(lat1)/math.Cos(lat)))
+	lng = h3ConstrainLng(lng1 + math.Atan2(sinlng, coslng))
+	return lat, lng
+}
+
+func h3ConstrainLng(lng float64) float64 {
+	for lng > math.Pi {
+		lng -= 2 * math.Pi
+	}
+	for lng < -math.Pi {
+		lng += 2 * math.Pi
+	}
+	return lng
+}
+
+func h3Intersect(x0, y0, x1, y1, x2, y2, x3, y3 float64) (float64, float64) {
+	s1x, s1y := x1-x0, y1-y0
+	s2x, s2y := x3-x2, y3-y2
+	t := (s2x*(y0-y2) - s2y*(x0-x2)) / (-s2x*s1y + s1x*s2y)
+	return x0 + t*s1x, y0 + t*s1y
+}
+
+func h3ToLatLng(lat, lng float64) LatLng {
+	return LatLng{Lat: toDegrees(lat), Lng: toDegrees(lng)}
+}
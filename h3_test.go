@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestH3CellAt(t *testing.T) {
+	tests := []struct {
+		LatLng   LatLng
+		Res      int
+		Cell     string
+		Center   LatLng
+		Vertices int
+	}{
+		{LatLng{40.7453721, -74.0078293}, 9, "892a1072187ffff", LatLng{40.74406, -74.00690}, 6},
+		{LatLng{-33.86, 151.2}, 5, "85be0e37fffffff", LatLng{-33.90159, 151.22348}, 6},
+		{LatLng{37.3615593, -122.0553238}, 0, "8029fffffffffff", LatLng{40.13172, -124.76073}, 6},
+		{LatLng{64.7, 10.536}, 7, "870800000ffffff", LatLng{64.70000, 10.53620}, 10},
+	}
+	for _, test := range tests {
+		c := H3CellAt(test.LatLng, test.Res)
+		if c.String() != test.Cell {
+			t.Errorf("Expected: %s, Got: %s", test.Cell, c)
+		}
+		if c.Resolution() != test.Res || !c.IsValid() {
+			t.Errorf("Expected valid cell at resolution %d, Got: %d", test.Res, c.Resolution())
+		}
+		center := c.Center()
+		if math.Abs(center.Lat-test.Center.Lat) > 1e-5 || math.Abs(center.Lng-test.Center.Lng) > 1e-5 {
+			t.Errorf("Expected: %v, Got: %v", test.Center, center)
+		}
+		if b := c.Boundary(); len(b) != test.Vertices {
+			t.Errorf("Expected: %d vertices, Got: %d", test.Vertices, len(b))
+		}
+		if parsed, err := ParseH3Cell(test.Cell); err != nil || parsed != c {
+			t.Errorf("Expected %s to parse, Got: %v, %v", test.Cell, parsed, err)
+		}
+	}
+	if _, err := ParseH3Cell("8029ffffffffff0"); err != InvalidH3CellError {
+		t.Errorf("Expected: %v, Got: %v", InvalidH3CellError, err)
+	}
+}
+
+func TestH3KRing(t *testing.T) {
+	c := H3CellAt(LatLng{40.7453721, -74.0078293}, 9)
+	ring := c.KRing(1)
+	if len(ring) != 7 || ring[0] != c {
+		t.Fatalf("Expected origin and 6 neighbors, Got: %v", ring)
+	}
+	for _, n := range ring[1:] {
+		if d := c.Center().DistanceTo(n.Center()); d > 400 {
+			t.Errorf("Expected neighbor %s to be adjacent, Got: %fm away", n, d)
+		}
+	}
+	if got := len(c.KRing(2)); got != 19 {
+		t.Errorf("Expected: 19, Got: %d", got)
+	}
+
+	pentagon, _ := ParseH3Cell("830800fffffffff")
+	if !pentagon.IsPentagon() {
+		t.Errorf("Expected %s to be a pentagon", pentagon)
+	}
+	if got := len(pentagon.KRing(1)); got != 6 {
+		t.Errorf("Expected: 6, Got: %d", got)
+	}
+	if got := len(pentagon.KRing(2)); got != 16 {
+		t.Errorf("Expected: 16, Got: %d", got)
+	}
+	if p := pentagon.Parent(0); p.String() != "8009fffffffffff" {
+		t.Errorf("Expected: 8009fffffffffff, Got: %s", p)
+	}
+}
@@ -0,0 +1,126 @@
+package geo
+
+import "sort"
+
+type (
+	// HeatmapGrid divides the world into cells named by strings: quadkeys,
+	// geohashes or H3 indexes.
+	HeatmapGrid interface {
+		Cell(ll LatLng) string
+		Bounds(cell string) BoundingBox
+		Center(cell string) LatLng
+	}
+
+	// HeatmapCell is the total of the points binned into one cell.
+	HeatmapCell struct {
+		Cell   string
+		Bounds BoundingBox
+		Center LatLng
+		Count  int
+		Weight float64
+	}
+
+	// Heatmap bins points into the cells of Grid as they arrive, so that
+	// datasets too large to hold can be aggregated in one pass. It is not
+	// safe for concurrent use.
+	Heatmap struct {
+		Grid  HeatmapGrid
+		cells map[string]*HeatmapCell
+	}
+
+	tileGrid    int
+	geohashGrid int
+	h3Grid      int
+)
+
+// TileGrid bins points into slippy-map tiles at zoom level z, named by
+// quadkey, for drawing tile overlays.
+func TileGrid(z int) HeatmapGrid { return tileGrid(z) }
+
+// GeohashGrid bins points into geohash cells of length precision.
+func GeohashGrid(precision int) HeatmapGrid { return geohashGrid(precision) }
+
+// H3Grid bins points into H3 cells at resolution res, whose near-equal
+// areas make counts comparable from cell to cell.
+func H3Grid(res int) HeatmapGrid { return h3Grid(res) }
+
+func (z tileGrid) Cell(ll LatLng) string { return QuadkeyAt(ll, int(z)) }
+
+func (z tileGrid) Bounds(cell string) BoundingBox {
+	t, _ := ParseQuadkey(cell)
+	return t.Bounds()
+}
+
+func (z tileGrid) Center(cell string) LatLng { return z.Bounds(cell).Center() }
+
+func (p geohashGrid) Cell(ll LatLng) string { return GeohashAt(ll, int(p)) }
+
+func (p geohashGrid) Bounds(cell string) BoundingBox {
+	b, _ := GeohashBounds(cell)
+	return b
+}
+
+func (p geohashGrid) Center(cell string) LatLng { return p.Bounds(cell).Center() }
+
+func (r h3Grid) Cell(ll LatLng) string { return H3CellAt(ll, int(r)).String() }
+
+func (r h3Grid) Bounds(cell string) BoundingBox {
+	c, _ := ParseH3Cell(cell)
+	return c.Boundary().Bounds()
+}
+
+func (r h3Grid) Center(cell string) LatLng {
+	c, _ := ParseH3Cell(cell)
+	return c.Center()
+}
+
+// NewHeatmap returns an empty Heatmap over g.
+func NewHeatmap(g HeatmapGrid) *Heatmap {
+	return &Heatmap{Grid: g, cells: map[string]*HeatmapCell{}}
+}
+
+// Add bins ll with the given weight; use 1 to count points.
+func (h *Heatmap) Add(ll LatLng, weight float64) {
+	if h.cells == nil {
+		h.cells = map[string]*HeatmapCell{}
+	}
+	name := h.Grid.Cell(ll)
+	c, ok := h.cells[name]
+	if !ok {
+		c = &HeatmapCell{Cell: name}
+		h.cells[name] = c
+	}
+	c.Count++
+	c.Weight += weight
+}
+
+// Len returns the number of non-empty cells.
+func (h *Heatmap) Len() int {
+	return len(h.cells)
+}
+
+// Cells returns the non-empty cells, heaviest first and then by name.
+func (h *Heatmap) Cells() []HeatmapCell {
+	cells := make([]HeatmapCell, 0, len(h.cells))
+	for _, c := range h.cells {
+		cell := *c
+		cell.Bounds, cell.Center = h.Grid.Bounds(c.Cell), h.Grid.Center(c.Cell)
+		cells = append(cells, cell)
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Weight != cells[j].Weight {
+			return cells[i].Weight > cells[j].Weight
+		}
+		return cells[i].Cell < cells[j].Cell
+	})
+	return cells
+}
+
+// BinPoints counts points per cell of g.
+func BinPoints(points []LatLng, g HeatmapGrid) []HeatmapCell {
+	h := NewHeatmap(g)
+	for _, p := range points {
+		h.Add(p, 1)
+	}
+	return h.Cells()
+}
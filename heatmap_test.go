@@ -0,0 +1,34 @@
+package geo
+
+import "testing"
+
+func TestHeatmap(t *testing.T) {
+	ottawa, toronto := LatLng{45.4215, -75.6972}, LatLng{43.6532, -79.3832}
+	points := []LatLng{ottawa, toronto, {45.4216, -75.6971}, {43.6533, -79.3833}, {45.4214, -75.6973}}
+	for _, g := range []HeatmapGrid{TileGrid(12), GeohashGrid(5), H3Grid(7)} {
+		cells := BinPoints(points, g)
+		if len(cells) != 2 {
+			t.Fatalf("%T: Expected: 2 cells, Got: %+v", g, cells)
+		}
+		if cells[0].Count != 3 || cells[0].Weight != 3 || cells[1].Count != 2 {
+			t.Errorf("%T: Unexpected counts: %+v", g, cells)
+		}
+		if !cells[0].Bounds.Contains(ottawa) || !cells[1].Bounds.Contains(toronto) {
+			t.Errorf("%T: Expected: cells to contain their points, Got: %+v", g, cells)
+		}
+		if d := cells[0].Center.DistanceTo(ottawa); d > 10000 {
+			t.Errorf("%T: Expected: the centre near Ottawa, Got: %.0fm away", g, d)
+		}
+	}
+}
+
+func TestHeatmapWeights(t *testing.T) {
+	h := NewHeatmap(GeohashGrid(4))
+	h.Add(LatLng{45.4215, -75.6972}, 1)
+	h.Add(LatLng{43.6532, -79.3832}, 2.5)
+	h.Add(LatLng{43.6532, -79.3832}, 0.5)
+	cells := h.Cells()
+	if h.Len() != 2 || cells[0].Weight != 3 || cells[0].Count != 2 || cells[0].Cell != GeohashAt(LatLng{43.6532, -79.3832}, 4) {
+		t.Errorf("Unexpected cells: %+v", cells)
+	}
+}
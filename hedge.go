@@ -0,0 +1,81 @@
+package geo
+
+import (
+	"context"
+	"time"
+)
+
+// HedgedGeocoder sends each request to Primary and, if it hasn't answered
+// within Delay, to Secondary as well, returning whichever succeeds first. A
+// Primary failure starts Secondary immediately. The slower request is
+// canceled. If both fail, Primary's error is returned.
+type HedgedGeocoder struct {
+	Primary   Geocoder
+	Secondary Geocoder
+	Delay     time.Duration
+}
+
+type hedgeResult struct {
+	addr      *Address
+	err       error
+	secondary bool
+}
+
+// Geocode geocodes q with hedging.
+func (h *HedgedGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	return h.hedge(ctx, func(ctx context.Context, g Geocoder) (*Address, error) {
+		return g.Geocode(ctx, q, opts...)
+	})
+}
+
+// ReverseGeocode reverse geocodes ll with hedging.
+func (h *HedgedGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	return h.hedge(ctx, func(ctx context.Context, g Geocoder) (*Address, error) {
+		return g.ReverseGeocode(ctx, ll, opts...)
+	})
+}
+
+func (h *HedgedGeocoder) hedge(ctx context.Context, call func(context.Context, Geocoder) (*Address, error)) (*Address, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan hedgeResult, 2)
+	run := func(g Geocoder, secondary bool) {
+		addr, err := call(ctx, g)
+		results <- hedgeResult{addr, err, secondary}
+	}
+	go run(h.Primary, false)
+
+	timer := time.NewTimer(h.Delay)
+	defer timer.Stop()
+	started, pending := false, 1
+	var primaryErr, secondaryErr error
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if !started {
+				started, pending = true, pending+1
+				go run(h.Secondary, true)
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				return r.addr, nil
+			}
+			if r.secondary {
+				secondaryErr = r.err
+			} else {
+				primaryErr = r.err
+				if !started {
+					started, pending = true, pending+1
+					go run(h.Secondary, true)
+				}
+			}
+		}
+	}
+	if primaryErr != nil {
+		return nil, primaryErr
+	}
+	return nil, secondaryErr
+}
+
+var _ Geocoder = (*HedgedGeocoder)(nil)
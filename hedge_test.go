@@ -0,0 +1,76 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// funcGeocoder adapts a function to Geocoder for tests.
+type funcGeocoder func(ctx context.Context, q string) (*Address, error)
+
+func (f funcGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	return f(ctx, q)
+}
+
+func (f funcGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	return f(ctx, latLngParam(ll))
+}
+
+func slowGeocoder(name string, d time.Duration, err error) funcGeocoder {
+	return func(ctx context.Context, q string) (*Address, error) {
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &Address{Address: name}, nil
+	}
+}
+
+func TestHedgedGeocoder(t *testing.T) {
+	ctx := context.Background()
+	primaryErr := errors.New("primary down")
+	tests := []struct {
+		name      string
+		primary   funcGeocoder
+		secondary funcGeocoder
+		expected  string
+		err       error
+	}{
+		{"fast primary", slowGeocoder("primary", 0, nil), slowGeocoder("secondary", 0, nil), "primary", nil},
+		{"slow primary", slowGeocoder("primary", time.Second, nil), slowGeocoder("secondary", 0, nil), "secondary", nil},
+		{"failed primary", slowGeocoder("primary", 0, primaryErr), slowGeocoder("secondary", 0, nil), "secondary", nil},
+		{"both fail", slowGeocoder("primary", 0, primaryErr), slowGeocoder("secondary", 0, errors.New("x")), "", primaryErr},
+	}
+	for _, test := range tests {
+		h := &HedgedGeocoder{Primary: test.primary, Secondary: test.secondary, Delay: 20 * time.Millisecond}
+		addr, err := h.Geocode(ctx, "q")
+		if err != test.err {
+			t.Errorf("%s: Expected: %v, Got: %v", test.name, test.err, err)
+			continue
+		}
+		if addr != nil && addr.Address != test.expected {
+			t.Errorf("%s: Expected: %s, Got: %s", test.name, test.expected, addr.Address)
+		}
+	}
+}
+
+func TestHedgedGeocoderSkipsSecondary(t *testing.T) {
+	called := false
+	secondary := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		called = true
+		return &Address{}, nil
+	})
+	h := &HedgedGeocoder{Primary: slowGeocoder("primary", 0, nil), Secondary: secondary, Delay: time.Second}
+	if _, err := h.ReverseGeocode(context.Background(), LatLng{}); err != nil {
+		t.Fatal(err)
+	}
+	if called {
+		t.Errorf("Expected the secondary not to be called when the primary answers in time")
+	}
+}
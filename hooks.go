@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type (
+	// Hooks are callbacks a Client makes as its requests progress, for
+	// emitting metrics and logs or reacting to them, e.g. alerting when the
+	// fallback is used too often. Any of them may be nil. They are called
+	// synchronously from the goroutine making the request, so they should
+	// be quick and safe for concurrent use.
+	Hooks struct {
+		OnCacheHit    func(CacheEvent)
+		OnCacheMiss   func(CacheEvent)
+		OnRateLimited func(RateLimitEvent)
+		OnRetry       func(RetryEvent)
+		OnFallback    func(FallbackEvent)
+	}
+
+	// CacheEvent describes a cache lookup. Kind is "geocode" or "reverse".
+	// Stale is set on a hit served past its TTL while it is refreshed.
+	CacheEvent struct {
+		Kind  string
+		Key   string
+		Stale bool
+	}
+
+	// RateLimitEvent describes a request held back, either by the Client's
+	// Limiter for Wait or by the API answering that its quota was exceeded,
+	// in which case Err is that answer and Wait any Retry-After it gave.
+	RateLimitEvent struct {
+		API  string
+		Wait time.Duration
+		Err  error
+	}
+
+	// RetryEvent describes a retry about to be made: Attempt is its number,
+	// from 1, Delay how long the Client waits first, and Err the failure
+	// being retried.
+	RetryEvent struct {
+		API     string
+		Attempt int
+		Delay   time.Duration
+		Err     error
+	}
+
+	// FallbackEvent describes a request handed to the Client's Fallback
+	// after failing with Err. Kind is "geocode" or "reverse".
+	FallbackEvent struct {
+		Kind string
+		Err  error
+	}
+)
+
+// WithHooks makes the Client call h as its requests progress.
+func WithHooks(h Hooks) ClientOption {
+	return func(c *Client) {
+		c.Hooks = h
+	}
+}
+
+// WithFallback makes the Client answer Geocode and ReverseGeocode with g
+// when its own request fails, except for invalid input and a done
+// context.
+func WithFallback(g Geocoder) ClientOption {
+	return func(c *Client) {
+		c.Fallback = g
+	}
+}
+
+// useFallback reports whether a request that failed with err should be
+// handed to the Fallback, announcing it if so.
+func (c *Client) useFallback(ctx context.Context, kind string, err error) bool {
+	if err == nil || c.Fallback == nil || ctx.Err() != nil {
+		return false
+	}
+	var ie *InvalidInputError
+	if errors.As(err, &ie) {
+		return false
+	}
+	if c.Hooks.OnFallback != nil {
+		c.Hooks.OnFallback(FallbackEvent{Kind: kind, Err: err})
+	}
+	return true
+}
+
+// throttled reports upstream throttling in the result of an attempt at a
+// request to path.
+func (c *Client) throttled(path string, b []byte, err error) {
+	if err == nil && responseStatus(b) != StatusOverQueryLimit {
+		return
+	}
+	if err == nil {
+		err = &GeocoderError{Status: StatusOverQueryLimit}
+	} else if !isThrottled(err) {
+		return
+	}
+	ev := RateLimitEvent{API: apiName(path), Err: err}
+	if he, ok := err.(*HTTPError); ok {
+		ev.Wait = he.RetryAfter
+	}
+	c.Hooks.OnRateLimited(ev)
+}
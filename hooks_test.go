@@ -0,0 +1,100 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// hookRecorder collects the events a Client reports.
+type hookRecorder struct {
+	mu        sync.Mutex
+	hits      []CacheEvent
+	misses    []CacheEvent
+	limited   []RateLimitEvent
+	retries   []RetryEvent
+	fallbacks []FallbackEvent
+}
+
+func (r *hookRecorder) hooks() Hooks {
+	record := func(f func()) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		f()
+	}
+	return Hooks{
+		OnCacheHit:    func(e CacheEvent) { record(func() { r.hits = append(r.hits, e) }) },
+		OnCacheMiss:   func(e CacheEvent) { record(func() { r.misses = append(r.misses, e) }) },
+		OnRateLimited: func(e RateLimitEvent) { record(func() { r.limited = append(r.limited, e) }) },
+		OnRetry:       func(e RetryEvent) { record(func() { r.retries = append(r.retries, e) }) },
+		OnFallback:    func(e FallbackEvent) { record(func() { r.fallbacks = append(r.fallbacks, e) }) },
+	}
+}
+
+func TestClientHooks(t *testing.T) {
+	n := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if n++; n == 1 {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"status": "OK", "results": [{"formatted_address": "x"}]}`))
+	}))
+	defer srv.Close()
+	var r hookRecorder
+	c := NewClient("k", WithBaseURL(srv.URL), WithCache(NewCache(time.Hour, 0)), WithRetry(RetryPolicy{MaxRetries: 1, MaxDelay: time.Millisecond}), WithHooks(r.hooks()))
+	for i := 0; i < 2; i++ {
+		if _, err := c.Geocode(context.Background(), "1 Main St"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	key := GeocodeCacheKey(ProviderGoogle, "1 Main St")
+	if len(r.misses) != 1 || r.misses[0].Key != key || r.misses[0].Kind != "geocode" {
+		t.Errorf("Expected: one miss for %s, Got: %+v", key, r.misses)
+	}
+	if len(r.hits) != 1 || r.hits[0].Key != key || r.hits[0].Stale {
+		t.Errorf("Expected: one fresh hit, Got: %+v", r.hits)
+	}
+	if len(r.limited) != 1 || r.limited[0].API != "geocode" || r.limited[0].Wait != 5*time.Second || r.limited[0].Err == nil {
+		t.Errorf("Expected: the 429 reported, Got: %+v", r.limited)
+	}
+	if len(r.retries) != 1 || r.retries[0].Attempt != 1 || r.retries[0].Delay != time.Millisecond {
+		t.Errorf("Expected: one retry after 1ms, Got: %+v", r.retries)
+	}
+}
+
+func TestClientFallback(t *testing.T) {
+	c, _ := newTestClient(t, `{"status": "OVER_QUERY_LIMIT"}`)
+	var r hookRecorder
+	WithHooks(r.hooks())(c)
+	WithFallback(fixedGeocoder("fallback", LatLng{1, 2}))(c)
+	WithRateLimit(20, 1)(c)
+	ctx := context.Background()
+	if a, err := c.Geocode(ctx, "1 Main St"); err != nil || a.Address != "fallback" {
+		t.Fatalf("Expected: the fallback's answer, Got: %v, %v", a, err)
+	}
+	if a, err := c.ReverseGeocode(ctx, LatLng{1, 2}); err != nil || a.Address != "fallback" {
+		t.Fatalf("Expected: the fallback's answer, Got: %v, %v", a, err)
+	}
+	if len(r.fallbacks) != 2 || r.fallbacks[0].Kind != "geocode" || r.fallbacks[1].Kind != "reverse" {
+		t.Errorf("Expected: two fallbacks, Got: %+v", r.fallbacks)
+	}
+	throttled, waited := 0, 0
+	for _, e := range r.limited {
+		if e.Err != nil {
+			throttled++
+		} else if e.Wait > 0 {
+			waited++
+		}
+	}
+	if throttled != 2 || waited != 1 {
+		t.Errorf("Expected: 2 throttled responses and a wait for the limiter, Got: %+v", r.limited)
+	}
+	if _, err := c.Geocode(ctx, ""); err == nil || len(r.fallbacks) != 2 {
+		t.Errorf("Expected: invalid input not to fall back, Got: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package geo
+
+import "sort"
+
+// ConvexHull returns the convex hull of points as a counter-clockwise Polygon,
+// computed in the same planar lat/lng space as Polygon.Contains. Collinear
+// points are dropped. Fewer than three distinct points yield the distinct
+// points themselves.
+func ConvexHull(points []LatLng) Polygon {
+	pts := make([]LatLng, len(points))
+	copy(pts, points)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].Lng != pts[j].Lng {
+			return pts[i].Lng < pts[j].Lng
+		}
+		return pts[i].Lat < pts[j].Lat
+	})
+	uniq := pts[:0]
+	for i, p := range pts {
+		if i == 0 || p != pts[i-1] {
+			uniq = append(uniq, p)
+		}
+	}
+	pts = uniq
+	if len(pts) < 3 {
+		return Polygon(pts)
+	}
+
+	// Andrew's monotone chain, with Lng as x and Lat as y.
+	hull := make([]LatLng, 0, 2*len(pts))
+	for _, p := range pts {
+		for len(hull) >= 2 && hullCross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(pts) - 2; i >= 0; i-- {
+		p := pts[i]
+		for len(hull) >= lower && hullCross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return Polygon(hull[:len(hull)-1])
+}
+
+func hullCross(o, a, b LatLng) float64 {
+	return (a.Lng-o.Lng)*(b.Lat-o.Lat) - (a.Lat-o.Lat)*(b.Lng-o.Lng)
+}
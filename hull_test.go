@@ -0,0 +1,26 @@
+package geo
+
+import "testing"
+
+func TestConvexHull(t *testing.T) {
+	points := []LatLng{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {1, 1}, {0, 1}, {0.5, 1.5}, {2, 2}}
+	hull := ConvexHull(points)
+	if len(hull) != 4 {
+		t.Fatalf("Expected: 4 vertices, Got: %v", hull)
+	}
+	for _, p := range points {
+		if p == (LatLng{1, 1}) || p == (LatLng{0.5, 1.5}) {
+			if !hull.Contains(p) {
+				t.Errorf("Expected hull to contain %v", p)
+			}
+		}
+	}
+	for _, v := range hull {
+		if v == (LatLng{1, 1}) || v == (LatLng{0, 1}) {
+			t.Errorf("Unexpected hull vertex: %v", v)
+		}
+	}
+	if got := ConvexHull([]LatLng{{1, 1}, {1, 1}}); len(got) != 1 {
+		t.Errorf("Expected: 1 point, Got: %v", got)
+	}
+}
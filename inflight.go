@@ -0,0 +1,95 @@
+package geo
+
+import (
+	"context"
+	"sync"
+)
+
+// InFlightLimiter caps the requests under way at once to each host and with
+// each API key, independently of any rate limit, so that large batches don't
+// open hundreds of simultaneous connections to one provider. A zero PerHost
+// or PerKey leaves that dimension unlimited. It is safe for concurrent use.
+type InFlightLimiter struct {
+	PerHost int
+	PerKey  int
+
+	mu    sync.Mutex
+	hosts map[string]chan struct{}
+	keys  map[string]chan struct{}
+}
+
+// NewInFlightLimiter returns an InFlightLimiter allowing perHost requests to
+// each host and perKey requests with each key at once.
+func NewInFlightLimiter(perHost, perKey int) *InFlightLimiter {
+	return &InFlightLimiter{PerHost: perHost, PerKey: perKey}
+}
+
+// WithInFlightLimit caps the Client's requests in flight to each host and
+// with each key; see InFlightLimiter.
+func WithInFlightLimit(perHost, perKey int) ClientOption {
+	return func(c *Client) {
+		c.InFlight = NewInFlightLimiter(perHost, perKey)
+	}
+}
+
+// Acquire blocks until a request to host with key may start, or ctx is
+// done. The caller must call release once the request has finished.
+func (l *InFlightLimiter) Acquire(ctx context.Context, host, key string) (release func(), err error) {
+	hs := l.slots(&l.hosts, host, l.PerHost)
+	ks := l.slots(&l.keys, key, l.PerKey)
+	if err := acquireSlot(ctx, hs); err != nil {
+		return nil, err
+	}
+	if err := acquireSlot(ctx, ks); err != nil {
+		releaseSlot(hs)
+		return nil, err
+	}
+	return func() {
+		releaseSlot(ks)
+		releaseSlot(hs)
+	}, nil
+}
+
+// InFlight returns the number of requests under way to host and with key.
+func (l *InFlightLimiter) InFlight(host, key string) (perHost, perKey int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.hosts[host]), len(l.keys[key])
+}
+
+// slots returns the semaphore for name in *m, creating it if need be, or
+// nil if there is no limit.
+func (l *InFlightLimiter) slots(m *map[string]chan struct{}, name string, limit int) chan struct{} {
+	if limit <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if *m == nil {
+		*m = map[string]chan struct{}{}
+	}
+	s, ok := (*m)[name]
+	if !ok {
+		s = make(chan struct{}, limit)
+		(*m)[name] = s
+	}
+	return s
+}
+
+func acquireSlot(ctx context.Context, s chan struct{}) error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func releaseSlot(s chan struct{}) {
+	if s != nil {
+		<-s
+	}
+}
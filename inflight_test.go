@@ -0,0 +1,85 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInFlightLimiter(t *testing.T) {
+	l := NewInFlightLimiter(2, 1)
+	ctx := context.Background()
+	r1, err := l.Acquire(ctx, "h", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := l.Acquire(ctx, "h", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, k := l.InFlight("h", "a"); h != 2 || k != 1 {
+		t.Errorf("Expected: 2 and 1 in flight, Got: %d and %d", h, k)
+	}
+
+	// The host is full, and so is key a.
+	short, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Acquire(short, "other", "a"); err != context.DeadlineExceeded {
+		t.Errorf("Expected: %v, Got: %v", context.DeadlineExceeded, err)
+	}
+	if _, err := l.Acquire(short, "h", "c"); err != context.DeadlineExceeded {
+		t.Errorf("Expected: %v, Got: %v", context.DeadlineExceeded, err)
+	}
+	if h, _ := l.InFlight("other", "a"); h != 0 {
+		t.Errorf("Expected: a failed acquire to release its host slot, Got: %d in flight", h)
+	}
+
+	r1()
+	r3, err := l.Acquire(ctx, "h", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2()
+	r3()
+	if h, k := l.InFlight("h", "a"); h != 0 || k != 0 {
+		t.Errorf("Expected: nothing in flight, Got: %d and %d", h, k)
+	}
+}
+
+func TestClientInFlightLimit(t *testing.T) {
+	var active, peak int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer srv.Close()
+	c := NewClient("k", WithBaseURL(srv.URL), WithInFlightLimit(3, 0))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{}
+			if err := c.getJSON(context.Background(), "/maps/api/test/json", map[string][]string{}, &out); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak > 3 {
+		t.Errorf("Expected: at most 3 requests at once, Got: %d", peak)
+	}
+}
@@ -0,0 +1,64 @@
+package geo
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Intersection is a pair of cross streets.
+type Intersection struct {
+	Street1 string
+	Street2 string
+}
+
+// crossStreetSeparator matches the ways providers and people join cross
+// streets: "&", "and", "at" and "/".
+var crossStreetSeparator = regexp.MustCompile(`(?i)\s*(?:&|\band\b|\bat\b|/)\s*`)
+
+// IntersectionQuery returns a well-formed query for the intersection of two
+// streets, such as "Main St & 3rd Ave, Springfield". locality may be empty.
+func IntersectionQuery(street1, street2, locality string) string {
+	q := strings.TrimSpace(street1) + " & " + strings.TrimSpace(street2)
+	if locality = strings.TrimSpace(locality); locality != "" {
+		q += ", " + locality
+	}
+	return q
+}
+
+// LandmarkQuery returns a query for a named place, such as "Eiffel Tower,
+// Paris". locality may be empty.
+func LandmarkQuery(name, locality string) string {
+	q := strings.TrimSpace(name)
+	if locality = strings.TrimSpace(locality); locality != "" {
+		q += ", " + locality
+	}
+	return q
+}
+
+// IsIntersection reports whether r is an intersection of streets.
+func (r *Result) IsIntersection() bool {
+	return hasType(r.Types, "intersection")
+}
+
+// IsLandmark reports whether r is a named place rather than an address.
+func (r *Result) IsLandmark() bool {
+	return hasType(r.Types, "point_of_interest", "establishment", "natural_feature", "park", "airport", "tourist_attraction")
+}
+
+// Intersection returns the cross streets of an intersection result, taken
+// from its intersection component or else the start of its formatted
+// address.
+func (r *Result) Intersection() (Intersection, bool) {
+	if !r.IsIntersection() {
+		return Intersection{}, false
+	}
+	name := r.longComponent("intersection")
+	if name == "" {
+		name = strings.SplitN(r.FormattedAddress, ",", 2)[0]
+	}
+	streets := crossStreetSeparator.Split(strings.TrimSpace(name), 2)
+	if len(streets) != 2 || streets[0] == "" || streets[1] == "" {
+		return Intersection{}, false
+	}
+	return Intersection{Street1: streets[0], Street2: streets[1]}, true
+}
@@ -0,0 +1,39 @@
+package geo
+
+import "testing"
+
+func TestIntersectionQuery(t *testing.T) {
+	if got := IntersectionQuery(" Main St", "3rd Ave ", "Springfield"); got != "Main St & 3rd Ave, Springfield" {
+		t.Errorf("Expected: Main St & 3rd Ave, Springfield, Got: %s", got)
+	}
+	if got := IntersectionQuery("Main St", "3rd Ave", ""); got != "Main St & 3rd Ave" {
+		t.Errorf("Expected: Main St & 3rd Ave, Got: %s", got)
+	}
+	if got := LandmarkQuery("Eiffel Tower", "Paris"); got != "Eiffel Tower, Paris" {
+		t.Errorf("Expected: Eiffel Tower, Paris, Got: %s", got)
+	}
+}
+
+func TestResultIntersection(t *testing.T) {
+	r := Result{Types: []string{"intersection"}, FormattedAddress: "Broadway & W 42nd St, New York, NY 10036, USA"}
+	got, ok := r.Intersection()
+	if !ok || got != (Intersection{"Broadway", "W 42nd St"}) {
+		t.Errorf("Expected: {Broadway W 42nd St}, Got: %v", got)
+	}
+
+	r = Result{
+		Types:             []string{"intersection"},
+		AddressComponents: []AddressComponent{{LongName: "Yonge Street and Bloor Street", Types: []string{"intersection"}}},
+	}
+	if got, ok := r.Intersection(); !ok || got.Street2 != "Bloor Street" {
+		t.Errorf("Expected: Bloor Street, Got: %v", got)
+	}
+
+	r = Result{Types: []string{"street_address"}, FormattedAddress: "1 Main St & Co, Springfield"}
+	if _, ok := r.Intersection(); ok || r.IsLandmark() {
+		t.Error("Expected: a street address to be neither an intersection nor a landmark")
+	}
+	if r := (Result{Types: []string{"tourist_attraction", "point_of_interest"}}); !r.IsLandmark() {
+		t.Error("Expected: a landmark")
+	}
+}
@@ -0,0 +1,97 @@
+package geo
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Country is an ISO 3166-1 country. Aliases holds common and official names
+// that differ from Name, e.g. "South Korea" for "Korea, Republic of".
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string
+	Name    string
+	Aliases []string
+}
+
+var (
+	countryCodes      map[string]*Country
+	countryNames      map[string]*Country
+	countryLookupOnce sync.Once
+)
+
+func loadCountryLookups() {
+	countryCodes = map[string]*Country{}
+	countryNames = map[string]*Country{}
+	for i := range countries {
+		c := &countries[i]
+		countryCodes[c.Alpha2] = c
+		countryCodes[c.Alpha3] = c
+		countryCodes[c.Numeric] = c
+		countryNames[countryNameKey(c.Name)] = c
+		for _, a := range c.Aliases {
+			countryNames[countryNameKey(a)] = c
+		}
+	}
+}
+
+// countryNameKey folds case, diacritics and punctuation so that "Cote
+// d'Ivoire" and "CÔTE D’IVOIRE" match.
+func countryNameKey(name string) string {
+	name = strings.ToLower(TransliterateDiacritics(name))
+	var sb strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// CountryByCode finds a country by alpha-2, alpha-3 or numeric code, ignoring
+// case.
+func CountryByCode(code string) (Country, bool) {
+	countryLookupOnce.Do(loadCountryLookups)
+	c, ok := countryCodes[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Country{}, false
+	}
+	return *c, true
+}
+
+// CountryByName finds a country by its ISO name or a common alias, ignoring
+// case, accents and punctuation.
+func CountryByName(name string) (Country, bool) {
+	countryLookupOnce.Do(loadCountryLookups)
+	c, ok := countryNames[countryNameKey(name)]
+	if !ok {
+		return Country{}, false
+	}
+	return *c, true
+}
+
+// LookupCountry accepts whatever a provider returns for a country, code or
+// name, and finds the matching ISO country.
+func LookupCountry(s string) (Country, bool) {
+	if c, ok := CountryByCode(s); ok {
+		return c, true
+	}
+	return CountryByName(s)
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 code of the address's country,
+// or "" if it has none or it isn't recognized.
+func (a *Address) CountryCode() string {
+	if a.Response == nil || len(a.Response.Results) == 0 {
+		return ""
+	}
+	r := &a.Response.Results[0]
+	for _, s := range []string{r.component("country"), r.longComponent("country")} {
+		if c, ok := LookupCountry(s); ok {
+			return c.Alpha2
+		}
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package geo
+
+import "testing"
+
+func TestLookupCountry(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"us", "US"},
+		{"USA", "US"},
+		{"840", "US"},
+		{"United States", "US"},
+		{"United States of America", "US"},
+		{"UK", "GB"},
+		{"South Korea", "KR"},
+		{"Korea, Republic of", "KR"},
+		{"cote d’ivoire", "CI"},
+		{"Türkiye", "TR"},
+		{"Turkey", "TR"},
+		{"Deutschland", ""},
+	}
+	for _, test := range tests {
+		c, ok := LookupCountry(test.in)
+		if c.Alpha2 != test.expected || ok != (test.expected != "") {
+			t.Errorf("%q: Expected: %q, Got: %q", test.in, test.expected, c.Alpha2)
+		}
+	}
+	if c, _ := CountryByCode("fra"); c.Name != "France" || c.Numeric != "250" {
+		t.Errorf("Unexpected country: %+v", c)
+	}
+}
+
+func TestAddressCountryCode(t *testing.T) {
+	addr := &Address{Response: &Response{Results: []Result{{AddressComponents: []AddressComponent{
+		{LongName: "United Kingdom", ShortName: "GB", Types: []string{"country", "political"}},
+	}}}}}
+	if got := addr.CountryCode(); got != "GB" {
+		t.Errorf("Expected: GB, Got: %s", got)
+	}
+	addr.Response.Results[0].AddressComponents[0].ShortName = "England"
+	if got := addr.CountryCode(); got != "GB" {
+		t.Errorf("Expected a fallback to the long name, Got: %s", got)
+	}
+	if got := (&Address{}).CountryCode(); got != "" {
+		t.Errorf("Expected no country, Got: %s", got)
+	}
+}
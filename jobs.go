@@ -0,0 +1,269 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Job states.
+const (
+	JobQueued   JobState = "queued"
+	JobRunning  JobState = "running"
+	JobDone     JobState = "done"
+	JobCanceled JobState = "canceled"
+)
+
+var JobNotFoundError = errors.New("No such geocoding job.")
+
+type (
+	// JobState is the lifecycle stage of a Job.
+	JobState string
+
+	// JobResult is a BatchResult in a form that can be stored. Status is
+	// the API status for failed queries that had one; Error describes any
+	// other failure.
+	JobResult struct {
+		Index   int      `json:"index"`
+		Query   string   `json:"query"`
+		Address *Address `json:"address,omitempty"`
+		Status  string   `json:"status"`
+		Error   string   `json:"error,omitempty"`
+	}
+
+	// Job is the persisted state of a batch submitted to a JobManager.
+	// Results holds the queries answered so far, in completion order.
+	Job struct {
+		ID       string        `json:"id"`
+		State    JobState      `json:"state"`
+		Queries  []string      `json:"queries"`
+		Results  []JobResult   `json:"results"`
+		Progress BatchProgress `json:"progress"`
+		Created  time.Time     `json:"created"`
+		Updated  time.Time     `json:"updated"`
+	}
+
+	// JobStore persists jobs. Save is called whenever a job changes, so
+	// stores backed by slow storage may want to batch writes.
+	JobStore interface {
+		Save(job *Job) error
+		Load(id string) (*Job, error)
+	}
+
+	// MemoryJobStore is a JobStore that keeps jobs in memory.
+	MemoryJobStore struct {
+		mu   sync.Mutex
+		jobs map[string]*Job
+	}
+
+	// JobManager runs geocoding batches in the background, so that a
+	// service can hand out a job ID and let clients poll or stream results
+	// instead of holding a request open. Each job runs on its own Scheduler
+	// with up to Concurrency requests at once. Jobs that were still running
+	// when the process stopped are not resumed. OnSaveError, if set, is
+	// called with a copy of the job whenever the Store fails to save it.
+	JobManager struct {
+		Geocoder    Geocoder
+		Store       JobStore
+		Concurrency int
+		OnSaveError func(job *Job, err error)
+
+		mu   sync.Mutex
+		live map[string]*liveJob
+	}
+
+	// liveJob tracks a job this process is running.
+	liveJob struct {
+		job     *Job
+		cancel  context.CancelFunc
+		changed chan struct{}
+	}
+)
+
+// NewMemoryJobStore returns an empty MemoryJobStore.
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{jobs: map[string]*Job{}}
+}
+
+// Save stores a copy of job.
+func (s *MemoryJobStore) Save(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = job.copy()
+	return nil
+}
+
+// Load returns a copy of the job with id.
+func (s *MemoryJobStore) Load(id string) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	if !ok {
+		return nil, JobNotFoundError
+	}
+	return j.copy(), nil
+}
+
+func (j *Job) copy() *Job {
+	c := *j
+	c.Queries = append([]string(nil), j.Queries...)
+	c.Results = append([]JobResult(nil), j.Results...)
+	return &c
+}
+
+// NewJobManager returns a JobManager geocoding with g and persisting to
+// store, or to a MemoryJobStore if store is nil.
+func NewJobManager(g Geocoder, store JobStore, concurrency int) *JobManager {
+	if store == nil {
+		store = NewMemoryJobStore()
+	}
+	return &JobManager{Geocoder: g, Store: store, Concurrency: concurrency, live: map[string]*liveJob{}}
+}
+
+// Submit starts geocoding queries in the background and returns the job's
+// ID.
+func (m *JobManager) Submit(queries []string, opts ...RequestOption) (string, error) {
+	now := time.Now()
+	job := &Job{
+		ID:       NewSessionToken(),
+		State:    JobQueued,
+		Queries:  append([]string(nil), queries...),
+		Results:  []JobResult{},
+		Progress: BatchProgress{Total: len(queries)},
+		Created:  now,
+		Updated:  now,
+	}
+	if err := m.Store.Save(job); err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	lj := &liveJob{job: job, cancel: cancel, changed: make(chan struct{})}
+	m.mu.Lock()
+	m.live[job.ID] = lj
+	m.mu.Unlock()
+
+	go m.run(ctx, lj, opts)
+	return job.ID, nil
+}
+
+func (m *JobManager) run(ctx context.Context, lj *liveJob, opts []RequestOption) {
+	s := NewScheduler(m.Geocoder, m.Concurrency)
+	s.OnResult = func(r BatchResult) {
+		m.update(lj, func(j *Job) {
+			j.Results = append(j.Results, newJobResult(r))
+		})
+	}
+	s.OnProgress = func(p BatchProgress) {
+		m.update(lj, func(j *Job) { j.Progress = p })
+	}
+	m.update(lj, func(j *Job) { j.State = JobRunning })
+	s.Run(ctx, lj.job.Queries, opts...)
+	m.update(lj, func(j *Job) {
+		j.State = JobDone
+		if ctx.Err() != nil {
+			j.State = JobCanceled
+		}
+	})
+	lj.cancel()
+	m.mu.Lock()
+	delete(m.live, lj.job.ID)
+	m.mu.Unlock()
+}
+
+// update applies f to a live job, persists it and wakes any streams.
+func (m *JobManager) update(lj *liveJob, f func(*Job)) {
+	m.mu.Lock()
+	f(lj.job)
+	lj.job.Updated = time.Now()
+	err := m.Store.Save(lj.job)
+	var failed *Job
+	if err != nil && m.OnSaveError != nil {
+		failed = lj.job.copy()
+	}
+	close(lj.changed)
+	lj.changed = make(chan struct{})
+	m.mu.Unlock()
+	if failed != nil {
+		m.OnSaveError(failed, err)
+	}
+}
+
+func newJobResult(r BatchResult) JobResult {
+	jr := JobResult{Index: r.Index, Query: r.Query, Address: r.Address, Status: StatusOk}
+	if r.Err != nil {
+		var ge *GeocoderError
+		if errors.As(r.Err, &ge) {
+			jr.Status = ge.Status
+		} else {
+			jr.Status, jr.Error = "", r.Err.Error()
+		}
+	}
+	return jr
+}
+
+// Job returns the current state of the job with id, from the store.
+func (m *JobManager) Job(id string) (*Job, error) {
+	return m.Store.Load(id)
+}
+
+// Cancel stops a running job. Queries already answered are kept; canceling
+// a finished job does nothing.
+func (m *JobManager) Cancel(id string) error {
+	m.mu.Lock()
+	lj, ok := m.live[id]
+	m.mu.Unlock()
+	if !ok {
+		_, err := m.Store.Load(id)
+		return err
+	}
+	lj.cancel()
+	return nil
+}
+
+// Stream sends each result of the job with id, first those already
+// available and then the rest as they complete, closing the channel when
+// the job finishes or ctx is done. A job this process isn't running, such as
+// one left queued or running by an earlier process, is streamed as stored.
+func (m *JobManager) Stream(ctx context.Context, id string) (<-chan JobResult, error) {
+	m.mu.Lock()
+	lj, ok := m.live[id]
+	m.mu.Unlock()
+	if !ok {
+		// Finished and orphaned jobs are only in the store, and won't change.
+		j, err := m.Store.Load(id)
+		if err != nil {
+			return nil, err
+		}
+		lj = &liveJob{job: j, changed: make(chan struct{})}
+	}
+	out := make(chan JobResult)
+	go func() {
+		defer close(out)
+		sent := 0
+		for {
+			m.mu.Lock()
+			pending := lj.job.Results[sent:len(lj.job.Results):len(lj.job.Results)]
+			finished := !ok || lj.job.State == JobDone || lj.job.State == JobCanceled
+			changed := lj.changed
+			m.mu.Unlock()
+			for _, r := range pending {
+				select {
+				case out <- r:
+					sent++
+				case <-ctx.Done():
+					return
+				}
+			}
+			if finished {
+				return
+			}
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
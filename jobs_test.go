@@ -0,0 +1,173 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func waitForJob(t *testing.T, m *JobManager, id string) *Job {
+	for i := 0; i < 200; i++ {
+		j, err := m.Job(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j.State == JobDone || j.State == JobCanceled {
+			return j
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("Expected: job to finish")
+	return nil
+}
+
+func TestJobManager(t *testing.T) {
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		switch q {
+		case "nowhere":
+			return nil, &GeocoderError{Status: StatusZeroResults}
+		case "broken":
+			return nil, errors.New("boom")
+		}
+		return &Address{Address: q}, nil
+	})
+	m := NewJobManager(g, nil, 2)
+	id, err := m.Submit([]string{"a", "nowhere", "broken", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := m.Stream(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]JobResult{}
+	for r := range results {
+		got[r.Query] = r
+	}
+	if len(got) != 4 || got["a"].Address.Address != "a" || got["nowhere"].Status != StatusZeroResults || got["broken"].Error != "boom" {
+		t.Errorf("Unexpected results: %+v", got)
+	}
+	j := waitForJob(t, m, id)
+	if j.State != JobDone || j.Progress.Done != 2 || j.Progress.Failed != 2 || len(j.Results) != 4 {
+		t.Errorf("Unexpected job: %+v", j)
+	}
+	if _, err := m.Job("missing"); err != JobNotFoundError {
+		t.Errorf("Expected: %v, Got: %v", JobNotFoundError, err)
+	}
+}
+
+func TestJobManagerCancel(t *testing.T) {
+	release := make(chan struct{})
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		if q == "slow" {
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		return &Address{}, nil
+	})
+	m := NewJobManager(g, nil, 1)
+	id, _ := m.Submit([]string{"fast", "slow", "never"})
+	for i := 0; i < 200; i++ {
+		if j, _ := m.Job(id); len(j.Results) == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := m.Cancel(id); err != nil {
+		t.Fatal(err)
+	}
+	j := waitForJob(t, m, id)
+	if j.State != JobCanceled || j.Results[0].Query != "fast" || j.Results[0].Status != StatusOk {
+		t.Errorf("Unexpected job: %+v", j)
+	}
+	close(release)
+}
+
+func TestJobManagerStreamFinished(t *testing.T) {
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		return &Address{Address: q}, nil
+	})
+	m := NewJobManager(g, nil, 1)
+	id, _ := m.Submit([]string{"a", "b"})
+	waitForJob(t, m, id)
+	results, err := m.Stream(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for range results {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("Expected: 2, Got: %d", n)
+	}
+	if err := m.Cancel(id); err != nil {
+		t.Errorf("Expected: no error canceling a finished job, Got: %v", err)
+	}
+}
+
+func TestJobManagerStreamOrphaned(t *testing.T) {
+	store := NewMemoryJobStore()
+	store.Save(&Job{ID: "old", State: JobRunning, Queries: []string{"a", "b"}, Results: []JobResult{{Query: "a", Status: StatusOk}}})
+	m := NewJobManager(funcGeocoder(nil), store, 1)
+	results, err := m.Stream(context.Background(), "old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, more := <-results:
+			if !more {
+				if n != 1 {
+					t.Errorf("Expected: 1, Got: %d", n)
+				}
+				return
+			}
+			n++
+		case <-timeout:
+			t.Fatal("Expected: stream of an orphaned job to close")
+		}
+	}
+}
+
+// failingJobStore is a JobStore whose saves fail.
+type failingJobStore struct{ *MemoryJobStore }
+
+func (s failingJobStore) Save(job *Job) error {
+	if job.State != JobQueued {
+		return errors.New("disk full")
+	}
+	return s.MemoryJobStore.Save(job)
+}
+
+func TestJobManagerSaveError(t *testing.T) {
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		return &Address{Address: q}, nil
+	})
+	m := NewJobManager(g, failingJobStore{NewMemoryJobStore()}, 1)
+	failed := make(chan JobState, 10)
+	m.OnSaveError = func(j *Job, err error) {
+		if err.Error() == "disk full" {
+			failed <- j.State
+		}
+	}
+	if _, err := m.Submit([]string{"a"}); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		select {
+		case state := <-failed:
+			if state == JobDone {
+				return
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected: OnSaveError for the finished job")
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package geo
+
+import "sort"
+
+type (
+	// JoinPair matches record A of the first dataset with record B of the
+	// second, Distance apart.
+	JoinPair struct {
+		A, B     int
+		Distance Distance
+	}
+
+	// JoinResult is the outcome of Join or JoinUnique. Pairs are in order
+	// of A; UnmatchedA and UnmatchedB list, in input order, the records of
+	// each dataset left without a partner.
+	JoinResult struct {
+		Pairs      []JoinPair
+		UnmatchedA []int
+		UnmatchedB []int
+	}
+)
+
+// Join matches each point of a with the nearest point of b no more than
+// radius away, indexing b for the lookups. Several points of a may match
+// the same point of b; use JoinUnique when each may be used only once.
+// Records are identified by their index in a and b.
+func Join(a, b []LatLng, radius Distance) JoinResult {
+	ix := joinIndex(b)
+	res := JoinResult{Pairs: []JoinPair{}, UnmatchedA: []int{}}
+	used := make([]bool, len(b))
+	for i, p := range a {
+		n := ix.Nearest(p, 1)
+		if len(n) == 0 || n[0].Distance > radius {
+			res.UnmatchedA = append(res.UnmatchedA, i)
+			continue
+		}
+		j := n[0].Value.(int)
+		used[j] = true
+		res.Pairs = append(res.Pairs, JoinPair{A: i, B: j, Distance: n[0].Distance})
+	}
+	res.UnmatchedB = unused(used)
+	return res
+}
+
+// JoinUnique is Join with each point of b matched at most once. Candidate
+// pairs are taken closest first, so a point of a loses its nearest match to
+// a closer rival and falls back to its next nearest within radius.
+func JoinUnique(a, b []LatLng, radius Distance) JoinResult {
+	ix := joinIndex(b)
+	candidates := []JoinPair{}
+	for i, p := range a {
+		for _, n := range ix.WithinRadius(p, radius) {
+			candidates = append(candidates, JoinPair{A: i, B: n.Value.(int), Distance: n.Distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Distance < candidates[j].Distance })
+
+	matched := make([]bool, len(a))
+	used := make([]bool, len(b))
+	res := JoinResult{Pairs: []JoinPair{}, UnmatchedA: []int{}}
+	for _, c := range candidates {
+		if !matched[c.A] && !used[c.B] {
+			matched[c.A], used[c.B] = true, true
+			res.Pairs = append(res.Pairs, c)
+		}
+	}
+	sort.Slice(res.Pairs, func(i, j int) bool { return res.Pairs[i].A < res.Pairs[j].A })
+	res.UnmatchedA = unused(matched)
+	res.UnmatchedB = unused(used)
+	return res
+}
+
+func joinIndex(points []LatLng) *PointIndex {
+	items := make([]IndexedPoint, len(points))
+	for i, p := range points {
+		items[i] = IndexedPoint{LatLng: p, Value: i}
+	}
+	return NewPointIndex(items)
+}
+
+func unused(used []bool) []int {
+	out := []int{}
+	for i, u := range used {
+		if !u {
+			out = append(out, i)
+		}
+	}
+	return out
+}
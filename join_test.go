@@ -0,0 +1,47 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	joinA = []LatLng{{45.4215, -75.6972}, {45.4216, -75.6973}, {43.6532, -79.3832}, {49.2827, -123.1207}}
+	joinB = []LatLng{{43.6533, -79.3833}, {45.4215, -75.6971}, {45.4230, -75.6990}, {51.0447, -114.0719}}
+)
+
+func TestJoin(t *testing.T) {
+	res := Join(joinA, joinB, 100)
+	pairs := [][2]int{}
+	for _, p := range res.Pairs {
+		pairs = append(pairs, [2]int{p.A, p.B})
+		if p.Distance > 100 || p.Distance != joinA[p.A].Distance(joinB[p.B]) {
+			t.Errorf("Unexpected distance for %+v", p)
+		}
+	}
+	if expected := [][2]int{{0, 1}, {1, 1}, {2, 0}}; !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, pairs)
+	}
+	if !reflect.DeepEqual(res.UnmatchedA, []int{3}) || !reflect.DeepEqual(res.UnmatchedB, []int{2, 3}) {
+		t.Errorf("Unexpected leftovers: %v %v", res.UnmatchedA, res.UnmatchedB)
+	}
+}
+
+func TestJoinUnique(t *testing.T) {
+	res := JoinUnique(joinA, joinB, 300)
+	pairs := [][2]int{}
+	for _, p := range res.Pairs {
+		pairs = append(pairs, [2]int{p.A, p.B})
+	}
+	// A1 loses B1 to the closer A0 and falls back to B2.
+	if expected := [][2]int{{0, 1}, {1, 2}, {2, 0}}; !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, pairs)
+	}
+	if !reflect.DeepEqual(res.UnmatchedA, []int{3}) || !reflect.DeepEqual(res.UnmatchedB, []int{3}) {
+		t.Errorf("Unexpected leftovers: %v %v", res.UnmatchedA, res.UnmatchedB)
+	}
+
+	if res := Join(joinA, nil, 100); len(res.Pairs) != 0 || len(res.UnmatchedA) != 4 {
+		t.Errorf("Expected: nothing to match against, Got: %+v", res)
+	}
+}
@@ -0,0 +1,176 @@
+package geo
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+type (
+	// IndexedPoint is an item stored in a PointIndex.
+	IndexedPoint struct {
+		LatLng LatLng
+		Value  interface{}
+	}
+
+	// Neighbor is an IndexedPoint returned from a query along with its distance
+	// from the query point.
+	Neighbor struct {
+		IndexedPoint
+		Distance Distance
+	}
+
+	// PointIndex is an immutable k-d tree over a set of points, answering
+	// nearest-neighbor and radius queries in roughly logarithmic time. Points
+	// are indexed by their position on the unit sphere, so queries behave
+	// correctly across the antimeridian and near the poles.
+	PointIndex struct {
+		items []IndexedPoint
+		xyz   [][3]float64
+	}
+)
+
+// NewPointIndex builds an index over items. The slice is copied.
+func NewPointIndex(items []IndexedPoint) *PointIndex {
+	ix := &PointIndex{
+		items: append([]IndexedPoint(nil), items...),
+		xyz:   make([][3]float64, len(items)),
+	}
+	for i, it := range ix.items {
+		ix.xyz[i] = latLngToXYZ(it.LatLng)
+	}
+	ix.build(0, len(ix.items), 0)
+	return ix
+}
+
+// Len returns the number of points in the index.
+func (ix *PointIndex) Len() int {
+	return len(ix.items)
+}
+
+func (ix *PointIndex) build(lo, hi, axis int) {
+	if hi-lo <= 1 {
+		return
+	}
+	sort.Sort(kdRange{ix, lo, hi, axis})
+	mid := (lo + hi) / 2
+	ix.build(lo, mid, (axis+1)%3)
+	ix.build(mid+1, hi, (axis+1)%3)
+}
+
+type kdRange struct {
+	ix     *PointIndex
+	lo, hi int
+	axis   int
+}
+
+func (r kdRange) Len() int { return r.hi - r.lo }
+func (r kdRange) Less(i, j int) bool {
+	return r.ix.xyz[r.lo+i][r.axis] < r.ix.xyz[r.lo+j][r.axis]
+}
+func (r kdRange) Swap(i, j int) {
+	i, j = r.lo+i, r.lo+j
+	r.ix.items[i], r.ix.items[j] = r.ix.items[j], r.ix.items[i]
+	r.ix.xyz[i], r.ix.xyz[j] = r.ix.xyz[j], r.ix.xyz[i]
+}
+
+// Nearest returns up to k points closest to q, nearest first.
+func (ix *PointIndex) Nearest(q LatLng, k int) []Neighbor {
+	if k <= 0 || len(ix.items) == 0 {
+		return []Neighbor{}
+	}
+	p := latLngToXYZ(q)
+	h := &kdHeap{}
+	ix.nearest(p, k, 0, len(ix.items), 0, h)
+
+	out := make([]Neighbor, h.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = ix.neighbor(q, heap.Pop(h).(kdCandidate).index)
+	}
+	return out
+}
+
+func (ix *PointIndex) nearest(p [3]float64, k, lo, hi, axis int, h *kdHeap) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	d := chordDistance2(p, ix.xyz[mid])
+	if h.Len() < k {
+		heap.Push(h, kdCandidate{mid, d})
+	} else if d < (*h)[0].dist2 {
+		(*h)[0] = kdCandidate{mid, d}
+		heap.Fix(h, 0)
+	}
+
+	diff := p[axis] - ix.xyz[mid][axis]
+	first, second := [2]int{lo, mid}, [2]int{mid + 1, hi}
+	if diff > 0 {
+		first, second = second, first
+	}
+	next := (axis + 1) % 3
+	ix.nearest(p, k, first[0], first[1], next, h)
+	if h.Len() < k || diff*diff < (*h)[0].dist2 {
+		ix.nearest(p, k, second[0], second[1], next, h)
+	}
+}
+
+// WithinRadius returns every point within radius of q, nearest first.
+func (ix *PointIndex) WithinRadius(q LatLng, radius Distance) []Neighbor {
+	out := []Neighbor{}
+	if radius < 0 {
+		return out
+	}
+	chord := 2 * math.Sin(math.Min(radius.Meters()/EarthRadius, math.Pi)/2)
+	ix.within(latLngToXYZ(q), chord*chord, 0, len(ix.items), 0, func(i int) {
+		out = append(out, ix.neighbor(q, i))
+	})
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Distance < out[j].Distance })
+	return out
+}
+
+func (ix *PointIndex) within(p [3]float64, r2 float64, lo, hi, axis int, visit func(int)) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	if chordDistance2(p, ix.xyz[mid]) <= r2 {
+		visit(mid)
+	}
+	diff := p[axis] - ix.xyz[mid][axis]
+	next := (axis + 1) % 3
+	if diff <= 0 || diff*diff <= r2 {
+		ix.within(p, r2, lo, mid, next, visit)
+	}
+	if diff >= 0 || diff*diff <= r2 {
+		ix.within(p, r2, mid+1, hi, next, visit)
+	}
+}
+
+func (ix *PointIndex) neighbor(q LatLng, i int) Neighbor {
+	return Neighbor{IndexedPoint: ix.items[i], Distance: q.Distance(ix.items[i].LatLng)}
+}
+
+func chordDistance2(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+type kdCandidate struct {
+	index int
+	dist2 float64
+}
+
+// kdHeap is a max-heap of the best candidates found so far.
+type kdHeap []kdCandidate
+
+func (h kdHeap) Len() int            { return len(h) }
+func (h kdHeap) Less(i, j int) bool  { return h[i].dist2 > h[j].dist2 }
+func (h kdHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *kdHeap) Push(x interface{}) { *h = append(*h, x.(kdCandidate)) }
+func (h *kdHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
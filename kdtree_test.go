@@ -0,0 +1,70 @@
+package geo
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func randomPoints(n int, seed int64) []IndexedPoint {
+	r := rand.New(rand.NewSource(seed))
+	points := make([]IndexedPoint, n)
+	for i := range points {
+		points[i] = IndexedPoint{
+			LatLng: LatLng{Lat: r.Float64()*170 - 85, Lng: r.Float64()*360 - 180},
+			Value:  i,
+		}
+	}
+	return points
+}
+
+func TestPointIndexNearest(t *testing.T) {
+	points := randomPoints(2000, 1)
+	ix := NewPointIndex(points)
+	for _, q := range randomPoints(50, 2) {
+		got := ix.Nearest(q.LatLng, 5)
+		expected := append([]IndexedPoint(nil), points...)
+		sort.Slice(expected, func(i, j int) bool {
+			return q.LatLng.DistanceTo(expected[i].LatLng) < q.LatLng.DistanceTo(expected[j].LatLng)
+		})
+		if len(got) != 5 {
+			t.Fatalf("Expected: 5 results, Got: %d", len(got))
+		}
+		for i := range got {
+			if got[i].Value != expected[i].Value {
+				t.Errorf("Expected: %v, Got: %v", expected[i].Value, got[i].Value)
+			}
+		}
+	}
+	if got := NewPointIndex(nil).Nearest(LatLng{}, 3); len(got) != 0 {
+		t.Errorf("Expected no results, Got: %v", got)
+	}
+}
+
+func TestPointIndexWithinRadius(t *testing.T) {
+	points := randomPoints(2000, 3)
+	ix := NewPointIndex(points)
+	q := LatLng{40.7453721, -74.0078293}
+	const radius = 1500000
+	expected := 0
+	for _, p := range points {
+		if q.DistanceTo(p.LatLng) <= radius {
+			expected++
+		}
+	}
+	got := ix.WithinRadius(q, radius)
+	if len(got) != expected {
+		t.Errorf("Expected: %d, Got: %d", expected, len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Distance < got[i-1].Distance {
+			t.Errorf("Expected results sorted by distance")
+		}
+	}
+
+	// Points either side of the antimeridian are neighbors.
+	ix = NewPointIndex([]IndexedPoint{{LatLng{0, 179.999}, "east"}, {LatLng{0, 170}, "far"}})
+	if got := ix.WithinRadius(LatLng{0, -179.999}, 1000); len(got) != 1 || got[0].Value != "east" {
+		t.Errorf("Expected to find the point across the antimeridian, Got: %v", got)
+	}
+}
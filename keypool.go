@@ -0,0 +1,145 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Key rotation strategies for a KeyPool.
+const (
+	RotateRoundRobin KeyRotation = iota
+	RotateLeastUsed
+)
+
+var NoAvailableKeyError = errors.New("Every API key in the pool is quarantined.")
+
+type (
+	// KeyRotation selects how a KeyPool picks the key for each request.
+	KeyRotation int
+
+	// KeyPool spreads requests across several API keys. A key whose request
+	// is rejected with REQUEST_DENIED or OVER_QUERY_LIMIT (or the newer APIs'
+	// PERMISSION_DENIED and RESOURCE_EXHAUSTED) is skipped for Quarantine.
+	// It is safe for concurrent use.
+	KeyPool struct {
+		Rotation   KeyRotation
+		Quarantine time.Duration
+		Now        func() time.Time
+
+		mu   sync.Mutex
+		keys []*pooledKey
+		next int
+	}
+
+	// KeyStatus reports the state of one key in a KeyPool.
+	KeyStatus struct {
+		Key              string
+		Uses             int
+		QuarantinedUntil time.Time
+	}
+
+	pooledKey struct {
+		key   string
+		uses  int
+		until time.Time
+	}
+)
+
+// NewKeyPool returns a pool of keys rotated by rotation, quarantining
+// rejected keys for a minute.
+func NewKeyPool(rotation KeyRotation, keys ...string) *KeyPool {
+	p := &KeyPool{Rotation: rotation, Quarantine: time.Minute, Now: time.Now}
+	for _, k := range keys {
+		p.keys = append(p.keys, &pooledKey{key: k})
+	}
+	return p
+}
+
+func (p *KeyPool) now() time.Time {
+	if p.Now != nil {
+		return p.Now()
+	}
+	return time.Now()
+}
+
+// Acquire returns the key to use for the next request.
+func (p *KeyPool) Acquire() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	var pick *pooledKey
+	for i := range p.keys {
+		k := p.keys[(p.next+i)%len(p.keys)]
+		if now.Before(k.until) {
+			continue
+		}
+		if p.Rotation == RotateRoundRobin {
+			pick = k
+			p.next = (p.next + i + 1) % len(p.keys)
+			break
+		}
+		if pick == nil || k.uses < pick.uses {
+			pick = k
+		}
+	}
+	if pick == nil {
+		return "", NoAvailableKeyError
+	}
+	pick.uses++
+	return pick.key, nil
+}
+
+// Report records the status a request made with key came back with,
+// quarantining the key if it was rejected.
+func (p *KeyPool) Report(key, status string) {
+	switch status {
+	case StatusRequestDenied, StatusOverQueryLimit, "PERMISSION_DENIED", "RESOURCE_EXHAUSTED":
+	default:
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, k := range p.keys {
+		if k.key == key {
+			k.until = p.now().Add(p.Quarantine)
+		}
+	}
+}
+
+// Status returns the state of every key in the pool, in the order they
+// were added.
+func (p *KeyPool) Status() []KeyStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]KeyStatus, len(p.keys))
+	for i, k := range p.keys {
+		out[i] = KeyStatus{Key: k.key, Uses: k.uses, QuarantinedUntil: k.until}
+	}
+	return out
+}
+
+// WithKeyPool makes the Client draw the key for each request from p
+// instead of using APIKey.
+func WithKeyPool(p *KeyPool) ClientOption {
+	return func(c *Client) {
+		c.Keys = p
+	}
+}
+
+// responseStatus extracts the API status from a response body in either the
+// legacy or the newer error format.
+func responseStatus(b []byte) string {
+	var v struct {
+		Status string          `json:"status"`
+		Error  *googleAPIError `json:"error"`
+	}
+	if json.Unmarshal(b, &v) != nil {
+		return ""
+	}
+	if v.Error != nil {
+		return v.Error.Status
+	}
+	return v.Status
+}
@@ -0,0 +1,99 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestKeyPoolRoundRobin(t *testing.T) {
+	p := NewKeyPool(RotateRoundRobin, "a", "b", "c")
+	got := ""
+	for i := 0; i < 4; i++ {
+		k, err := p.Acquire()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got += k
+	}
+	if got != "abca" {
+		t.Errorf("Expected: abca, Got: %s", got)
+	}
+}
+
+func TestKeyPoolLeastUsed(t *testing.T) {
+	p := NewKeyPool(RotateLeastUsed, "a", "b")
+	p.Acquire()
+	p.Acquire()
+	p.Acquire()
+	st := p.Status()
+	if st[0].Uses != 2 || st[1].Uses != 1 {
+		t.Errorf("Expected: uses 2 and 1, Got: %+v", st)
+	}
+}
+
+func TestKeyPoolQuarantine(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := NewKeyPool(RotateRoundRobin, "a", "b")
+	p.Now = func() time.Time { return now }
+	p.Report("a", StatusOverQueryLimit)
+	p.Report("b", StatusZeroResults)
+	for i := 0; i < 2; i++ {
+		if k, _ := p.Acquire(); k != "b" {
+			t.Errorf("Expected: b, Got: %s", k)
+		}
+	}
+	p.Report("b", StatusRequestDenied)
+	if _, err := p.Acquire(); err != NoAvailableKeyError {
+		t.Errorf("Expected: %v, Got: %v", NoAvailableKeyError, err)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := p.Acquire(); err != nil {
+		t.Errorf("Expected: quarantine to lapse, Got: %v", err)
+	}
+}
+
+func TestClientKeyPool(t *testing.T) {
+	c, last := newTestClient(t, `{"status":"OVER_QUERY_LIMIT"}`)
+	p := NewKeyPool(RotateRoundRobin, "k1", "k2")
+	WithKeyPool(p)(c)
+	var out struct{}
+	for _, want := range []string{"k1", "k2"} {
+		c.getJSON(context.Background(), "/maps/api/geocode/json", map[string][]string{}, &out)
+		if got := (*last).URL.Query().Get("key"); got != want {
+			t.Errorf("Expected: %s, Got: %s", want, got)
+		}
+	}
+	if err := c.getJSON(context.Background(), "/maps/api/geocode/json", map[string][]string{}, &out); err != NoAvailableKeyError {
+		t.Errorf("Expected: %v, Got: %v", NoAvailableKeyError, err)
+	}
+}
+
+func TestClientKeyPoolRetriesWithAnotherKey(t *testing.T) {
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.URL.Query().Get("key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error": {"status": "RESOURCE_EXHAUSTED"}}`))
+			return
+		}
+		w.Write([]byte(`{"status": "OK"}`))
+	}))
+	defer srv.Close()
+	p := NewKeyPool(RotateRoundRobin, "k1", "k2")
+	c := NewClient("", WithBaseURL(srv.URL), WithKeyPool(p), WithRetry(RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}))
+	var out struct{}
+	if err := c.getJSON(context.Background(), "/maps/api/geocode/json", url.Values{}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "k1" || keys[1] != "k2" {
+		t.Errorf("Expected: [k1 k2], Got: %v", keys)
+	}
+	if st := p.Status(); st[0].QuarantinedUntil.IsZero() || !st[1].QuarantinedUntil.IsZero() {
+		t.Errorf("Expected: only k1 quarantined, Got: %+v", st)
+	}
+}
@@ -0,0 +1,151 @@
+package geo
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var InvalidKMLCoordinatesError = errors.New("Invalid KML coordinates.")
+
+type (
+	// Placemark is a KML placemark with either a Point or a LineString
+	// geometry. Placemarks with other geometries decode with both empty.
+	Placemark struct {
+		Name        string
+		Description string
+		Point       *LatLng
+		Line        []LatLng
+	}
+
+	kmlDoc struct {
+		XMLName    xml.Name       `xml:"kml"`
+		Xmlns      string         `xml:"xmlns,attr,omitempty"`
+		Placemarks []kmlPlacemark `xml:"Document>Placemark"`
+	}
+
+	kmlPlacemark struct {
+		Name        string `xml:"name,omitempty"`
+		Description string `xml:"description,omitempty"`
+		Point       *struct {
+			Coordinates string `xml:"coordinates"`
+		} `xml:"Point"`
+		LineString *struct {
+			Coordinates string `xml:"coordinates"`
+		} `xml:"LineString"`
+	}
+)
+
+// ReadKML decodes the placemarks of a KML document, whether or not they are
+// wrapped in a Document.
+func ReadKML(r io.Reader) ([]Placemark, error) {
+	var doc struct {
+		Placemarks []kmlPlacemark `xml:"Placemark"`
+		Document   []kmlPlacemark `xml:"Document>Placemark"`
+		Folders    []kmlPlacemark `xml:"Document>Folder>Placemark"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	placemarks := []Placemark{}
+	for _, group := range [][]kmlPlacemark{doc.Placemarks, doc.Document, doc.Folders} {
+		for _, kp := range group {
+			p := Placemark{Name: strings.TrimSpace(kp.Name), Description: strings.TrimSpace(kp.Description)}
+			if kp.Point != nil {
+				points, err := parseKMLCoordinates(kp.Point.Coordinates)
+				if err != nil {
+					return nil, err
+				}
+				if len(points) != 1 {
+					return nil, InvalidKMLCoordinatesError
+				}
+				p.Point = &points[0]
+			}
+			if kp.LineString != nil {
+				points, err := parseKMLCoordinates(kp.LineString.Coordinates)
+				if err != nil {
+					return nil, err
+				}
+				p.Line = points
+			}
+			placemarks = append(placemarks, p)
+		}
+	}
+	return placemarks, nil
+}
+
+// WriteKML encodes placemarks as a KML 2.2 document.
+func WriteKML(w io.Writer, placemarks []Placemark) error {
+	doc := kmlDoc{Xmlns: "http://www.opengis.net/kml/2.2"}
+	for _, p := range placemarks {
+		kp := kmlPlacemark{Name: p.Name, Description: p.Description}
+		if p.Point != nil {
+			kp.Point = &struct {
+				Coordinates string `xml:"coordinates"`
+			}{kmlCoordinates([]LatLng{*p.Point})}
+		}
+		if len(p.Line) > 0 {
+			kp.LineString = &struct {
+				Coordinates string `xml:"coordinates"`
+			}{kmlCoordinates(p.Line)}
+		}
+		doc.Placemarks = append(doc.Placemarks, kp)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(doc)
+}
+
+// AddressPlacemark returns a point placemark for a, named by its formatted
+// address.
+func AddressPlacemark(a *Address) Placemark {
+	return Placemark{Name: a.Address, Point: &LatLng{a.Lat, a.Lng}}
+}
+
+// PlacemarksBounds returns the smallest box containing every point and line
+// vertex of placemarks.
+func PlacemarksBounds(placemarks []Placemark) BoundingBox {
+	points := []LatLng{}
+	for _, p := range placemarks {
+		if p.Point != nil {
+			points = append(points, *p.Point)
+		}
+		points = append(points, p.Line...)
+	}
+	return Polygon(points).Bounds()
+}
+
+// parseKMLCoordinates parses KML's whitespace-separated lng,lat[,alt]
+// tuples. Altitudes are ignored.
+func parseKMLCoordinates(s string) ([]LatLng, error) {
+	points := []LatLng{}
+	for _, tuple := range strings.Fields(s) {
+		parts := strings.Split(tuple, ",")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, InvalidKMLCoordinatesError
+		}
+		lng, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, InvalidKMLCoordinatesError
+		}
+		lat, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, InvalidKMLCoordinatesError
+		}
+		points = append(points, LatLng{lat, lng})
+	}
+	return points, nil
+}
+
+func kmlCoordinates(points []LatLng) string {
+	parts := make([]string, len(points))
+	for i, p := range points {
+		parts[i] = strconv.FormatFloat(p.Lng, 'f', -1, 64) + "," + strconv.FormatFloat(p.Lat, 'f', -1, 64)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,54 @@
+package geo
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadKML(t *testing.T) {
+	doc := `<kml xmlns="http://www.opengis.net/kml/2.2"><Document>
+  <Placemark><name>Office</name><Point><coordinates>-79.3871,43.6426,0</coordinates></Point></Placemark>
+  <Folder><Placemark><name>Route</name><LineString><coordinates>
+    -79.39,43.64 -79.38,43.65
+  </coordinates></LineString></Placemark></Folder>
+</Document></kml>`
+	placemarks, err := ReadKML(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Placemark{
+		{Name: "Office", Point: &LatLng{43.6426, -79.3871}},
+		{Name: "Route", Line: []LatLng{{43.64, -79.39}, {43.65, -79.38}}},
+	}
+	if !reflect.DeepEqual(placemarks, expected) {
+		t.Errorf("Expected: %+v, Got: %+v", expected, placemarks)
+	}
+	bounds := BoundingBox{Southwest: LatLng{43.64, -79.39}, Northeast: LatLng{43.65, -79.38}}
+	if b := PlacemarksBounds(placemarks); b != bounds {
+		t.Errorf("Expected: %v, Got: %v", bounds, b)
+	}
+
+	if _, err := ReadKML(strings.NewReader(`<kml><Placemark><Point><coordinates>x,y</coordinates></Point></Placemark></kml>`)); err != InvalidKMLCoordinatesError {
+		t.Errorf("Expected: %v, Got: %v", InvalidKMLCoordinatesError, err)
+	}
+}
+
+func TestKMLRoundTrip(t *testing.T) {
+	placemarks := []Placemark{
+		AddressPlacemark(&Address{Lat: 43.6426, Lng: -79.3871, Address: "290 Bremner Blvd, Toronto"}),
+		{Name: "Route", Description: "a <b>", Line: []LatLng{{1, 2}, {3, 4}}},
+	}
+	var buf bytes.Buffer
+	if err := WriteKML(&buf, placemarks); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadKML(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, placemarks) {
+		t.Errorf("Expected: %+v, Got: %+v", placemarks, got)
+	}
+}
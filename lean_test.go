@@ -0,0 +1,64 @@
+package geo
+
+import (
+	"context"
+	"testing"
+)
+
+// fullGeocodeBody is a typical single-result Geocoding API response.
+const fullGeocodeBody = `{"status": "OK", "results": [{
+	"address_components": [
+		{"long_name": "1600", "short_name": "1600", "types": ["street_number"]},
+		{"long_name": "Amphitheatre Parkway", "short_name": "Amphitheatre Pkwy", "types": ["route"]},
+		{"long_name": "Mountain View", "short_name": "Mountain View", "types": ["locality", "political"]},
+		{"long_name": "Santa Clara County", "short_name": "Santa Clara County", "types": ["administrative_area_level_2", "political"]},
+		{"long_name": "California", "short_name": "CA", "types": ["administrative_area_level_1", "political"]},
+		{"long_name": "United States", "short_name": "US", "types": ["country", "political"]},
+		{"long_name": "94043", "short_name": "94043", "types": ["postal_code"]}
+	],
+	"formatted_address": "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA",
+	"geometry": {
+		"location": {"lat": 37.4224764, "lng": -122.0842499},
+		"location_type": "ROOFTOP",
+		"viewport": {"northeast": {"lat": 37.4238253802915, "lng": -122.0829009197085}, "southwest": {"lat": 37.4211274197085, "lng": -122.0855988802915}}
+	},
+	"place_id": "ChIJ2eUgeAK6j4ARbn5u_wAGqWA",
+	"plus_code": {"compound_code": "CWC8+W5 Mountain View, California, United States", "global_code": "849VCWC8+W5"},
+	"types": ["street_address"]
+}]}`
+
+func TestLeanDecoding(t *testing.T) {
+	c, _ := newTestClient(t, fullGeocodeBody)
+	WithLeanDecoding()(c)
+	a, err := c.Geocode(context.Background(), "1600 Amphitheatre Pkwy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := a.Response.Results[0]
+	if a.Lat != 37.4224764 || r.PlaceID != "ChIJ2eUgeAK6j4ARbn5u_wAGqWA" || a.Address != "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA" {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+	if r.AddressComponents != nil || r.Types != nil || r.Precision != PrecisionRooftop {
+		t.Errorf("Expected: only essentials decoded, Got: %+v", r)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	body := []byte(fullGeocodeBody)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := new(Response).decode(body, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeLean(b *testing.B) {
+	body := []byte(fullGeocodeBody)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := new(Response).decodeLean(body, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
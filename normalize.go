@@ -0,0 +1,237 @@
+package geo
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// QueryNormalizer rewrites a free-text query before it is sent or used as a
+// cache key. Normalizers compose with NormalizeQuery.
+type QueryNormalizer func(string) string
+
+var (
+	// DefaultQueryNormalizers is the pipeline used by WithQueryNormalization
+	// when no steps are given.
+	DefaultQueryNormalizers = []QueryNormalizer{CollapseWhitespace, ExpandAbbreviations}
+
+	streetAbbreviations = map[string]string{
+		"ave": "Avenue", "av": "Avenue", "blvd": "Boulevard", "cir": "Circle",
+		"ct": "Court", "dr": "Drive", "expy": "Expressway", "fwy": "Freeway",
+		"hwy": "Highway", "ln": "Lane", "pkwy": "Parkway", "pl": "Place",
+		"rd": "Road", "sq": "Square", "st": "Street", "ter": "Terrace",
+		"trl": "Trail", "mt": "Mount", "ft": "Fort",
+	}
+
+	compassDirections = map[string]struct{}{
+		"n": {}, "s": {}, "e": {}, "w": {}, "ne": {}, "nw": {}, "se": {}, "sw": {},
+		"north": {}, "south": {}, "east": {}, "west": {},
+		"northeast": {}, "northwest": {}, "southeast": {}, "southwest": {},
+	}
+
+	unitDesignatorPattern = regexp.MustCompile(`(?i)(,\s*)?\b(apt|apartment|suite|ste|unit|fl|floor|rm|room|bldg|building|dept)\.?\s*#?\s*[0-9a-z-]+\b|(,\s*)?#\s*[0-9a-z-]+\b`)
+
+	diacriticReplacements = strings.NewReplacer(
+		"ß", "ss", "æ", "ae", "Æ", "AE", "œ", "oe", "Œ", "OE", "ø", "o", "Ø", "O",
+		"ł", "l", "Ł", "L", "đ", "d", "Đ", "D", "ð", "d", "Ð", "D", "þ", "th", "Þ", "Th", "ı", "i",
+	)
+
+	diacriticBases = map[rune]string{}
+)
+
+func init() {
+	for base, variants := range map[string]string{
+		"a": "àáâãäåāăą", "A": "ÀÁÂÃÄÅĀĂĄ", "c": "çćĉċč", "C": "ÇĆĈĊČ",
+		"d": "ď", "D": "Ď", "e": "èéêëēĕėęě", "E": "ÈÉÊËĒĔĖĘĚ",
+		"g": "ĝğġģ", "G": "ĜĞĠĢ", "h": "ĥħ", "H": "ĤĦ", "i": "ìíîïĩīĭįİ", "I": "ÌÍÎÏĨĪĬĮ",
+		"j": "ĵ", "J": "Ĵ", "k": "ķ", "K": "Ķ", "l": "ĺļľŀ", "L": "ĹĻĽĿ",
+		"n": "ñńņňŉ", "N": "ÑŃŅŇ", "o": "òóôõöōŏő", "O": "ÒÓÔÕÖŌŎŐ",
+		"r": "ŕŗř", "R": "ŔŖŘ", "s": "śŝşšș", "S": "ŚŜŞŠȘ", "t": "ţťŧț", "T": "ŢŤŦȚ",
+		"u": "ùúûüũūŭůűų", "U": "ÙÚÛÜŨŪŬŮŰŲ", "w": "ŵ", "W": "Ŵ", "y": "ýÿŷ", "Y": "ÝŸŶ",
+		"z": "źżž", "Z": "ŹŻŽ",
+	} {
+		for _, r := range variants {
+			diacriticBases[r] = base
+		}
+	}
+}
+
+// NormalizeQuery returns a normalizer that trims q and then applies each
+// step in order.
+func NormalizeQuery(steps ...QueryNormalizer) QueryNormalizer {
+	return func(q string) string {
+		q = strings.TrimSpace(q)
+		for _, step := range steps {
+			q = step(q)
+		}
+		return q
+	}
+}
+
+// defaultNormalize is NormalizeQuery(DefaultQueryNormalizers...) without
+// building a closure on every call.
+func defaultNormalize(q string) string {
+	q = strings.TrimSpace(q)
+	for _, step := range DefaultQueryNormalizers {
+		q = step(q)
+	}
+	return q
+}
+
+// CollapseWhitespace trims q and replaces each run of whitespace with a
+// single space.
+func CollapseWhitespace(q string) string {
+	if collapsed(q) {
+		return q
+	}
+	return strings.Join(strings.Fields(q), " ")
+}
+
+// collapsed reports whether CollapseWhitespace would leave q unchanged.
+func collapsed(q string) bool {
+	prev := ' '
+	for _, r := range q {
+		if unicode.IsSpace(r) && (r != ' ' || prev == ' ') {
+			return false
+		}
+		prev = r
+	}
+	return prev != ' ' || q == ""
+}
+
+// ExpandAbbreviations expands common street-type abbreviations such as
+// "St" and "Ave". "St" is read as "Saint" when it starts a name and comes
+// before one ("St Louis", "12 St Marks Pl") and as "Street" otherwise ("W 18th
+// St", "100 St NW"). "Dr" is left alone where it starts a name, as in "Dr
+// Martin Luther King Jr Blvd".
+func ExpandAbbreviations(q string) string {
+	if !hasAbbreviation(q) {
+		return q
+	}
+	words := strings.Fields(q)
+	for i, w := range words {
+		core := strings.TrimRight(w, ".,")
+		trail := w[len(strings.TrimRight(w, ",")):]
+		full, ok := abbreviation(core)
+		if !ok {
+			continue
+		}
+		if (full == "Street" || full == "Drive") && i+1 < len(words) && !strings.HasSuffix(w, ",") &&
+			startsName(words, i) && isName(words[i+1]) {
+			if full == "Drive" {
+				continue
+			}
+			full = "Saint"
+		}
+		words[i] = full + trail
+	}
+	return strings.Join(words, " ")
+}
+
+// hasAbbreviation reports whether any word of q is in streetAbbreviations.
+func hasAbbreviation(q string) bool {
+	for w := range strings.FieldsSeq(q) {
+		if _, ok := abbreviation(strings.TrimRight(w, ".,")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// abbreviation looks w up in streetAbbreviations without regard to case,
+// lowercasing ASCII words into a fixed buffer so that the lookup doesn't
+// allocate.
+func abbreviation(w string) (string, bool) {
+	var buf [8]byte
+	if len(w) > len(buf) {
+		return "", false
+	}
+	for i := 0; i < len(w); i++ {
+		c := w[i]
+		if c >= utf8.RuneSelf {
+			full, ok := streetAbbreviations[strings.ToLower(w)]
+			return full, ok
+		}
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		buf[i] = c
+	}
+	full, ok := streetAbbreviations[string(buf[:len(w)])]
+	return full, ok
+}
+
+// startsName reports whether words[i] begins a name rather than ending one:
+// it is at the start of a comma-separated part or follows a house number.
+// An ordinal such as "18th" is the name of a street, not a house number.
+func startsName(words []string, i int) bool {
+	if i == 0 || strings.HasSuffix(words[i-1], ",") {
+		return true
+	}
+	prev := strings.TrimLeftFunc(words[i-1], unicode.IsDigit)
+	if len(prev) == len(words[i-1]) {
+		return false
+	}
+	switch strings.ToLower(prev) {
+	case "st", "nd", "rd", "th":
+		return false
+	}
+	return true
+}
+
+// isName reports whether w can be the proper name that "St" or "Dr" precedes:
+// it starts with a letter and is neither a compass direction nor a street
+// abbreviation.
+func isName(w string) bool {
+	w = strings.TrimRight(w, ".,")
+	first, _ := utf8.DecodeRuneInString(w)
+	if !unicode.IsLetter(first) {
+		return false
+	}
+	if _, ok := compassDirections[strings.ToLower(w)]; ok {
+		return false
+	}
+	_, ok := abbreviation(w)
+	return !ok
+}
+
+// StripUnitDesignators removes apartment, suite, unit and floor numbers,
+// which geocoders generally ignore and which fragment cache keys.
+func StripUnitDesignators(q string) string {
+	q = unitDesignatorPattern.ReplaceAllString(q, "")
+	return strings.ReplaceAll(CollapseWhitespace(q), " ,", ",")
+}
+
+// TransliterateDiacritics replaces accented Latin letters with their ASCII
+// equivalents, e.g. "Zürich" becomes "Zurich".
+func TransliterateDiacritics(q string) string {
+	q = diacriticReplacements.Replace(q)
+	var sb strings.Builder
+	for _, r := range q {
+		if base, ok := diacriticBases[r]; ok {
+			sb.WriteString(base)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// WithQueryNormalization normalizes every free-text query the Client sends,
+// using DefaultQueryNormalizers if no steps are given.
+func WithQueryNormalization(steps ...QueryNormalizer) ClientOption {
+	if len(steps) == 0 {
+		steps = DefaultQueryNormalizers
+	}
+	return func(c *Client) {
+		c.Normalizer = NormalizeQuery(steps...)
+	}
+}
+
+func (c *Client) normalizeQuery(q string) string {
+	if c.Normalizer == nil {
+		return strings.TrimSpace(q)
+	}
+	return c.Normalizer(q)
+}
@@ -0,0 +1,57 @@
+package geo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalizers(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        QueryNormalizer
+		in       string
+		expected string
+	}{
+		{"collapse", CollapseWhitespace, "  555  W\t18th   St ", "555 W 18th St"},
+		{"collapsed", CollapseWhitespace, "555 W 18th St", "555 W 18th St"},
+		{"trailing space", CollapseWhitespace, "555 W 18th St ", "555 W 18th St"},
+		{"unicode space", CollapseWhitespace, "Zürich\u00a0HB", "Zürich HB"},
+		{"no abbreviations", ExpandAbbreviations, "1600 Amphitheatre Parkway", "1600 Amphitheatre Parkway"},
+		{"upper case", ExpandAbbreviations, "1 MAIN ST", "1 MAIN Street"},
+		{"street", ExpandAbbreviations, "555 W 18th St, New York", "555 W 18th Street, New York"},
+		{"saint", ExpandAbbreviations, "St. Louis, MO", "Saint Louis, MO"},
+		{"saint after number", ExpandAbbreviations, "12 St Marks Pl", "12 Saint Marks Place"},
+		{"street before name", ExpandAbbreviations, "555 W 18th St New York NY", "555 W 18th Street New York NY"},
+		{"street before direction", ExpandAbbreviations, "E 42nd St NW", "E 42nd Street NW"},
+		{"numbered street", ExpandAbbreviations, "100 St NW", "100 Street NW"},
+		{"doctor", ExpandAbbreviations, "Dr Martin Luther King Jr Blvd", "Dr Martin Luther King Jr Boulevard"},
+		{"drive", ExpandAbbreviations, "1 Ocean Dr, Miami", "1 Ocean Drive, Miami"},
+		{"avenue", ExpandAbbreviations, "350 5th Ave.", "350 5th Avenue"},
+		{"units", StripUnitDesignators, "350 5th Ave, Suite 3300, New York", "350 5th Ave, New York"},
+		{"apt", StripUnitDesignators, "1 Main St Apt 4B", "1 Main St"},
+		{"hash", StripUnitDesignators, "1 Main St #12, Springfield", "1 Main St, Springfield"},
+		{"diacritics", TransliterateDiacritics, "Bahnhofstraße 1, Zürich", "Bahnhofstrasse 1, Zurich"},
+		{"more diacritics", TransliterateDiacritics, "Łódź, Søndergade, São Paulo", "Lodz, Sondergade, Sao Paulo"},
+	}
+	for _, test := range tests {
+		if got := test.f(test.in); got != test.expected {
+			t.Errorf("%s: Expected: %q, Got: %q", test.name, test.expected, got)
+		}
+	}
+
+	all := NormalizeQuery(CollapseWhitespace, StripUnitDesignators, ExpandAbbreviations, TransliterateDiacritics)
+	if got, expected := all("  10  Rue   de l'Église  Apt 3 "), "10 Rue de l'Eglise"; got != expected {
+		t.Errorf("Expected: %q, Got: %q", expected, got)
+	}
+}
+
+func TestClientNormalizesQueries(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	WithQueryNormalization()(c)
+	if _, err := c.Geocode(context.Background(), "  555 W   18th St "); err != nil {
+		t.Fatal(err)
+	}
+	if got := (*last).URL.Query().Get("address"); got != "555 W 18th Street" {
+		t.Errorf("Expected: %q, Got: %q", "555 W 18th Street", got)
+	}
+}
@@ -0,0 +1,76 @@
+package geo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/rand"
+)
+
+// The helpers below reduce the precision of a location before it is stored,
+// for applications that must not keep exact user addresses. Snapping is
+// deterministic and many-to-one, so it hides which point within a cell was
+// meant and repeated saves agree; jittering moves a point randomly within a
+// radius. Neither hides the cell or the neighbourhood of the point, so pick
+// a size that covers enough people for the use at hand.
+
+// Snap returns the centre of the cell of a grid about size on a side that
+// contains l. Every point in a cell snaps to the same centre, and no point
+// moves more than about 0.71 * size. Cells are size tall everywhere and at
+// most size wide at their centre latitude.
+func (l LatLng) Snap(size Distance) LatLng {
+	latStep := math.Min(size.Meters()/metersPerDegree, 180)
+	row := math.Floor((l.Lat + 90) / latStep)
+	lat := math.Min(-90+(row+0.5)*latStep, 90)
+	// Round the column count up so the columns wrap evenly at ±180.
+	lngStep := 360.0
+	if c := math.Cos(toRadians(lat)); c > 0 {
+		lngStep = 360 / math.Ceil(360/math.Min(latStep/c, 360))
+	}
+	col := math.Floor((normalizeLng(l.Lng) + 180) / lngStep)
+	return LatLng{Lat: lat, Lng: normalizeLng(-180 + (col+0.5)*lngStep)}
+}
+
+// SnapToGeohash returns the centre of the geohash cell of length precision
+// containing l, so that stored points line up with geohash-keyed data.
+func (l LatLng) SnapToGeohash(precision int) LatLng {
+	b, _ := GeohashBounds(GeohashAt(l, precision))
+	return b.Center()
+}
+
+// SnapToH3 returns the centre of the H3 cell at resolution res containing l.
+func (l LatLng) SnapToH3(res int) LatLng {
+	return H3CellAt(l, res).Center()
+}
+
+// Jitter returns a point chosen uniformly at random within radius of l,
+// using r, or the default source if r is nil. Each call gives a different
+// point, so jittering the same location repeatedly and averaging recovers
+// it; store the result once, or use StableJitter.
+func (l LatLng) Jitter(radius Distance, r *rand.Rand) LatLng {
+	u, v := rand.Float64, rand.Float64
+	if r != nil {
+		u, v = r.Float64, r.Float64
+	}
+	return l.jitter(radius, u(), v())
+}
+
+// StableJitter is Jitter with the offset derived from l and a secret key
+// instead of chosen at random: the same point and key always give the same
+// result, and without the key the offset can't be undone. Points that
+// differ at all get unrelated offsets, so Snap or Round noisy inputs first.
+func (l LatLng) StableJitter(radius Distance, key []byte) LatLng {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(latLngParam(l)))
+	sum := mac.Sum(nil)
+	u := float64(binary.BigEndian.Uint64(sum[0:8])>>11) / (1 << 53)
+	v := float64(binary.BigEndian.Uint64(sum[8:16])>>11) / (1 << 53)
+	return l.jitter(radius, u, v)
+}
+
+// jitter maps u and v, uniform in [0, 1), to a point uniform in the disc of
+// radius around l.
+func (l LatLng) jitter(radius Distance, u, v float64) LatLng {
+	return l.destination(2*math.Pi*v, radius.Meters()*math.Sqrt(u))
+}
@@ -0,0 +1,61 @@
+package geo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSnap(t *testing.T) {
+	points := []LatLng{{45.4215, -75.6972}, {-33.8688, 151.2093}, {89.9999, 10}, {0.0001, 179.9999}}
+	for _, ll := range points {
+		s := ll.Snap(500)
+		if d := ll.DistanceTo(s); d > 0.71*500+1 {
+			t.Errorf("%v: Expected: at most 356m, Got: %.0fm", ll, d)
+		}
+		if again := s.Snap(500); again.DistanceTo(s) > 1e-6 {
+			t.Errorf("%v: Expected: snapping to be idempotent, Got: %v then %v", ll, s, again)
+		}
+	}
+	a, b := LatLng{45.42151, -75.69721}.Snap(500), LatLng{45.42152, -75.69722}.Snap(500)
+	if a != b {
+		t.Errorf("Expected: nearby points to share a cell, Got: %v and %v", a, b)
+	}
+}
+
+func TestSnapToCells(t *testing.T) {
+	ll := LatLng{45.4215, -75.6972}
+	if g := ll.SnapToGeohash(6); GeohashAt(g, 6) != GeohashAt(ll, 6) {
+		t.Errorf("Expected: %v to stay in geohash %s", g, GeohashAt(ll, 6))
+	}
+	if h := ll.SnapToH3(7); H3CellAt(h, 7) != H3CellAt(ll, 7) {
+		t.Errorf("Expected: %v to stay in H3 cell %v", h, H3CellAt(ll, 7))
+	}
+}
+
+func TestJitter(t *testing.T) {
+	ll := LatLng{45.4215, -75.6972}
+	r := rand.New(rand.NewSource(1))
+	far := 0
+	for i := 0; i < 200; i++ {
+		d := ll.DistanceTo(ll.Jitter(100, r))
+		if d > 100.001 {
+			t.Fatalf("Expected: at most 100m, Got: %.3fm", d)
+		}
+		if d > 50 {
+			far++
+		}
+	}
+	// Three quarters of a uniform disc lies beyond half its radius.
+	if far < 120 || far > 180 {
+		t.Errorf("Expected: about 150 points past 50m, Got: %d", far)
+	}
+
+	key := []byte("secret")
+	a, b := ll.StableJitter(100, key), ll.StableJitter(100, key)
+	if a != b || ll.DistanceTo(a) > 100.001 {
+		t.Errorf("Expected: the same point within 100m, Got: %v and %v", a, b)
+	}
+	if c := ll.StableJitter(100, []byte("other")); c == a {
+		t.Errorf("Expected: a different key to give a different point")
+	}
+}
@@ -0,0 +1,160 @@
+package geo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var InvalidDatasetError = errors.New("Invalid offline geocoder dataset.")
+
+type (
+	// OfflineEntry is one address and its coordinates.
+	OfflineEntry struct {
+		Address string  `json:"address"`
+		Lat     float64 `json:"lat"`
+		Lng     float64 `json:"lng"`
+	}
+
+	// OfflineGeocoder serves Geocode and ReverseGeocode from a fixed local
+	// dataset, for demos, air-gapped environments and load tests. Forward
+	// lookups match the whole address or, failing that, the shortest address
+	// starting with the query; reverse lookups return the nearest entry.
+	OfflineGeocoder struct {
+		entries []OfflineEntry
+		keys    []string
+		sorted  []int
+		index   *PointIndex
+	}
+)
+
+// NewOfflineGeocoder indexes entries.
+func NewOfflineGeocoder(entries []OfflineEntry) *OfflineGeocoder {
+	g := &OfflineGeocoder{entries: entries, keys: make([]string, len(entries)), sorted: make([]int, len(entries))}
+	points := make([]IndexedPoint, len(entries))
+	for i, e := range entries {
+		g.keys[i] = offlineKey(e.Address)
+		g.sorted[i] = i
+		points[i] = IndexedPoint{LatLng: LatLng{e.Lat, e.Lng}, Value: i}
+	}
+	sort.SliceStable(g.sorted, func(a, b int) bool {
+		return g.keys[g.sorted[a]] < g.keys[g.sorted[b]]
+	})
+	g.index = NewPointIndex(points)
+	return g
+}
+
+// LoadOfflineGeocoderCSV reads a CSV file whose header names address, lat
+// and lng columns (in any order, ignoring case; other columns are ignored).
+func LoadOfflineGeocoderCSV(r io.Reader) (*OfflineGeocoder, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, InvalidDatasetError
+	}
+	cols := map[string]int{}
+	for i, name := range rows[0] {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	ai, ok1 := cols["address"]
+	lati, ok2 := cols["lat"]
+	lngi, ok3 := cols["lng"]
+	if !ok1 || !ok2 || !ok3 {
+		return nil, InvalidDatasetError
+	}
+	entries := make([]OfflineEntry, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		lat, err1 := strconv.ParseFloat(strings.TrimSpace(row[lati]), 64)
+		lng, err2 := strconv.ParseFloat(strings.TrimSpace(row[lngi]), 64)
+		if err1 != nil || err2 != nil {
+			return nil, InvalidDatasetError
+		}
+		entries = append(entries, OfflineEntry{Address: row[ai], Lat: lat, Lng: lng})
+	}
+	return NewOfflineGeocoder(entries), nil
+}
+
+// LoadOfflineGeocoderJSON reads a JSON array of OfflineEntry objects.
+func LoadOfflineGeocoderJSON(r io.Reader) (*OfflineGeocoder, error) {
+	var entries []OfflineEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	return NewOfflineGeocoder(entries), nil
+}
+
+// Geocode finds q in the dataset.
+func (g *OfflineGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	key := offlineKey(q)
+	if key == "" {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	i := sort.Search(len(g.sorted), func(i int) bool { return g.keys[g.sorted[i]] >= key })
+	best := -1
+	for ; i < len(g.sorted) && strings.HasPrefix(g.keys[g.sorted[i]], key); i++ {
+		e := g.sorted[i]
+		if best < 0 || len(g.keys[e]) < len(g.keys[best]) {
+			best = e
+		}
+	}
+	if best < 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	confidence := 0.5
+	if g.keys[best] == key {
+		confidence = 1
+	}
+	return g.address(best, confidence), nil
+}
+
+// ReverseGeocode returns the entry nearest to ll.
+func (g *OfflineGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	n := g.index.Nearest(ll, 1)
+	if len(n) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	return g.address(n[0].Value.(int), 1), nil
+}
+
+func (g *OfflineGeocoder) address(i int, confidence float64) *Address {
+	e := g.entries[i]
+	r := Result{FormattedAddress: e.Address, Confidence: confidence}
+	r.Geometry.Location = LatLng{e.Lat, e.Lng}
+	return &Address{
+		Lat:      e.Lat,
+		Lng:      e.Lng,
+		Address:  e.Address,
+		Response: &Response{Status: StatusOk, Results: []Result{r}},
+	}
+}
+
+// offlineKey folds case, accents and punctuation so that lookups tolerate
+// formatting differences.
+func offlineKey(s string) string {
+	s = strings.ToLower(TransliterateDiacritics(s))
+	var sb strings.Builder
+	space := false
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && sb.Len() > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteRune(r)
+			space = false
+		default:
+			space = true
+		}
+	}
+	return sb.String()
+}
+
+var _ Geocoder = (*OfflineGeocoder)(nil)
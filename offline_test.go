@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const offlineCSV = `id,Address,Lat,Lng
+1,"555 W 18th St, New York, NY 10011, USA",40.7453721,-74.0078293
+2,"323 South Albany Street, Ithaca, NY 14850, USA",42.435901,-76.501238
+3,"323 South Albany Street, Ithaca, NY 14850, USA, Rear",42.4359,-76.5013
+4,"Bahnhofstrasse 1, Zürich",47.3779,8.5403
+`
+
+func TestOfflineGeocoder(t *testing.T) {
+	g, err := LoadOfflineGeocoderCSV(strings.NewReader(offlineCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	tests := []struct {
+		q          string
+		expected   string
+		confidence float64
+	}{
+		{"555 w 18th st new york ny 10011 usa", "555 W 18th St, New York, NY 10011, USA", 1},
+		{"555 W 18th St", "555 W 18th St, New York, NY 10011, USA", 0.5},
+		{"323 south albany", "323 South Albany Street, Ithaca, NY 14850, USA", 0.5},
+		{"bahnhofstrasse 1, zurich", "Bahnhofstrasse 1, Zürich", 1},
+	}
+	for _, test := range tests {
+		addr, err := g.Geocode(ctx, test.q)
+		if err != nil {
+			t.Errorf("%q: %v", test.q, err)
+			continue
+		}
+		if addr.Address != test.expected || addr.Response.Results[0].Confidence != test.confidence {
+			t.Errorf("%q: Expected: %s (%v), Got: %s (%v)", test.q, test.expected, test.confidence, addr.Address, addr.Response.Results[0].Confidence)
+		}
+	}
+	if _, err := g.Geocode(ctx, "1600 Pennsylvania Ave"); err == nil {
+		t.Errorf("Expected ZERO_RESULTS for an unknown address")
+	}
+
+	addr, err := g.ReverseGeocode(ctx, LatLng{40.7450, -74.0080})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(addr.Address, "555 W 18th St") {
+		t.Errorf("Unexpected nearest address: %s", addr.Address)
+	}
+}
+
+func TestLoadOfflineGeocoderJSON(t *testing.T) {
+	g, err := LoadOfflineGeocoderJSON(strings.NewReader(`[{"address": "Ithaca, NY", "lat": 42.44, "lng": -76.5}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr, err := g.Geocode(context.Background(), "ithaca"); err != nil || addr.Lat != 42.44 {
+		t.Errorf("Unexpected result: %v %v", addr, err)
+	}
+	if _, err := LoadOfflineGeocoderCSV(strings.NewReader("name,x\na,b\n")); err != InvalidDatasetError {
+		t.Errorf("Expected: %v, Got: %v", InvalidDatasetError, err)
+	}
+}
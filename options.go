@@ -0,0 +1,328 @@
+package geo
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TravelModeDriving   = "driving"
+	TravelModeWalking   = "walking"
+	TravelModeBicycling = "bicycling"
+	TravelModeTransit   = "transit"
+
+	TrafficModelBestGuess   = "best_guess"
+	TrafficModelPessimistic = "pessimistic"
+	TrafficModelOptimistic  = "optimistic"
+
+	AvoidTolls    = "tolls"
+	AvoidHighways = "highways"
+	AvoidFerries  = "ferries"
+	AvoidIndoor   = "indoor"
+
+	UnitsMetric   = "metric"
+	UnitsImperial = "imperial"
+)
+
+type (
+	// RequestOption sets an optional parameter on a single API request.
+	RequestOption func(*requestOptions)
+
+	requestOptions struct {
+		params            url.Values
+		waypoints         []LatLng
+		optimizeWaypoints bool
+		resultTypes       []string
+		minPrecision      Precision
+		within            *BoundingBox
+		near              *LatLng
+		radius            Distance
+		components        *ComponentFilter
+	}
+)
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	o := &requestOptions{params: url.Values{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// apply copies the collected parameters into params.
+func (o *requestOptions) apply(params url.Values) {
+	for k, v := range o.params {
+		params[k] = v
+	}
+}
+
+// filterKey identifies the filters applied after the response is decoded,
+// for caching alongside the request parameters.
+func (o *requestOptions) filterKey() string {
+	k := ""
+	if len(o.resultTypes) > 0 {
+		k += "#types=" + strings.Join(o.resultTypes, "|")
+	}
+	if o.minPrecision > PrecisionUnknown {
+		k += "#precision=" + o.minPrecision.String()
+	}
+	if o.within != nil {
+		k += "#within=" + boundsParam(*o.within)
+	}
+	if o.near != nil {
+		k += "#near=" + latLngParam(*o.near) + "," + strconv.FormatFloat(o.radius.Meters(), 'f', -1, 64)
+	}
+	return k
+}
+
+// filtering reports whether any results may be dropped after decoding.
+func (o *requestOptions) filtering() bool {
+	return len(o.resultTypes) > 0 || o.minPrecision > PrecisionUnknown || o.within != nil || o.near != nil
+}
+
+// keep reports whether r passes the client-side result filters.
+func (o *requestOptions) keep(r *Result) bool {
+	if r.Precision < o.minPrecision {
+		return false
+	}
+	if len(o.resultTypes) > 0 && !hasType(r.Types, o.resultTypes...) {
+		return false
+	}
+	if o.near != nil && o.near.Distance(r.Geometry.Location) > o.radius {
+		return false
+	}
+	return o.within == nil || o.within.Contains(r.Geometry.Location)
+}
+
+// WithMode sets the travel mode, e.g. TravelModeWalking.
+func WithMode(mode string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("mode", mode)
+	}
+}
+
+// WithDepartureTime requests results for travel starting at t. A zero t
+// means now, which enables traffic-aware durations for driving.
+func WithDepartureTime(t time.Time) RequestOption {
+	return func(o *requestOptions) {
+		if t.IsZero() {
+			o.params.Set("departure_time", "now")
+		} else {
+			o.params.Set("departure_time", strconv.FormatInt(t.Unix(), 10))
+		}
+	}
+}
+
+// WithArrivalTime requests transit results arriving by t.
+func WithArrivalTime(t time.Time) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("arrival_time", strconv.FormatInt(t.Unix(), 10))
+	}
+}
+
+// WithTrafficModel sets how traffic durations are predicted, e.g.
+// TrafficModelPessimistic. It requires a departure time.
+func WithTrafficModel(model string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("traffic_model", model)
+	}
+}
+
+// WithAvoid asks the router to avoid the given features, e.g. AvoidTolls.
+func WithAvoid(features ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("avoid", strings.Join(features, "|"))
+	}
+}
+
+// WithUnits sets the unit system used in text fields.
+func WithUnits(units string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("units", units)
+	}
+}
+
+// WithLanguage sets the language of returned text.
+func WithLanguage(lang string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("language", lang)
+	}
+}
+
+// WithRegion biases results towards a ccTLD region code.
+func WithRegion(region string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("region", region)
+	}
+}
+
+// WithWaypoints routes Directions requests through points, in order.
+func WithWaypoints(points ...LatLng) RequestOption {
+	return func(o *requestOptions) {
+		o.waypoints = points
+	}
+}
+
+// WithOptimizedWaypoints lets Directions reorder the waypoints to minimize
+// the route. The chosen order is reported in Route.WaypointOrder.
+func WithOptimizedWaypoints() RequestOption {
+	return func(o *requestOptions) {
+		o.optimizeWaypoints = true
+	}
+}
+
+// WithAlternatives asks Directions for more than one route when available.
+func WithAlternatives() RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("alternatives", "true")
+	}
+}
+
+// WithLocationBias prefers results within radius of center.
+func WithLocationBias(center LatLng, radius Distance) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("location", latLngParam(center))
+		o.params.Set("radius", strconv.FormatFloat(radius.Meters(), 'f', -1, 64))
+	}
+}
+
+// WithLocationRestriction only returns results within radius of center.
+func WithLocationRestriction(center LatLng, radius Distance) RequestOption {
+	return func(o *requestOptions) {
+		WithLocationBias(center, radius)(o)
+		o.params.Set("strictbounds", "true")
+	}
+}
+
+// WithTypes restricts results to the given place types, e.g. "address" or
+// "establishment".
+func WithTypes(types ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("types", strings.Join(types, "|"))
+	}
+}
+
+// WithCountries restricts results to the given ISO 3166-1 alpha-2 country
+// codes.
+func WithCountries(codes ...string) RequestOption {
+	return func(o *requestOptions) {
+		parts := make([]string, len(codes))
+		for i, code := range codes {
+			parts[i] = "country:" + strings.ToLower(code)
+		}
+		o.params.Set("components", strings.Join(parts, "|"))
+	}
+}
+
+// WithSessionToken groups Places requests into one billing session.
+func WithSessionToken(token string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("sessiontoken", token)
+	}
+}
+
+// WithFields limits a Place Details response to the named fields, e.g.
+// "name", "opening_hours" or "geometry/location". Google bills by field.
+func WithFields(fields ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("fields", strings.Join(fields, ","))
+	}
+}
+
+// WithKeyword filters Nearby Search results to places matching keyword.
+func WithKeyword(keyword string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("keyword", keyword)
+	}
+}
+
+// WithPlaceType restricts search results to a single place type, e.g.
+// "cafe".
+func WithPlaceType(placeType string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("type", placeType)
+	}
+}
+
+// WithOpenNow only returns places that are currently open.
+func WithOpenNow() RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("opennow", "true")
+	}
+}
+
+// WithComponents restricts geocoding results with a component filter. The
+// filter is validated before the request is sent; see
+// ComponentFilter.Validate.
+func WithComponents(f ComponentFilter) RequestOption {
+	return func(o *requestOptions) {
+		o.components = &f
+		if s := f.join(func(v string) string { return v }); s != "" {
+			o.params.Set("components", s)
+		}
+	}
+}
+
+// WithResultTypes keeps only geocoding results with at least one of types,
+// e.g. "street_address". Reverse geocoding requests also ask the server to
+// filter.
+func WithResultTypes(types ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.resultTypes = append(o.resultTypes, types...)
+	}
+}
+
+// WithMinPrecision keeps only geocoding results at least as precise as p.
+// Reverse geocoding requests also ask the server to filter.
+func WithMinPrecision(p Precision) RequestOption {
+	return func(o *requestOptions) {
+		o.minPrecision = p
+	}
+}
+
+// WithinBounds biases geocoding towards b and then drops any result whose
+// location falls outside it, so a far-off match fails with ZERO_RESULTS
+// rather than being returned.
+func WithinBounds(b BoundingBox) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("bounds", boundsParam(b))
+		o.within = &b
+	}
+}
+
+// countries returns the upper-cased country codes of the components
+// filter, for providers that take countries as a separate parameter.
+func (o *requestOptions) countries() []string {
+	countries := []string{}
+	for _, c := range strings.Split(o.params.Get("components"), "|") {
+		if strings.HasPrefix(c, "country:") {
+			countries = append(countries, strings.ToUpper(strings.TrimPrefix(c, "country:")))
+		}
+	}
+	return countries
+}
+
+func boundsParam(b BoundingBox) string {
+	return latLngParam(b.Southwest) + "|" + latLngParam(b.Northeast)
+}
+
+// withinRadius drops results farther than radius from center.
+func withinRadius(center LatLng, radius Distance) RequestOption {
+	return func(o *requestOptions) {
+		o.near, o.radius = &center, radius
+	}
+}
+
+// WithExtraParams adds parameters this package doesn't model, such as new
+// or provider-specific flags, to the request. Values are appended to any
+// already set and escaped when the URL is built. Parameters the request
+// itself sets, like address, take precedence.
+func WithExtraParams(extra url.Values) RequestOption {
+	return func(o *requestOptions) {
+		for k, vs := range extra {
+			o.params[k] = append(o.params[k], vs...)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package geo
+
+// ParsedAddress is a result flattened into the columns of a typical address
+// table. Names are long names except where a Code field gives the short form.
+type ParsedAddress struct {
+	StreetNumber string `json:"street_number"`
+	Street       string `json:"street"`
+	Unit         string `json:"unit"`
+	City         string `json:"city"`
+	County       string `json:"county"`
+	State        string `json:"state"`
+	StateCode    string `json:"state_code"`
+	PostalCode   string `json:"postal_code"`
+	Country      string `json:"country"`
+	CountryCode  string `json:"country_code"`
+}
+
+// ParsedAddress assembles r's address components into a ParsedAddress.
+// City falls back from locality to postal_town (used in the UK) and then to
+// sublocality, and PostalCode includes any postal_code_suffix as ZIP+4.
+func (r *Result) ParsedAddress() ParsedAddress {
+	p := ParsedAddress{
+		StreetNumber: r.longComponent("street_number"),
+		Street:       r.longComponent("route"),
+		Unit:         r.longComponent("subpremise"),
+		County:       r.longComponent("administrative_area_level_2"),
+		State:        r.longComponent("administrative_area_level_1"),
+		StateCode:    r.component("administrative_area_level_1"),
+		PostalCode:   r.longComponent("postal_code"),
+		Country:      r.longComponent("country"),
+		CountryCode:  r.component("country"),
+	}
+	for _, t := range []string{"locality", "postal_town", "sublocality", "administrative_area_level_3"} {
+		if p.City = r.longComponent(t); p.City != "" {
+			break
+		}
+	}
+	if suffix := r.longComponent("postal_code_suffix"); suffix != "" && p.PostalCode != "" {
+		p.PostalCode += "-" + suffix
+	}
+	return p
+}
+
+// longComponent returns the long name of the first component of type t.
+func (r *Result) longComponent(t string) string {
+	for _, c := range r.AddressComponents {
+		if hasType(c.Types, t) {
+			return c.LongName
+		}
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParsedAddress(t *testing.T) {
+	var r Result
+	err := json.Unmarshal([]byte(`{"address_components": [
+		{"long_name": "4B", "short_name": "4B", "types": ["subpremise"]},
+		{"long_name": "555", "short_name": "555", "types": ["street_number"]},
+		{"long_name": "West 18th Street", "short_name": "W 18th St", "types": ["route"]},
+		{"long_name": "Manhattan", "short_name": "Manhattan", "types": ["sublocality_level_1", "sublocality", "political"]},
+		{"long_name": "New York", "short_name": "New York", "types": ["locality", "political"]},
+		{"long_name": "New York County", "short_name": "New York County", "types": ["administrative_area_level_2", "political"]},
+		{"long_name": "New York", "short_name": "NY", "types": ["administrative_area_level_1", "political"]},
+		{"long_name": "United States", "short_name": "US", "types": ["country", "political"]},
+		{"long_name": "10011", "short_name": "10011", "types": ["postal_code"]},
+		{"long_name": "1312", "short_name": "1312", "types": ["postal_code_suffix"]}
+	]}`), &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ParsedAddress{
+		StreetNumber: "555",
+		Street:       "West 18th Street",
+		Unit:         "4B",
+		City:         "New York",
+		County:       "New York County",
+		State:        "New York",
+		StateCode:    "NY",
+		PostalCode:   "10011-1312",
+		Country:      "United States",
+		CountryCode:  "US",
+	}
+	if got := r.ParsedAddress(); got != expected {
+		t.Errorf("Expected: %+v, Got: %+v", expected, got)
+	}
+
+	uk := Result{AddressComponents: []AddressComponent{{LongName: "London", ShortName: "London", Types: []string{"postal_town"}}}}
+	if got := uk.ParsedAddress().City; got != "London" {
+		t.Errorf("Expected: London, Got: %s", got)
+	}
+}
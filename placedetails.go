@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+)
+
+type (
+	// PlaceDetails extends a geocoding Result with the business details
+	// returned by the Place Details API. Fields not requested with
+	// WithFields are left empty.
+	PlaceDetails struct {
+		Result
+		Name                     string        `json:"name"`
+		FormattedPhoneNumber     string        `json:"formatted_phone_number"`
+		InternationalPhoneNumber string        `json:"international_phone_number"`
+		Website                  string        `json:"website"`
+		URL                      string        `json:"url"`
+		BusinessStatus           string        `json:"business_status"`
+		Rating                   float64       `json:"rating"`
+		UserRatingsTotal         int           `json:"user_ratings_total"`
+		UTCOffsetMinutes         *int          `json:"utc_offset"`
+		OpeningHours             *OpeningHours `json:"opening_hours"`
+	}
+
+	OpeningHours struct {
+		OpenNow     bool            `json:"open_now"`
+		Periods     []OpeningPeriod `json:"periods"`
+		WeekdayText []string        `json:"weekday_text"`
+	}
+
+	// OpeningPeriod is a span the place is open. Close is nil for places
+	// that are always open.
+	OpeningPeriod struct {
+		Open  OpeningTime  `json:"open"`
+		Close *OpeningTime `json:"close"`
+	}
+
+	// OpeningTime is a day of the week (0 is Sunday) and a 24-hour "hhmm"
+	// time.
+	OpeningTime struct {
+		Day  int    `json:"day"`
+		Time string `json:"time"`
+	}
+
+	placeDetailsResponse struct {
+		Status string       `json:"status"`
+		Result PlaceDetails `json:"result"`
+	}
+)
+
+// PlaceDetails fetches details for a place ID. Use WithFields to request
+// only the fields you need.
+func (c *Client) PlaceDetails(ctx context.Context, placeID string, opts ...RequestOption) (*PlaceDetails, error) {
+	params := url.Values{}
+	newRequestOptions(opts).apply(params)
+	params.Set("place_id", placeID)
+	var r placeDetailsResponse
+	if err := c.getJSON(ctx, "/maps/api/place/details/json", params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+	return &r.Result, nil
+}
+
+// Details fetches the chosen prediction's place details with the session's
+// token, ending the session.
+func (s *AutocompleteSession) Details(ctx context.Context, placeID string, opts ...RequestOption) (*PlaceDetails, error) {
+	defer s.Reset()
+	return s.client.PlaceDetails(ctx, placeID, append(opts[:len(opts):len(opts)], WithSessionToken(s.Token()))...)
+}
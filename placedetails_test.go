@@ -0,0 +1,43 @@
+package geo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPlaceDetails(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "result": {
+		"name": "Google Workplace 6",
+		"place_id": "ChIJN1t_tDeuEmsRUsoyG83frY4",
+		"formatted_address": "48 Pirrama Rd, Pyrmont NSW 2009, Australia",
+		"formatted_phone_number": "(02) 9374 4000",
+		"website": "http://google.com/",
+		"geometry": {"location": {"lat": -33.866489, "lng": 151.1958561}},
+		"utc_offset": 600,
+		"opening_hours": {
+			"open_now": true,
+			"periods": [{"open": {"day": 1, "time": "0900"}, "close": {"day": 1, "time": "1700"}}],
+			"weekday_text": ["Monday: 9:00 AM – 5:00 PM"]
+		}
+	}}`)
+	d, err := c.PlaceDetails(context.Background(), "ChIJN1t_tDeuEmsRUsoyG83frY4",
+		WithFields("name", "formatted_phone_number", "website", "opening_hours", "geometry"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (*last).URL.Query().Get("fields"); got != "name,formatted_phone_number,website,opening_hours,geometry" {
+		t.Errorf("Unexpected fields: %s", got)
+	}
+	if d.Name != "Google Workplace 6" || d.FormattedPhoneNumber != "(02) 9374 4000" || d.Website != "http://google.com/" {
+		t.Errorf("Unexpected details: %+v", d)
+	}
+	if d.Geometry.Location.Lat != -33.866489 || d.PlaceID != "ChIJN1t_tDeuEmsRUsoyG83frY4" {
+		t.Errorf("Unexpected result: %+v", d.Result)
+	}
+	if d.OpeningHours == nil || !d.OpeningHours.OpenNow || d.OpeningHours.Periods[0].Close.Time != "1700" {
+		t.Errorf("Unexpected opening hours: %+v", d.OpeningHours)
+	}
+	if d.UTCOffsetMinutes == nil || *d.UTCOffsetMinutes != 600 {
+		t.Errorf("Unexpected UTC offset: %v", d.UTCOffsetMinutes)
+	}
+}
@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type (
+	// PlaceSearchResult is one page of Nearby or Text Search results. Pass
+	// NextPageToken to NextPage to fetch the following page.
+	PlaceSearchResult struct {
+		Places        []PlaceDetails
+		NextPageToken string
+		path          string
+	}
+
+	placeSearchResponse struct {
+		Status        string         `json:"status"`
+		Results       []PlaceDetails `json:"results"`
+		NextPageToken string         `json:"next_page_token"`
+	}
+)
+
+// NearbySearch finds places within radius of center.
+func (c *Client) NearbySearch(ctx context.Context, center LatLng, radius Distance, opts ...RequestOption) (*PlaceSearchResult, error) {
+	params := url.Values{}
+	newRequestOptions(opts).apply(params)
+	params.Set("location", latLngParam(center))
+	params.Set("radius", strconv.FormatFloat(radius.Meters(), 'f', -1, 64))
+	return c.placeSearch(ctx, "/maps/api/place/nearbysearch/json", params)
+}
+
+// TextSearch finds places matching a free-text query such as
+// "coffee near 555 W 18th St". Use WithLocationBias to steer results.
+func (c *Client) TextSearch(ctx context.Context, query string, opts ...RequestOption) (*PlaceSearchResult, error) {
+	params := url.Values{}
+	newRequestOptions(opts).apply(params)
+	params.Set("query", query)
+	return c.placeSearch(ctx, "/maps/api/place/textsearch/json", params)
+}
+
+// NextPage fetches the page following prev, which must have a
+// NextPageToken. Google only activates page tokens a short time after
+// issuing them, so asking too quickly may return INVALID_REQUEST.
+func (c *Client) NextPage(ctx context.Context, prev *PlaceSearchResult) (*PlaceSearchResult, error) {
+	if prev.NextPageToken == "" {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	params := url.Values{}
+	params.Set("pagetoken", prev.NextPageToken)
+	return c.placeSearch(ctx, prev.path, params)
+}
+
+func (c *Client) placeSearch(ctx context.Context, path string, params url.Values) (*PlaceSearchResult, error) {
+	var r placeSearchResponse
+	if err := c.getJSON(ctx, path, params, &r); err != nil {
+		return nil, err
+	}
+	if r.Status == StatusZeroResults {
+		return &PlaceSearchResult{Places: []PlaceDetails{}, path: path}, nil
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+	return &PlaceSearchResult{Places: r.Results, NextPageToken: r.NextPageToken, path: path}, nil
+}
@@ -0,0 +1,62 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNearbySearchPagination(t *testing.T) {
+	var queries []map[string][]string
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		paths = append(paths, r.URL.Path)
+		if r.URL.Query().Get("pagetoken") == "" {
+			w.Write([]byte(`{"status": "OK", "next_page_token": "tok", "results": [{"name": "Joe's", "place_id": "a", "geometry": {"location": {"lat": 40.74, "lng": -74.0}}}]}`))
+		} else {
+			w.Write([]byte(`{"status": "OK", "results": [{"name": "Blue Bottle", "place_id": "b"}]}`))
+		}
+	}))
+	defer srv.Close()
+	c := NewClient("k", WithBaseURL(srv.URL))
+
+	page, err := c.NearbySearch(context.Background(), LatLng{40.7453, -74.0078}, 500, WithPlaceType("cafe"), WithOpenNow())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q := queries[0]; q["location"][0] != "40.7453,-74.0078" || q["radius"][0] != "500" || q["type"][0] != "cafe" || q["opennow"][0] != "true" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+	if len(page.Places) != 1 || page.Places[0].Name != "Joe's" || page.NextPageToken != "tok" {
+		t.Errorf("Unexpected page: %+v", page)
+	}
+	page, err = c.NextPage(context.Background(), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paths[1] != "/maps/api/place/nearbysearch/json" || queries[1]["pagetoken"][0] != "tok" {
+		t.Errorf("Unexpected page request: %s %v", paths[1], queries[1])
+	}
+	if len(page.Places) != 1 || page.Places[0].Name != "Blue Bottle" || page.NextPageToken != "" {
+		t.Errorf("Unexpected page: %+v", page)
+	}
+	if _, err := c.NextPage(context.Background(), page); err == nil {
+		t.Errorf("Expected an error past the last page")
+	}
+}
+
+func TestTextSearch(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "ZERO_RESULTS", "results": []}`)
+	page, err := c.TextSearch(context.Background(), "coffee near 555 W 18th St")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*last).URL.Path != "/maps/api/place/textsearch/json" || (*last).URL.Query().Get("query") != "coffee near 555 W 18th St" {
+		t.Errorf("Unexpected request: %v", (*last).URL)
+	}
+	if len(page.Places) != 0 {
+		t.Errorf("Expected no places, Got: %v", page.Places)
+	}
+}
@@ -0,0 +1,148 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const placesAPIHost = "https://places.googleapis.com"
+
+// placesFieldMask lists the Places API (New) fields mapped into Result.
+// Requesting only these keeps calls in the cheapest billing tier that
+// includes addresses.
+const placesFieldMask = "places.id,places.formattedAddress,places.location,places.types,places.addressComponents,places.viewport"
+
+// placesReverseRadius is how far from the point ReverseGeocode looks for
+// places.
+const placesReverseRadius = 50 * Meter
+
+type (
+	// PlacesGeocoder geocodes with the Places API (New) instead of the
+	// legacy Geocoding API, for keys that only have access to the former.
+	// Geocode uses Text Search and ReverseGeocode uses Nearby Search ranked
+	// by distance. WithLanguage, WithRegion and WithLocationBias are
+	// honored; results have no location type, so their Precision comes from
+	// their types.
+	PlacesGeocoder struct {
+		Client *Client
+	}
+
+	placesLatLng struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}
+
+	placesCircle struct {
+		Circle struct {
+			Center placesLatLng `json:"center"`
+			Radius float64      `json:"radius"`
+		} `json:"circle"`
+	}
+
+	placesRequest struct {
+		TextQuery           string        `json:"textQuery,omitempty"`
+		LanguageCode        string        `json:"languageCode,omitempty"`
+		RegionCode          string        `json:"regionCode,omitempty"`
+		LocationBias        *placesCircle `json:"locationBias,omitempty"`
+		LocationRestriction *placesCircle `json:"locationRestriction,omitempty"`
+		RankPreference      string        `json:"rankPreference,omitempty"`
+		MaxResultCount      int           `json:"maxResultCount,omitempty"`
+	}
+
+	placesResponse struct {
+		Places []struct {
+			ID                string       `json:"id"`
+			FormattedAddress  string       `json:"formattedAddress"`
+			Location          placesLatLng `json:"location"`
+			Types             []string     `json:"types"`
+			AddressComponents []struct {
+				LongText  string   `json:"longText"`
+				ShortText string   `json:"shortText"`
+				Types     []string `json:"types"`
+			} `json:"addressComponents"`
+			Viewport struct {
+				Low  placesLatLng `json:"low"`
+				High placesLatLng `json:"high"`
+			} `json:"viewport"`
+		} `json:"places"`
+	}
+)
+
+// NewPlacesGeocoder returns a PlacesGeocoder that sends requests with c.
+func NewPlacesGeocoder(c *Client) *PlacesGeocoder {
+	return &PlacesGeocoder{Client: c}
+}
+
+// Geocode looks up q with Text Search.
+func (p *PlacesGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	q = p.Client.normalizeQuery(q)
+	o := newRequestOptions(opts)
+	if err := validateQuery(q, o); err != nil {
+		return nil, err
+	}
+	req := newPlacesRequest(o)
+	req.TextQuery = q
+	if loc := o.params.Get("location"); loc != "" {
+		if ll, ok := TryParseCoordinates(loc); ok {
+			radius, _ := strconv.ParseFloat(o.params.Get("radius"), 64)
+			req.LocationBias = newPlacesCircle(ll, Distance(radius))
+		}
+	}
+	return p.search(ctx, "/v1/places:searchText", req)
+}
+
+// ReverseGeocode returns the places nearest ll.
+func (p *PlacesGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	if err := validateLatLng("latlng", ll); err != nil {
+		return nil, err
+	}
+	req := newPlacesRequest(newRequestOptions(opts))
+	req.LocationRestriction = newPlacesCircle(ll, placesReverseRadius)
+	req.RankPreference = "DISTANCE"
+	req.MaxResultCount = 5
+	return p.search(ctx, "/v1/places:searchNearby", req)
+}
+
+func newPlacesRequest(o *requestOptions) *placesRequest {
+	return &placesRequest{LanguageCode: o.params.Get("language"), RegionCode: o.params.Get("region")}
+}
+
+func newPlacesCircle(center LatLng, radius Distance) *placesCircle {
+	c := &placesCircle{}
+	c.Circle.Center = placesLatLng{center.Lat, center.Lng}
+	c.Circle.Radius = radius.Meters()
+	return c
+}
+
+func (p *PlacesGeocoder) search(ctx context.Context, path string, req *placesRequest) (*Address, error) {
+	var r placesResponse
+	params := url.Values{"fields": {placesFieldMask}}
+	if err := p.Client.doJSON(ctx, "POST", placesAPIHost, path, params, req, &r, true, nil); err != nil {
+		return nil, err
+	}
+	if len(r.Places) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	g := &Response{Status: StatusOk, Results: make([]Result, len(r.Places))}
+	for i, pl := range r.Places {
+		res := &g.Results[i]
+		res.PlaceID, res.FormattedAddress, res.Types = pl.ID, pl.FormattedAddress, pl.Types
+		res.Geometry.Location = LatLng{pl.Location.Latitude, pl.Location.Longitude}
+		res.Geometry.Viewport = BoundingBox{
+			Southwest: LatLng{pl.Viewport.Low.Latitude, pl.Viewport.Low.Longitude},
+			Northeast: LatLng{pl.Viewport.High.Latitude, pl.Viewport.High.Longitude},
+		}
+		for _, ac := range pl.AddressComponents {
+			res.AddressComponents = append(res.AddressComponents, AddressComponent{LongName: ac.LongText, ShortName: ac.ShortText, Types: ac.Types})
+		}
+		res.scorePrecision(typePrecision(res.Types))
+	}
+	first := g.Results[0]
+	a := &Address{Lat: first.Geometry.Location.Lat, Lng: first.Geometry.Location.Lng, Address: first.FormattedAddress, Response: g}
+	a.stamp(time.Now(), p.Client.Validity)
+	return a, nil
+}
+
+var _ Geocoder = (*PlacesGeocoder)(nil)
@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const placesBody = `{"places": [{
+	"id": "ChIJj61dQgK6j4AR4GeTYWZsKWw",
+	"formattedAddress": "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA",
+	"location": {"latitude": 37.4220656, "longitude": -122.0840897},
+	"types": ["street_address"],
+	"addressComponents": [{"longText": "Mountain View", "shortText": "Mountain View", "types": ["locality", "political"], "languageCode": "en"}],
+	"viewport": {"low": {"latitude": 37.42, "longitude": -122.09}, "high": {"latitude": 37.43, "longitude": -122.08}}
+}]}`
+
+func newPlacesTestClient(t *testing.T, body string) (*PlacesGeocoder, *http.Request, *placesRequest) {
+	var (
+		last http.Request
+		sent placesRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = *r
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &sent)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewPlacesGeocoder(NewClient("test-key", WithBaseURL(srv.URL))), &last, &sent
+}
+
+func TestPlacesGeocode(t *testing.T) {
+	p, last, sent := newPlacesTestClient(t, placesBody)
+	a, err := p.Geocode(context.Background(), "1600 Amphitheatre", WithLanguage("en"), WithLocationBias(LatLng{37.4, -122.1}, 5000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Method != "POST" || last.URL.Path != "/v1/places:searchText" || last.URL.Query().Get("fields") != placesFieldMask {
+		t.Errorf("Unexpected request: %s %s", last.Method, last.URL)
+	}
+	if sent.TextQuery != "1600 Amphitheatre" || sent.LanguageCode != "en" || sent.LocationBias == nil || sent.LocationBias.Circle.Radius != 5000 {
+		t.Errorf("Unexpected body: %+v", sent)
+	}
+	r := a.Response.Results[0]
+	if a.Lat != 37.4220656 || r.PlaceID != "ChIJj61dQgK6j4AR4GeTYWZsKWw" || r.longComponent("locality") != "Mountain View" {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+	if r.Geometry.Viewport.Northeast.Lat != 37.43 || a.Precision() != PrecisionRooftop || r.Confidence != 1 {
+		t.Errorf("Unexpected result: %+v", r)
+	}
+}
+
+func TestPlacesReverseGeocode(t *testing.T) {
+	p, last, sent := newPlacesTestClient(t, `{}`)
+	_, err := p.ReverseGeocode(context.Background(), LatLng{37.42, -122.08})
+	if ge, ok := err.(*GeocoderError); !ok || ge.Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, err)
+	}
+	if last.URL.Path != "/v1/places:searchNearby" || sent.RankPreference != "DISTANCE" || sent.LocationRestriction.Circle.Center.Latitude != 37.42 {
+		t.Errorf("Unexpected request: %s %+v", last.URL, sent)
+	}
+}
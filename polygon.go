@@ -0,0 +1,78 @@
+package geo
+
+import "math"
+
+// Polygon is a simple closed ring of points. The closing edge from the last
+// point back to the first is implied. Edges are treated as straight lines in
+// lat/lng space, which is adequate for the city- and region-sized areas this
+// package deals with.
+type Polygon []LatLng
+
+// Bounds returns the smallest BoundingBox containing every vertex of p.
+func (p Polygon) Bounds() BoundingBox {
+	if len(p) == 0 {
+		return BoundingBox{}
+	}
+	b := BoundingBox{Southwest: p[0], Northeast: p[0]}
+	for _, ll := range p[1:] {
+		b.Southwest.Lat = math.Min(b.Southwest.Lat, ll.Lat)
+		b.Southwest.Lng = math.Min(b.Southwest.Lng, ll.Lng)
+		b.Northeast.Lat = math.Max(b.Northeast.Lat, ll.Lat)
+		b.Northeast.Lng = math.Max(b.Northeast.Lng, ll.Lng)
+	}
+	return b
+}
+
+// Contains reports whether ll lies inside p, using the even-odd rule.
+func (p Polygon) Contains(ll LatLng) bool {
+	in := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if (a.Lat > ll.Lat) != (b.Lat > ll.Lat) &&
+			ll.Lng < (b.Lng-a.Lng)*(ll.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			in = !in
+		}
+	}
+	return in
+}
+
+// intersectsBox reports whether p and the (non antimeridian crossing) box b
+// overlap.
+func (p Polygon) intersectsBox(b BoundingBox) bool {
+	if len(p) == 0 {
+		return false
+	}
+	for _, ll := range p {
+		if b.Contains(ll) {
+			return true
+		}
+	}
+	corners := []LatLng{
+		b.Southwest,
+		{Lat: b.Southwest.Lat, Lng: b.Northeast.Lng},
+		b.Northeast,
+		{Lat: b.Northeast.Lat, Lng: b.Southwest.Lng},
+	}
+	for _, c := range corners {
+		if p.Contains(c) {
+			return true
+		}
+	}
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		for k := range corners {
+			if segmentsIntersect(p[j], p[i], corners[k], corners[(k+1)%4]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func segmentsIntersect(a, b, c, d LatLng) bool {
+	cross := func(o, p, q LatLng) float64 {
+		return (p.Lng-o.Lng)*(q.Lat-o.Lat) - (p.Lat-o.Lat)*(q.Lng-o.Lng)
+	}
+	d1, d2 := cross(c, d, a), cross(c, d, b)
+	d3, d4 := cross(a, b, c), cross(a, b, d)
+	return ((d1 > 0) != (d2 > 0)) && ((d3 > 0) != (d4 > 0))
+}
@@ -0,0 +1,17 @@
+package geo
+
+import "testing"
+
+func TestPolygonContains(t *testing.T) {
+	square := Polygon{{0, 0}, {0, 10}, {10, 10}, {10, 0}}
+	if !square.Contains(LatLng{5, 5}) {
+		t.Errorf("Expected square to contain its center")
+	}
+	if square.Contains(LatLng{5, 15}) || square.Contains(LatLng{-1, 5}) {
+		t.Errorf("Expected square not to contain outside points")
+	}
+	b := square.Bounds()
+	if b.Southwest != (LatLng{0, 0}) || b.Northeast != (LatLng{10, 10}) {
+		t.Errorf("Unexpected bounds: %v", b)
+	}
+}
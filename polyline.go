@@ -0,0 +1,70 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+var InvalidPolylineError = errors.New("Invalid encoded polyline.")
+
+// EncodePolyline encodes points using Google's encoded polyline algorithm
+// at 5 decimal places of precision.
+func EncodePolyline(points []LatLng) string {
+	var sb strings.Builder
+	var prevLat, prevLng int64
+	for _, p := range points {
+		lat := int64(math.Round(p.Lat * 1e5))
+		lng := int64(math.Round(p.Lng * 1e5))
+		encodePolylineValue(&sb, lat-prevLat)
+		encodePolylineValue(&sb, lng-prevLng)
+		prevLat, prevLng = lat, lng
+	}
+	return sb.String()
+}
+
+func encodePolylineValue(sb *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		sb.WriteByte(byte(0x20|(u&0x1f)) + 63)
+		u >>= 5
+	}
+	sb.WriteByte(byte(u) + 63)
+}
+
+// DecodePolyline decodes a Google encoded polyline.
+func DecodePolyline(s string) ([]LatLng, error) {
+	points := []LatLng{}
+	var lat, lng int64
+	for i := 0; i < len(s); {
+		var deltas [2]int64
+		for k := range deltas {
+			var u uint64
+			var shift uint
+			for {
+				if i >= len(s) || s[i] < 63 || s[i] > 126 || shift > 60 {
+					return nil, InvalidPolylineError
+				}
+				b := uint64(s[i] - 63)
+				i++
+				u |= (b & 0x1f) << shift
+				shift += 5
+				if b < 0x20 {
+					break
+				}
+			}
+			if u&1 != 0 {
+				deltas[k] = ^int64(u >> 1)
+			} else {
+				deltas[k] = int64(u >> 1)
+			}
+		}
+		lat += deltas[0]
+		lng += deltas[1]
+		points = append(points, LatLng{Lat: float64(lat) / 1e5, Lng: float64(lng) / 1e5})
+	}
+	return points, nil
+}
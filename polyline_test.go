@@ -0,0 +1,37 @@
+package geo
+
+import "testing"
+
+// From Google's polyline algorithm documentation.
+var polylinePoints = []LatLng{{38.5, -120.2}, {40.7, -120.95}, {43.252, -126.453}}
+
+const polylineEncoded = "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+
+func TestEncodePolyline(t *testing.T) {
+	if got := EncodePolyline(polylinePoints); got != polylineEncoded {
+		t.Errorf("Expected: %s, Got: %s", polylineEncoded, got)
+	}
+	if got := EncodePolyline(nil); got != "" {
+		t.Errorf("Expected empty string, Got: %s", got)
+	}
+}
+
+func TestDecodePolyline(t *testing.T) {
+	got, err := DecodePolyline(polylineEncoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(polylinePoints) {
+		t.Fatalf("Expected: %v, Got: %v", polylinePoints, got)
+	}
+	for i := range got {
+		if got[i] != polylinePoints[i] {
+			t.Errorf("Expected: %v, Got: %v", polylinePoints[i], got[i])
+		}
+	}
+	for _, bad := range []string{"_p~iF", "_p~iF~ps|", "abc def"} {
+		if _, err := DecodePolyline(bad); err != InvalidPolylineError {
+			t.Errorf("%q: Expected: %v, Got: %v", bad, InvalidPolylineError, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package geo
+
+import "math"
+
+// PolylineProjection is the point of a polyline closest to some location.
+// Distance is from the location to Point and Along is from the start of the
+// line to Point. Segment is the index of the vertex that
+// starts the segment Point lies on.
+type PolylineProjection struct {
+	Point    LatLng
+	Distance Distance
+	Along    Distance
+	Segment  int
+}
+
+// DistanceToPolyline returns the distance from p to the nearest point of
+// line, or +Inf if line is empty.
+func DistanceToPolyline(p LatLng, line []LatLng) Distance {
+	return ProjectOntoPolyline(p, line).Distance
+}
+
+// ProjectOntoPolyline finds the point of line closest to p. Each segment is
+// treated as straight in a plane tangent at p, which is accurate for the
+// segment lengths of routes and corridors. An empty line gives a Distance
+// of +Inf and a Segment of -1.
+func ProjectOntoPolyline(p LatLng, line []LatLng) PolylineProjection {
+	best := PolylineProjection{Distance: Distance(math.Inf(1)), Segment: -1}
+	if len(line) == 1 {
+		return PolylineProjection{Point: line[0], Distance: p.Distance(line[0])}
+	}
+	k := math.Cos(toRadians(p.Lat))
+	local := func(ll LatLng) [2]float64 {
+		return [2]float64{normalizeLng(ll.Lng-p.Lng) * k, ll.Lat - p.Lat}
+	}
+	along := Distance(0)
+	for i := 0; i+1 < len(line); i++ {
+		a, b := line[i], line[i+1]
+		t := segmentFraction(local(a), local(b))
+		q := LatLng{Lat: a.Lat + (b.Lat-a.Lat)*t, Lng: normalizeLng(a.Lng + normalizeLng(b.Lng-a.Lng)*t)}
+		if d := p.Distance(q); d < best.Distance {
+			best = PolylineProjection{Point: q, Distance: d, Along: along + a.Distance(q), Segment: i}
+		}
+		along += a.Distance(b)
+	}
+	return best
+}
+
+// segmentFraction returns how far along the segment a-b, from 0 to 1, the
+// point closest to the origin lies.
+func segmentFraction(a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return 0
+	}
+	t := -(a[0]*dx + a[1]*dy) / (dx*dx + dy*dy)
+	return math.Max(0, math.Min(1, t))
+}
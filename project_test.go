@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProjectOntoPolyline(t *testing.T) {
+	// A route running east along the equator and then north.
+	line := []LatLng{{0, 0}, {0, 0.01}, {0.01, 0.01}}
+	tests := []struct {
+		p        LatLng
+		segment  int
+		point    LatLng
+		along    Distance
+		distance Distance
+	}{
+		{LatLng{0.001, 0.005}, 0, LatLng{0, 0.005}, 556, 111},
+		{LatLng{0.005, 0.012}, 1, LatLng{0.005, 0.01}, 1668, 222},
+		{LatLng{-0.001, -0.001}, 0, LatLng{0, 0}, 0, 157},
+		{LatLng{0.02, 0.01}, 1, LatLng{0.01, 0.01}, 2224, 1112},
+	}
+	for _, test := range tests {
+		pr := ProjectOntoPolyline(test.p, line)
+		if pr.Segment != test.segment || pr.Point.DistanceTo(test.point) > 0.5 ||
+			math.Abs((pr.Along-test.along).Meters()) > 1 || math.Abs((pr.Distance-test.distance).Meters()) > 1 {
+			t.Errorf("%v: Unexpected projection: %+v", test.p, pr)
+		}
+		if d := DistanceToPolyline(test.p, line); d != pr.Distance {
+			t.Errorf("Expected: %v, Got: %v", pr.Distance, d)
+		}
+	}
+}
+
+func TestProjectOntoPolylineDegenerate(t *testing.T) {
+	if d := DistanceToPolyline(LatLng{1, 1}, nil); !math.IsInf(d.Meters(), 1) {
+		t.Errorf("Expected: +Inf, Got: %v", d)
+	}
+	pr := ProjectOntoPolyline(LatLng{0, 0.001}, []LatLng{{0, 0}})
+	if pr.Point != (LatLng{0, 0}) || math.Abs(pr.Distance.Meters()-111) > 1 {
+		t.Errorf("Unexpected projection: %+v", pr)
+	}
+	// Segments crossing the antimeridian are handled as the short way round.
+	pr = ProjectOntoPolyline(LatLng{0.001, 180}, []LatLng{{0, 179.99}, {0, -179.99}})
+	if math.Abs(pr.Distance.Meters()-111) > 1 || math.Abs(math.Abs(pr.Point.Lng)-180) > 1e-9 {
+		t.Errorf("Unexpected projection: %+v", pr)
+	}
+}
@@ -0,0 +1,52 @@
+package geo
+
+import (
+	"errors"
+	"strings"
+)
+
+var InvalidQuadkeyError = errors.New("Invalid quadkey.")
+
+// Quadkey returns the Bing Maps style quadkey for t.
+func (t Tile) Quadkey() string {
+	var b strings.Builder
+	for i := t.Z; i > 0; i-- {
+		digit := byte('0')
+		mask := 1 << uint(i-1)
+		if t.X&mask != 0 {
+			digit++
+		}
+		if t.Y&mask != 0 {
+			digit += 2
+		}
+		b.WriteByte(digit)
+	}
+	return b.String()
+}
+
+// QuadkeyAt returns the quadkey of the tile containing ll at zoom level z.
+func QuadkeyAt(ll LatLng, z int) string {
+	return TileAt(ll, z).Quadkey()
+}
+
+// ParseQuadkey returns the tile identified by a quadkey. The zoom level is the
+// length of the key.
+func ParseQuadkey(key string) (Tile, error) {
+	t := Tile{Z: len(key)}
+	for i := 0; i < len(key); i++ {
+		mask := 1 << uint(len(key)-i-1)
+		switch key[i] {
+		case '0':
+		case '1':
+			t.X |= mask
+		case '2':
+			t.Y |= mask
+		case '3':
+			t.X |= mask
+			t.Y |= mask
+		default:
+			return Tile{}, InvalidQuadkeyError
+		}
+	}
+	return t, nil
+}
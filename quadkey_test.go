@@ -0,0 +1,35 @@
+package geo
+
+import "testing"
+
+func TestQuadkey(t *testing.T) {
+	tests := []struct {
+		Tile Tile
+		Key  string
+	}{
+		// The worked example in Bing Maps' tile system reference, and its
+		// parent tiles.
+		{Tile{3, 5, 3}, "213"},
+		{Tile{1, 2, 2}, "21"},
+		{Tile{0, 1, 1}, "2"},
+		{Tile{0, 0, 1}, "0"},
+		{Tile{0, 0, 0}, ""},
+		// X 010010110101 and Y 011000000011 interleaved by hand.
+		{Tile{1205, 1539, 12}, "032010110123"},
+	}
+	for _, test := range tests {
+		if got := test.Tile.Quadkey(); got != test.Key {
+			t.Errorf("Expected: %s, Got: %s", test.Key, got)
+		}
+		tile, err := ParseQuadkey(test.Key)
+		if err != nil {
+			t.Error(err)
+		}
+		if tile != test.Tile {
+			t.Errorf("Expected: %v, Got: %v", test.Tile, tile)
+		}
+	}
+	if _, err := ParseQuadkey("0124"); err != InvalidQuadkeyError {
+		t.Errorf("Expected: %v, Got: %v", InvalidQuadkeyError, err)
+	}
+}
@@ -0,0 +1,188 @@
+package geo
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var QuotaExceededError = errors.New("API quota budget exhausted.")
+
+type (
+	// QuotaBudget limits spend per UTC day and calendar month, in the units
+	// set by QuotaTracker.SetCost. Zero means unlimited.
+	QuotaBudget struct {
+		Daily   float64
+		Monthly float64
+	}
+
+	// QuotaUsage is a snapshot of spend against one key's budget.
+	QuotaUsage struct {
+		Key         string
+		Day         string
+		Month       string
+		DailyUsed   float64
+		MonthlyUsed float64
+		Budget      QuotaBudget
+	}
+
+	// QuotaTracker accounts for API usage per key. Each request is charged
+	// its API's cost (1 unless set otherwise); OnWarn is called once per
+	// window when usage first reaches WarnAt of a budget (80% if WarnAt is
+	// zero), and with HardStop set, requests that would exceed a budget fail
+	// with QuotaExceededError. The zero value is ready to use. It is safe for
+	// concurrent use.
+	QuotaTracker struct {
+		WarnAt   float64
+		OnWarn   func(QuotaUsage)
+		HardStop bool
+		Now      func() time.Time
+
+		mu      sync.Mutex
+		budgets map[string]QuotaBudget
+		costs   map[string]float64
+		usage   map[string]*quotaState
+	}
+
+	quotaState struct {
+		QuotaUsage
+		warnedDay, warnedMonth bool
+	}
+)
+
+// NewQuotaTracker returns a tracker that warns at 80% of a budget.
+func NewQuotaTracker() *QuotaTracker {
+	return &QuotaTracker{
+		WarnAt:  0.8,
+		Now:     time.Now,
+		budgets: map[string]QuotaBudget{},
+		costs:   map[string]float64{},
+		usage:   map[string]*quotaState{},
+	}
+}
+
+// SetBudget sets the budget for key. The budget for "" applies to keys
+// without one of their own.
+func (q *QuotaTracker) SetBudget(key string, b QuotaBudget) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.budgets == nil {
+		q.budgets = map[string]QuotaBudget{}
+	}
+	q.budgets[key] = b
+}
+
+// SetCost sets the cost of one request to api, named after its endpoint,
+// e.g. "geocode", "directions", "place/details" or "snapToRoads".
+func (q *QuotaTracker) SetCost(api string, cost float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.costs == nil {
+		q.costs = map[string]float64{}
+	}
+	q.costs[api] = cost
+}
+
+// Charge records one request to api with key.
+func (q *QuotaTracker) Charge(key, api string) error {
+	q.mu.Lock()
+	cost, ok := q.costs[api]
+	if !ok {
+		cost = 1
+	}
+	budget, ok := q.budgets[key]
+	if !ok {
+		budget = q.budgets[""]
+	}
+	now := time.Now
+	if q.Now != nil {
+		now = q.Now
+	}
+	t := now().UTC()
+	day, month := t.Format("2006-01-02"), t.Format("2006-01")
+
+	s, ok := q.usage[key]
+	if !ok {
+		if q.usage == nil {
+			q.usage = map[string]*quotaState{}
+		}
+		s = &quotaState{QuotaUsage: QuotaUsage{Key: key}}
+		q.usage[key] = s
+	}
+	if s.Day != day {
+		s.Day, s.DailyUsed, s.warnedDay = day, 0, false
+	}
+	if s.Month != month {
+		s.Month, s.MonthlyUsed, s.warnedMonth = month, 0, false
+	}
+	s.Budget = budget
+
+	over := (budget.Daily > 0 && s.DailyUsed+cost > budget.Daily) ||
+		(budget.Monthly > 0 && s.MonthlyUsed+cost > budget.Monthly)
+	if over && q.HardStop {
+		q.mu.Unlock()
+		return QuotaExceededError
+	}
+	s.DailyUsed += cost
+	s.MonthlyUsed += cost
+
+	warnAt := q.WarnAt
+	if warnAt <= 0 {
+		warnAt = 0.8
+	}
+	warn := false
+	if budget.Daily > 0 && !s.warnedDay && s.DailyUsed >= warnAt*budget.Daily {
+		s.warnedDay, warn = true, true
+	}
+	if budget.Monthly > 0 && !s.warnedMonth && s.MonthlyUsed >= warnAt*budget.Monthly {
+		s.warnedMonth, warn = true, true
+	}
+	usage, onWarn := s.QuotaUsage, q.OnWarn
+	q.mu.Unlock()
+
+	if warn && onWarn != nil {
+		onWarn(usage)
+	}
+	return nil
+}
+
+// Snapshot returns current usage for every key seen, sorted by key.
+func (q *QuotaTracker) Snapshot() []QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	out := make([]QuotaUsage, 0, len(q.usage))
+	for _, s := range q.usage {
+		out = append(out, s.QuotaUsage)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// WithQuota charges every request the Client makes with its API key to q,
+// retries included.
+func WithQuota(q *QuotaTracker) ClientOption {
+	return func(c *Client) {
+		c.Quota = q
+	}
+}
+
+// apiName names the API behind a request path for quota accounting.
+func apiName(path string) string {
+	if strings.HasPrefix(path, "/maps/api/") {
+		return strings.TrimSuffix(strings.TrimPrefix(path, "/maps/api/"), "/json")
+	}
+	// TomTom puts the query in the path, as /search/2/geocode/<query>.json.
+	if strings.HasPrefix(path, "/search/2/") {
+		name := strings.TrimPrefix(path, "/search/2/")
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[:i]
+		}
+		return name
+	}
+	if i := strings.LastIndexAny(path, "/:"); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
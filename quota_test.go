@@ -0,0 +1,110 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestQuotaWarnsOncePerWindow(t *testing.T) {
+	q := NewQuotaTracker()
+	q.SetBudget("", QuotaBudget{Daily: 10})
+	warnings := []QuotaUsage{}
+	q.OnWarn = func(u QuotaUsage) { warnings = append(warnings, u) }
+	now := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	q.Now = func() time.Time { return now }
+
+	for i := 0; i < 12; i++ {
+		if err := q.Charge("k", "geocode"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(warnings) != 1 || warnings[0].DailyUsed != 8 {
+		t.Errorf("Expected: one warning at 8, Got: %+v", warnings)
+	}
+
+	now = now.Add(24 * time.Hour)
+	q.Charge("k", "geocode")
+	snap := q.Snapshot()
+	if len(snap) != 1 || snap[0].DailyUsed != 1 || snap[0].MonthlyUsed != 13 || snap[0].Day != "2020-03-02" {
+		t.Errorf("Expected: daily reset with monthly carried over, Got: %+v", snap)
+	}
+}
+
+func TestQuotaTrackerZeroValue(t *testing.T) {
+	q := &QuotaTracker{}
+	q.SetBudget("", QuotaBudget{Daily: 10})
+	q.SetCost("geocode", 2)
+	warned := 0
+	q.OnWarn = func(QuotaUsage) { warned++ }
+	for i := 0; i < 3; i++ {
+		if err := q.Charge("k", "geocode"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if warned != 0 {
+		t.Errorf("Expected: no warning below 80%%, Got: %d", warned)
+	}
+	q.Charge("k", "geocode")
+	if warned != 1 {
+		t.Errorf("Expected: warning at 80%%, Got: %d", warned)
+	}
+	if (&QuotaTracker{}).Charge("k", "geocode") != nil {
+		t.Error("Expected: zero tracker to charge without a budget")
+	}
+}
+
+func TestQuotaHardStop(t *testing.T) {
+	q := NewQuotaTracker()
+	q.HardStop = true
+	q.SetBudget("k", QuotaBudget{Monthly: 5})
+	q.SetCost("directions", 2)
+	for i := 0; i < 2; i++ {
+		if err := q.Charge("k", "directions"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Charge("k", "directions"); err != QuotaExceededError {
+		t.Errorf("Expected: %v, Got: %v", QuotaExceededError, err)
+	}
+	if err := q.Charge("k", "geocode"); err != nil {
+		t.Errorf("Expected: cheaper call to fit, Got: %v", err)
+	}
+	if err := q.Charge("other", "directions"); err != nil {
+		t.Errorf("Expected: unbudgeted key to pass, Got: %v", err)
+	}
+}
+
+func TestClientChargesQuota(t *testing.T) {
+	c, last := newTestClient(t, `{"status":"OK"}`)
+	q := NewQuotaTracker()
+	q.HardStop = true
+	q.SetBudget("test-key", QuotaBudget{Daily: 1})
+	WithQuota(q)(c)
+
+	var out struct{}
+	if err := c.getJSON(context.Background(), "/maps/api/timezone/json", map[string][]string{}, &out); err != nil {
+		t.Fatal(err)
+	}
+	*last = nil
+	if err := c.getJSON(context.Background(), "/maps/api/timezone/json", map[string][]string{}, &out); err != QuotaExceededError {
+		t.Errorf("Expected: %v, Got: %v", QuotaExceededError, err)
+	}
+	if *last != nil {
+		t.Error("Expected: no request once the budget is spent")
+	}
+}
+
+func TestAPIName(t *testing.T) {
+	for path, want := range map[string]string{
+		"/maps/api/geocode/json":        "geocode",
+		"/maps/api/place/details/json":  "place/details",
+		"/v1/snapToRoads":               "snapToRoads",
+		"/v1:validateAddress":           "validateAddress",
+		"/search/2/geocode/Ottawa.json": "geocode",
+	} {
+		if got := apiName(path); got != want {
+			t.Errorf("Expected: %s, Got: %s", want, got)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package geo
+
+import "sort"
+
+// RankOptions controls RankResults. Results within Dedupe of each other
+// whose address components agree are treated as duplicates. Results whose
+// types appear earlier in PreferredTypes sort first; otherwise results sort
+// by Confidence.
+type RankOptions struct {
+	Dedupe         Distance
+	PreferredTypes []string
+}
+
+// dedupeComponentTypes are the components that must agree for two nearby
+// results to count as the same place.
+var dedupeComponentTypes = []string{"street_number", "route", "locality", "postal_code", "country"}
+
+// RankResults removes near-identical results, keeping the more confident of
+// each pair, and orders the rest for display. The input is not modified.
+func RankResults(results []Result, opts RankOptions) []Result {
+	kept := []Result{}
+	for _, r := range results {
+		dup := -1
+		for i, k := range kept {
+			if sameResult(r, k, opts.Dedupe) {
+				dup = i
+				break
+			}
+		}
+		switch {
+		case dup < 0:
+			kept = append(kept, r)
+		case r.Confidence > kept[dup].Confidence:
+			kept[dup] = r
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		pi, pj := typeRank(kept[i].Types, opts.PreferredTypes), typeRank(kept[j].Types, opts.PreferredTypes)
+		if pi != pj {
+			return pi < pj
+		}
+		return kept[i].Confidence > kept[j].Confidence
+	})
+	return kept
+}
+
+func sameResult(a, b Result, within Distance) bool {
+	if a.PlaceID != "" && a.PlaceID == b.PlaceID {
+		return true
+	}
+	if a.Geometry.Location.Distance(b.Geometry.Location) > within {
+		return false
+	}
+	for _, t := range dedupeComponentTypes {
+		if a.component(t) != b.component(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// component returns the short name of the first component of type t.
+func (r *Result) component(t string) string {
+	for _, c := range r.AddressComponents {
+		if hasType(c.Types, t) {
+			return c.ShortName
+		}
+	}
+	return ""
+}
+
+func typeRank(types, preferred []string) int {
+	for i, p := range preferred {
+		if hasType(types, p) {
+			return i
+		}
+	}
+	return len(preferred)
+}
+
+// SortByDistance orders results by the distance of their location from
+// from, nearest first. Results at the same distance keep their order.
+func SortByDistance(results []Result, from LatLng) {
+	sort.SliceStable(results, func(i, j int) bool {
+		return from.DistanceTo(results[i].Geometry.Location) < from.DistanceTo(results[j].Geometry.Location)
+	})
+}
+
+// Nearest returns the result whose location is closest to from, or false if
+// there are no results.
+func Nearest(results []Result, from LatLng) (Result, bool) {
+	best := -1
+	bestDist := 0.0
+	for i := range results {
+		if d := from.DistanceTo(results[i].Geometry.Location); best < 0 || d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	if best < 0 {
+		return Result{}, false
+	}
+	return results[best], true
+}
@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func rankResult(id string, lat, lng, confidence float64, types []string, route string) Result {
+	r := Result{PlaceID: id, Types: types, Confidence: confidence}
+	r.Geometry.Location = LatLng{lat, lng}
+	r.AddressComponents = []AddressComponent{{ShortName: route, Types: []string{"route"}}}
+	return r
+}
+
+func TestRankResults(t *testing.T) {
+	results := []Result{
+		rankResult("a", 40.7453, -74.0078, 0.6, []string{"route"}, "W 18th St"),
+		rankResult("b", 40.7454, -74.0078, 0.9, []string{"street_address"}, "W 18th St"),
+		rankResult("a", 40.7453, -74.0078, 0.8, []string{"route"}, "W 18th St"),
+		rankResult("c", 40.7453, -74.0079, 0.7, []string{"street_address"}, "10th Ave"),
+		rankResult("d", 40.7000, -74.0000, 0.5, []string{"locality"}, ""),
+	}
+	got := RankResults(results, RankOptions{Dedupe: 50 * Meter, PreferredTypes: []string{"street_address", "route"}})
+	ids := []string{}
+	for _, r := range got {
+		ids = append(ids, r.PlaceID)
+	}
+	// a and b are duplicates by distance and route; b is more confident.
+	// c is close by but on a different route.
+	expected := []string{"b", "c", "d"}
+	if len(ids) != len(expected) {
+		t.Fatalf("Expected: %v, Got: %v", expected, ids)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Errorf("Expected: %v, Got: %v", expected, ids)
+			break
+		}
+	}
+	if results[0].Confidence != 0.6 {
+		t.Errorf("Expected the input to be left alone")
+	}
+}
+
+func TestSortByDistance(t *testing.T) {
+	results := []Result{
+		rankResult("far", 10, 10, 1, nil, ""),
+		rankResult("near", 0.1, 0.1, 1, nil, ""),
+		rankResult("mid", 1, 1, 1, nil, ""),
+	}
+	best, ok := Nearest(results, LatLng{0, 0})
+	if !ok || best.PlaceID != "near" {
+		t.Errorf("Expected: near, Got: %s", best.PlaceID)
+	}
+	SortByDistance(results, LatLng{0, 0})
+	got := []string{}
+	for _, r := range results {
+		got = append(got, r.PlaceID)
+	}
+	if fmt.Sprint(got) != "[near mid far]" {
+		t.Errorf("Expected: [near mid far], Got: %v", got)
+	}
+	if _, ok := Nearest(nil, LatLng{}); ok {
+		t.Error("Expected: no nearest result")
+	}
+}
@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket allowing Rate requests per second on
+// average, in bursts of up to Burst. It is safe for concurrent use.
+type RateLimiter struct {
+	Rate  float64
+	Burst int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a full RateLimiter.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{Rate: perSecond, Burst: burst, tokens: float64(burst), last: time.Now()}
+}
+
+// Wait blocks until a request may be made or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	_, err := l.wait(ctx)
+	return err
+}
+
+// wait is Wait, also returning how long it had to wait.
+func (l *RateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.Rate
+	if max := float64(l.Burst); l.tokens > max {
+		l.tokens = max
+	}
+	l.last = now
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 && l.Rate > 0 {
+		wait = time.Duration(-l.tokens / l.Rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+	if wait == 0 {
+		return 0, nil
+	}
+	if err := sleep(ctx, wait); err != nil {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return wait, err
+	}
+	return wait, nil
+}
+
+// WithRateLimit paces the Client's requests to perSecond, in bursts of up
+// to burst.
+func WithRateLimit(perSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.Limiter = NewRateLimiter(perSecond, burst)
+	}
+}
@@ -0,0 +1,30 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(100, 2)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d < 15*time.Millisecond {
+		t.Errorf("Expected: about 20ms for two requests past the burst, Got: %v", d)
+	}
+}
+
+func TestRateLimiterContext(t *testing.T) {
+	l := NewRateLimiter(0.001, 1)
+	l.Wait(context.Background())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected: %v, Got: %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,64 @@
+package geo
+
+import (
+	"sort"
+	"time"
+)
+
+// AddressScanner walks a store of addresses, such as a database table,
+// calling visit with each record's key and address until visit returns
+// false.
+type AddressScanner func(visit func(key string, a *Address) bool) error
+
+// stamp records when a was fetched and, if validity is set, until when it
+// may be kept.
+func (a *Address) stamp(now time.Time, validity time.Duration) {
+	a.FetchedAt = now
+	if validity > 0 {
+		a.ValidUntil = now.Add(validity)
+	}
+}
+
+// Expired reports whether a is past its ValidUntil at now.
+func (a *Address) Expired(now time.Time) bool {
+	return !a.ValidUntil.IsZero() && now.After(a.ValidUntil)
+}
+
+// DueForRefresh reports whether a should be geocoded again at now: it has
+// expired, it was fetched more than maxAge ago (if maxAge is positive), or
+// it was never stamped with a fetch time, as with addresses stored before
+// FetchedAt existed.
+func DueForRefresh(a *Address, now time.Time, maxAge time.Duration) bool {
+	if a.FetchedAt.IsZero() || a.Expired(now) {
+		return true
+	}
+	return maxAge > 0 && now.Sub(a.FetchedAt) > maxAge
+}
+
+// ScanDue runs scan and passes each address DueForRefresh at now to due,
+// stopping early if due returns false. It returns scan's error.
+func ScanDue(scan AddressScanner, now time.Time, maxAge time.Duration, due func(key string, a *Address) bool) error {
+	return scan(func(key string, a *Address) bool {
+		if a == nil || !DueForRefresh(a, now, maxAge) {
+			return true
+		}
+		return due(key, a)
+	})
+}
+
+// Scan is an AddressScanner over the cache's entries, in key order.
+func (c *Cache) Scan(visit func(key string, a *Address) bool) error {
+	c.mu.Lock()
+	entries := make([]*cacheEntry, 0, len(c.entries))
+	for _, el := range c.entries {
+		entries = append(entries, el.Value.(*cacheEntry))
+	}
+	c.mu.Unlock()
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+	for _, e := range entries {
+		if !visit(e.key, e.addr) {
+			break
+		}
+	}
+	return nil
+}
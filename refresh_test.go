@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClientStampsAddresses(t *testing.T) {
+	c, _ := newTestClient(t, `{"status":"OK","results":[{"formatted_address":"Ottawa","geometry":{"location":{"lat":45.42,"lng":-75.69}}}]}`)
+	c.Validity = 30 * 24 * time.Hour
+	before := time.Now()
+	a, err := c.Geocode(context.Background(), "Ottawa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.FetchedAt.Before(before) || a.FetchedAt.After(time.Now()) {
+		t.Errorf("Expected: FetchedAt to be now, Got: %v", a.FetchedAt)
+	}
+	if d := a.ValidUntil.Sub(a.FetchedAt); d != c.Validity {
+		t.Errorf("Expected: %v, Got: %v", c.Validity, d)
+	}
+}
+
+func TestDueForRefresh(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		a        *Address
+		expected bool
+	}{
+		{&Address{}, true},
+		{&Address{FetchedAt: now.Add(-time.Hour)}, false},
+		{&Address{FetchedAt: now.Add(-48 * time.Hour)}, true},
+		{&Address{FetchedAt: now.Add(-time.Hour), ValidUntil: now.Add(-time.Minute)}, true},
+		{&Address{FetchedAt: now.Add(-time.Hour), ValidUntil: now.Add(time.Minute)}, false},
+	}
+	for i, test := range tests {
+		if due := DueForRefresh(test.a, now, 24*time.Hour); due != test.expected {
+			t.Errorf("%d: Expected: %v, Got: %v", i, test.expected, due)
+		}
+	}
+}
+
+func TestScanDue(t *testing.T) {
+	now := time.Now()
+	cache := NewCache(0, 0)
+	cache.Set("fresh", &Address{FetchedAt: now})
+	cache.Set("old", &Address{FetchedAt: now.Add(-90 * 24 * time.Hour)})
+	cache.Set("legacy", &Address{})
+	cache.Set("expired", &Address{FetchedAt: now, ValidUntil: now.Add(-time.Second)})
+
+	due := []string{}
+	err := ScanDue(cache.Scan, now, 30*24*time.Hour, func(key string, a *Address) bool {
+		due = append(due, key)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"expired", "legacy", "old"}; !reflect.DeepEqual(due, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, due)
+	}
+
+	n := 0
+	ScanDue(cache.Scan, now, 0, func(key string, a *Address) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("Expected: the scan to stop after 1, Got: %d", n)
+	}
+}
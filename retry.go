@@ -0,0 +1,230 @@
+package geo
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type (
+	// HTTPError is returned when an API answers with a non-2xx status.
+	// Status holds the API's own status from the body, if it had one;
+	// Body is the start of the body, for diagnostics.
+	HTTPError struct {
+		StatusCode int
+		Status     string
+		Body       string
+		RetryAfter time.Duration
+	}
+
+	// RetryStrategy decides whether and when a failed request is retried.
+	// NextDelay is called after each failure with the number of retries
+	// made so far, from 0, and returns how long to wait before the next
+	// attempt, or false to give up and return err. A Client shares its
+	// strategy between concurrent requests, so implementations must be safe
+	// for concurrent use. Retryable tells which errors are worth retrying.
+	RetryStrategy interface {
+		NextDelay(attempt int, err error) (time.Duration, bool)
+	}
+
+	// RetryPolicy is the exponential RetryStrategy: it retries requests
+	// that fail in transit or with a 429 or 5xx status, up to MaxRetries
+	// times. The wait between attempts doubles from BaseDelay up to
+	// MaxDelay, with jitter, unless the response says otherwise with a
+	// Retry-After header.
+	RetryPolicy struct {
+		MaxRetries int
+		BaseDelay  time.Duration
+		MaxDelay   time.Duration
+	}
+
+	// DecorrelatedJitter retries the same failures as RetryPolicy, waiting
+	// a random time between BaseDelay and three times the previous wait's
+	// bound, up to MaxDelay. Waits spread out more than RetryPolicy's, which
+	// helps when many clients fail at once. Retry-After is honored.
+	DecorrelatedJitter struct {
+		MaxRetries int
+		BaseDelay  time.Duration
+		MaxDelay   time.Duration
+	}
+
+	// FixedBackoff retries the same failures as RetryPolicy, waiting Delay
+	// between attempts, or as long as a Retry-After header asks.
+	FixedBackoff struct {
+		MaxRetries int
+		Delay      time.Duration
+	}
+)
+
+// maxErrorBody bounds how much of a failed response is kept in HTTPError.
+const maxErrorBody = 512
+
+func (e *HTTPError) Error() string {
+	if e.Status != "" {
+		return "HTTP " + strconv.Itoa(e.StatusCode) + " (" + e.Status + ")"
+	}
+	return "HTTP " + strconv.Itoa(e.StatusCode) + ": " + e.Body
+}
+
+// Unwrap exposes the API status as a GeocoderError, so callers matching on
+// GeocoderError with errors.As see it.
+func (e *HTTPError) Unwrap() error {
+	if e.Status == "" {
+		return nil
+	}
+	return &GeocoderError{Status: e.Status}
+}
+
+// Temporary reports whether the request may succeed if retried.
+func (e *HTTPError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// newHTTPError builds the error for a non-2xx response, or returns nil.
+func newHTTPError(resp *http.Response, body []byte) *HTTPError {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	snippet := body
+	if len(snippet) > maxErrorBody {
+		snippet = snippet[:maxErrorBody]
+	}
+	return &HTTPError{
+		StatusCode: resp.StatusCode,
+		Status:     responseStatus(body),
+		Body:       string(snippet),
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// retryAfter parses a Retry-After header given in either seconds or as an
+// HTTP date.
+func retryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if s, err := strconv.Atoi(v); err == nil && s > 0 {
+		return time.Duration(s) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// WithRetry makes the Client retry failed requests according to p.
+func WithRetry(p RetryPolicy) ClientOption {
+	return WithRetryStrategy(&p)
+}
+
+// WithRetryStrategy makes the Client retry failed requests according to s.
+func WithRetryStrategy(s RetryStrategy) ClientOption {
+	return func(c *Client) {
+		c.Retry = s
+	}
+}
+
+// Retryable reports whether err is worth another attempt: the request
+// failed in transit, or with a 429 or 5xx status.
+func Retryable(err error) bool {
+	if err == RemoteServerError {
+		return true
+	}
+	he, ok := err.(*HTTPError)
+	return ok && he.Temporary()
+}
+
+// retryAfterDelay returns the wait err's Retry-After header asks for,
+// capped at max if max is set.
+func retryAfterDelay(err error, max time.Duration) (time.Duration, bool) {
+	he, ok := err.(*HTTPError)
+	if !ok || he.RetryAfter <= 0 {
+		return 0, false
+	}
+	if max > 0 && he.RetryAfter > max {
+		return max, true
+	}
+	return he.RetryAfter, true
+}
+
+// NextDelay implements RetryStrategy.
+func (p *RetryPolicy) NextDelay(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxRetries || !Retryable(err) {
+		return 0, false
+	}
+	return p.delay(attempt, err), true
+}
+
+// delay returns how long to wait before retry number n (from 0) after err.
+func (p *RetryPolicy) delay(n int, err error) time.Duration {
+	if d, ok := retryAfterDelay(err, p.MaxDelay); ok {
+		return d
+	}
+	d := p.BaseDelay << uint(n)
+	if p.MaxDelay > 0 && (d > p.MaxDelay || d <= 0) {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// NextDelay implements RetryStrategy. The strategy is shared between
+// requests, so rather than remembering the previous wait it draws from the
+// range that wait would have been drawn from, BaseDelay to BaseDelay·3ⁿ.
+func (p *DecorrelatedJitter) NextDelay(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxRetries || !Retryable(err) {
+		return 0, false
+	}
+	if d, ok := retryAfterDelay(err, p.MaxDelay); ok {
+		return d, true
+	}
+	hi := p.BaseDelay
+	for i := 0; i < attempt && (p.MaxDelay <= 0 || hi < p.MaxDelay); i++ {
+		if hi > math.MaxInt64/3 {
+			hi = math.MaxInt64
+			break
+		}
+		hi *= 3
+	}
+	if p.MaxDelay > 0 && hi > p.MaxDelay {
+		hi = p.MaxDelay
+	}
+	if hi <= p.BaseDelay {
+		return hi, true
+	}
+	return p.BaseDelay + time.Duration(rand.Int63n(int64(hi-p.BaseDelay)+1)), true
+}
+
+// NextDelay implements RetryStrategy.
+func (p *FixedBackoff) NextDelay(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxRetries || !Retryable(err) {
+		return 0, false
+	}
+	if d, ok := retryAfterDelay(err, 0); ok {
+		return d, true
+	}
+	return p.Delay, true
+}
+
+var (
+	_ RetryStrategy = (*RetryPolicy)(nil)
+	_ RetryStrategy = (*DecorrelatedJitter)(nil)
+	_ RetryStrategy = (*FixedBackoff)(nil)
+)
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
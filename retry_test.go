@@ -0,0 +1,164 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newFlakyClient returns a Client whose server answers the first failures
+// requests with status and then succeeds, counting the requests made.
+func newFlakyClient(t *testing.T, failures, status int, header http.Header) (*Client, *int) {
+	n := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if n <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"error": {"code": 429, "status": "RESOURCE_EXHAUSTED"}}`))
+			return
+		}
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient("test-key", WithBaseURL(srv.URL)), &n
+}
+
+func TestClientHTTPError(t *testing.T) {
+	c, _ := newFlakyClient(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"7"}})
+	var out struct{}
+	err := c.getJSON(context.Background(), "/", map[string][]string{}, &out)
+	he, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("Expected: *HTTPError, Got: %v", err)
+	}
+	if he.StatusCode != 429 || he.RetryAfter != 7*time.Second || !he.Temporary() {
+		t.Errorf("Unexpected error: %+v", he)
+	}
+	var ge *GeocoderError
+	if !errors.As(err, &ge) || ge.Status != "RESOURCE_EXHAUSTED" {
+		t.Errorf("Expected: RESOURCE_EXHAUSTED, Got: %v", ge)
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	c, n := newFlakyClient(t, 2, http.StatusServiceUnavailable, nil)
+	WithRetry(RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond})(c)
+	var out struct{ Status string }
+	if err := c.getJSON(context.Background(), "/", map[string][]string{}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if *n != 3 || out.Status != StatusOk {
+		t.Errorf("Expected: 3 attempts, Got: %d", *n)
+	}
+}
+
+func TestClientRetryGivesUp(t *testing.T) {
+	c, n := newFlakyClient(t, 5, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+	WithRetry(RetryPolicy{MaxRetries: 2, MaxDelay: time.Millisecond})(c)
+	var out struct{}
+	if _, ok := c.getJSON(context.Background(), "/", map[string][]string{}, &out).(*HTTPError); !ok || *n != 3 {
+		t.Errorf("Expected: HTTPError after 3 attempts, Got: %d attempts", *n)
+	}
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	c, n := newFlakyClient(t, 1, http.StatusForbidden, nil)
+	WithRetry(RetryPolicy{MaxRetries: 3})(c)
+	var out struct{}
+	c.getJSON(context.Background(), "/", map[string][]string{}, &out)
+	if *n != 1 {
+		t.Errorf("Expected: 1, Got: %d", *n)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for v, want := range map[string]time.Duration{
+		"":                              0,
+		"30":                            30 * time.Second,
+		"Wed, 01 Jan 2020 00:01:00 GMT": time.Minute,
+		"soon":                          0,
+	} {
+		if got := retryAfter(v, now); got != want {
+			t.Errorf("Expected: %v, Got: %v", want, got)
+		}
+	}
+}
+
+func TestRetryStrategies(t *testing.T) {
+	unavailable := &HTTPError{StatusCode: http.StatusServiceUnavailable}
+	throttled := &HTTPError{StatusCode: http.StatusTooManyRequests, RetryAfter: 500 * time.Millisecond}
+	forbidden := &HTTPError{StatusCode: http.StatusForbidden}
+	strategies := map[string]RetryStrategy{
+		"exponential":  &RetryPolicy{MaxRetries: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second},
+		"decorrelated": &DecorrelatedJitter{MaxRetries: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second},
+		"fixed":        &FixedBackoff{MaxRetries: 3, Delay: 10 * time.Millisecond},
+	}
+	for name, s := range strategies {
+		for n := 0; n < 3; n++ {
+			d, ok := s.NextDelay(n, unavailable)
+			if !ok || d < 0 || d > time.Second {
+				t.Errorf("%s: Expected: a retry within a second, Got: %v, %v", name, d, ok)
+			}
+		}
+		if _, ok := s.NextDelay(3, unavailable); ok {
+			t.Errorf("%s: Expected: no retry after MaxRetries", name)
+		}
+		if _, ok := s.NextDelay(0, forbidden); ok {
+			t.Errorf("%s: Expected: client errors not retried", name)
+		}
+		if d, ok := s.NextDelay(0, throttled); !ok || d != 500*time.Millisecond {
+			t.Errorf("%s: Expected: 500ms from Retry-After, Got: %v", name, d)
+		}
+	}
+}
+
+func TestDecorrelatedJitterBounds(t *testing.T) {
+	s := &DecorrelatedJitter{MaxRetries: 100, BaseDelay: 10 * time.Millisecond, MaxDelay: 200 * time.Millisecond}
+	unavailable := &HTTPError{StatusCode: http.StatusBadGateway}
+	for n := 0; n < 100; n++ {
+		hi := 10 * time.Millisecond
+		for i := 0; i < n && hi < s.MaxDelay; i++ {
+			hi *= 3
+		}
+		if hi > s.MaxDelay {
+			hi = s.MaxDelay
+		}
+		if d, _ := s.NextDelay(n, unavailable); d < s.BaseDelay || d > hi {
+			t.Errorf("Expected: %v between %v and %v, Got: %v", n, s.BaseDelay, hi, d)
+		}
+	}
+	if d, _ := (&DecorrelatedJitter{MaxRetries: 100, BaseDelay: time.Second}).NextDelay(99, unavailable); d < time.Second {
+		t.Errorf("Expected: no overflow without MaxDelay, Got: %v", d)
+	}
+}
+
+// countingStrategy retries while attempts remain, recording each call.
+type countingStrategy struct {
+	max   int
+	calls []int
+}
+
+func (s *countingStrategy) NextDelay(attempt int, err error) (time.Duration, bool) {
+	s.calls = append(s.calls, attempt)
+	return 0, attempt < s.max
+}
+
+func TestClientCustomRetryStrategy(t *testing.T) {
+	c, n := newFlakyClient(t, 5, http.StatusForbidden, nil)
+	s := &countingStrategy{max: 2}
+	WithRetryStrategy(s)(c)
+	var out struct{}
+	if _, ok := c.getJSON(context.Background(), "/", map[string][]string{}, &out).(*HTTPError); !ok {
+		t.Error("Expected: the last HTTPError")
+	}
+	if *n != 3 || len(s.calls) != 3 || s.calls[2] != 2 {
+		t.Errorf("Expected: 3 attempts and calls for retries 0-2, Got: %d attempts, calls %v", *n, s.calls)
+	}
+}
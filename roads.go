@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+)
+
+// MaxRoadsPoints is the most points the Roads API accepts in one request.
+const MaxRoadsPoints = 100
+
+type (
+	// SnappedPoint is a point moved onto the road network. OriginalIndex is
+	// the index of the input point it came from, or -1 for points added by
+	// interpolation.
+	SnappedPoint struct {
+		Location      LatLng
+		OriginalIndex int
+		PlaceID       string
+	}
+
+	roadsResponse struct {
+		SnappedPoints []struct {
+			Location struct {
+				Latitude  float64 `json:"latitude"`
+				Longitude float64 `json:"longitude"`
+			} `json:"location"`
+			OriginalIndex *int   `json:"originalIndex"`
+			PlaceID       string `json:"placeId"`
+		} `json:"snappedPoints"`
+		Error *googleAPIError `json:"error"`
+	}
+)
+
+// SnapToRoads snaps a GPS trace to the roads it most likely followed. With
+// interpolate set, extra points are added so the result follows road
+// geometry smoothly.
+func (c *Client) SnapToRoads(ctx context.Context, path []LatLng, interpolate bool) ([]SnappedPoint, error) {
+	if len(path) == 0 {
+		return nil, NoPointsError
+	}
+	if err := validatePoints("path", path, MaxRoadsPoints); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("path", latLngsParam(path))
+	if interpolate {
+		params.Set("interpolate", "true")
+	}
+	return c.roads(ctx, "/v1/snapToRoads", params)
+}
+
+// NearestRoads returns the nearest road segment for each of points
+// independently, without treating them as a path.
+func (c *Client) NearestRoads(ctx context.Context, points []LatLng) ([]SnappedPoint, error) {
+	if len(points) == 0 {
+		return nil, NoPointsError
+	}
+	if err := validatePoints("points", points, MaxRoadsPoints); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("points", latLngsParam(points))
+	return c.roads(ctx, "/v1/nearestRoads", params)
+}
+
+func (c *Client) roads(ctx context.Context, path string, params url.Values) ([]SnappedPoint, error) {
+	var r roadsResponse
+	if err := c.getJSONFrom(ctx, roadsAPIHost, path, params, &r); err != nil {
+		return nil, err
+	}
+	if err := r.Error.err(); err != nil {
+		return nil, err
+	}
+	points := make([]SnappedPoint, len(r.SnappedPoints))
+	for i, p := range r.SnappedPoints {
+		points[i] = SnappedPoint{
+			Location:      LatLng{Lat: p.Location.Latitude, Lng: p.Location.Longitude},
+			OriginalIndex: -1,
+			PlaceID:       p.PlaceID,
+		}
+		if p.OriginalIndex != nil {
+			points[i].OriginalIndex = *p.OriginalIndex
+		}
+	}
+	return points, nil
+}
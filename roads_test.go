@@ -0,0 +1,74 @@
+package geo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSnapToRoads(t *testing.T) {
+	c, last := newTestClient(t, `{"snappedPoints": [
+		{"location": {"latitude": -35.2784167, "longitude": 149.1294692}, "originalIndex": 0, "placeId": "ChIJoR7CemhNFmsRQB9QbW7qABM"},
+		{"location": {"latitude": -35.280321, "longitude": 149.1293261}, "placeId": "ChIJiy6YT2hNFmsRkHZAbW7qABM"},
+		{"location": {"latitude": -35.2803215, "longitude": 149.1293212}, "originalIndex": 1, "placeId": "ChIJiy6YT2hNFmsRkHZAbW7qABM"}
+	]}`)
+	points, err := c.SnapToRoads(context.Background(), []LatLng{{-35.27801, 149.12958}, {-35.28032, 149.12907}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if q.Get("path") != "-35.27801,149.12958|-35.28032,149.12907" || q.Get("interpolate") != "true" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+	if (*last).URL.Path != "/v1/snapToRoads" {
+		t.Errorf("Unexpected path: %s", (*last).URL.Path)
+	}
+	if len(points) != 3 || points[0].OriginalIndex != 0 || points[1].OriginalIndex != -1 || points[2].OriginalIndex != 1 {
+		t.Errorf("Unexpected points: %+v", points)
+	}
+	if points[0].Location.Lat != -35.2784167 {
+		t.Errorf("Unexpected location: %v", points[0].Location)
+	}
+}
+
+func TestNearestRoadsError(t *testing.T) {
+	c, _ := newTestClient(t, `{"error": {"code": 400, "message": "Invalid request.", "status": "INVALID_ARGUMENT"}}`)
+	_, err := c.NearestRoads(context.Background(), []LatLng{{0, 0}})
+	if ge, ok := err.(*GeocoderError); !ok || ge.Status != "INVALID_ARGUMENT" {
+		t.Errorf("Expected: INVALID_ARGUMENT, Got: %v", err)
+	}
+}
+
+func TestRoadsValidation(t *testing.T) {
+	c, last := newTestClient(t, `{"snappedPoints": []}`)
+	snap := func(points []LatLng) error {
+		_, err := c.SnapToRoads(context.Background(), points, false)
+		return err
+	}
+	nearest := func(points []LatLng) error {
+		_, err := c.NearestRoads(context.Background(), points)
+		return err
+	}
+	tests := []struct {
+		name   string
+		call   func([]LatLng) error
+		points []LatLng
+		field  string
+	}{
+		{"SnapToRoads", snap, []LatLng{{0, 0}, {0, 181}}, "path"},
+		{"SnapToRoads", snap, make([]LatLng, MaxRoadsPoints+1), "path"},
+		{"NearestRoads", nearest, []LatLng{{-91, 0}}, "points"},
+		{"NearestRoads", nearest, make([]LatLng, MaxRoadsPoints+1), "points"},
+	}
+	for _, test := range tests {
+		err := test.call(test.points)
+		if ie, ok := err.(*InvalidInputError); !ok || ie.Field != test.field {
+			t.Errorf("%s with %d points Expected: invalid %s, Got: %v", test.name, len(test.points), test.field, err)
+		}
+	}
+	if *last != nil {
+		t.Errorf("Expected: no requests for invalid input, Got: %v", (*last).URL)
+	}
+	if _, err := c.NearestRoads(context.Background(), make([]LatLng, MaxRoadsPoints)); err != nil {
+		t.Errorf("Expected: %d points to be allowed, Got: %v", MaxRoadsPoints, err)
+	}
+}
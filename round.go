@@ -0,0 +1,63 @@
+package geo
+
+import "math"
+
+// Decimal places of a coordinate and the distance they resolve at the
+// equator, for choosing a precision when rounding or truncating:
+//
+//	decimals  resolution  enough to identify
+//	1         11 km       a large city
+//	2         1.1 km      a village or neighbourhood
+//	3         110 m       a large field or city block
+//	4         11 m        a parcel of land
+//	5         1.1 m       a tree or a doorway
+//	6         0.11 m      surveying-grade detail
+//
+// Away from the equator a degree of longitude shrinks with the cosine of the
+// latitude, so the east-west resolution is finer than listed.
+const (
+	CityDecimals          = 1
+	NeighbourhoodDecimals = 2
+	StreetDecimals        = 3
+	ParcelDecimals        = 4
+	DoorwayDecimals       = 5
+)
+
+// metersPerDegree is the length of one degree of latitude.
+const metersPerDegree = EarthRadius * math.Pi / 180
+
+// Round returns l with both coordinates rounded to decimals places, for
+// keying and deduplicating nearby points consistently.
+func (l LatLng) Round(decimals int) LatLng {
+	p := math.Pow(10, float64(decimals))
+	return LatLng{Lat: math.Round(l.Lat*p) / p, Lng: math.Round(l.Lng*p) / p}
+}
+
+// Truncate returns l with both coordinates truncated towards zero to
+// decimals places. Unlike Round it never moves a point into a neighbouring
+// cell, which makes it the usual choice for coarsening locations for
+// privacy: every point in a cell maps to the same corner.
+func (l LatLng) Truncate(decimals int) LatLng {
+	p := math.Pow(10, float64(decimals))
+	return LatLng{Lat: math.Trunc(l.Lat*p) / p, Lng: math.Trunc(l.Lng*p) / p}
+}
+
+// Equal reports whether l and o are within tolerance of each other. A
+// tolerance of zero requires the coordinates to be identical.
+func (l LatLng) Equal(o LatLng, tolerance Distance) bool {
+	if l == o {
+		return true
+	}
+	return l.Distance(o) <= tolerance && tolerance > 0
+}
+
+// DecimalsFor returns the fewest decimal places that resolve d, per the
+// table above, capped at 15.
+func DecimalsFor(d Distance) int {
+	for n := 0; n < 15; n++ {
+		if metersPerDegree/math.Pow(10, float64(n)) <= d.Meters() {
+			return n
+		}
+	}
+	return 15
+}
@@ -0,0 +1,44 @@
+package geo
+
+import "testing"
+
+func TestRoundAndTruncate(t *testing.T) {
+	ll := LatLng{45.501689, -73.567256}
+	if got := ll.Round(StreetDecimals); got != (LatLng{45.502, -73.567}) {
+		t.Errorf("Expected: {45.502 -73.567}, Got: %v", got)
+	}
+	if got := ll.Truncate(StreetDecimals); got != (LatLng{45.501, -73.567}) {
+		t.Errorf("Expected: {45.501 -73.567}, Got: %v", got)
+	}
+	if got := ll.Round(0); got != (LatLng{46, -74}) {
+		t.Errorf("Expected: {46 -74}, Got: %v", got)
+	}
+}
+
+func TestLatLngEqual(t *testing.T) {
+	a := LatLng{45.5, -73.6}
+	b := LatLng{45.50005, -73.6}
+	if !a.Equal(a, 0) {
+		t.Error("Expected: a point to equal itself")
+	}
+	if a.Equal(b, 0) || a.Equal(b, 5) {
+		t.Error("Expected: points 5.6m apart to differ at 5m")
+	}
+	if !a.Equal(b, 6) {
+		t.Error("Expected: points 5.6m apart to match at 6m")
+	}
+}
+
+func TestDecimalsFor(t *testing.T) {
+	for d, want := range map[Distance]int{
+		20 * Kilometer: 1,
+		Kilometer:      3,
+		12 * Meter:     4,
+		Meter:          6,
+		1e-100:         15,
+	} {
+		if got := DecimalsFor(d); got != want {
+			t.Errorf("DecimalsFor(%v) Expected: %d, Got: %d", d, want, got)
+		}
+	}
+}
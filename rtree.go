@@ -0,0 +1,314 @@
+package geo
+
+import "math"
+
+type (
+	// Shape is an area that can be stored in an RTree. BoundingBox and Polygon
+	// both implement it.
+	Shape interface {
+		Bounds() BoundingBox
+		Contains(LatLng) bool
+	}
+
+	// RTreeEntry is a shape stored in an RTree along with its value.
+	RTreeEntry struct {
+		Shape Shape
+		Value interface{}
+	}
+
+	// RTree indexes shapes by their bounding boxes so that point and box
+	// queries only examine shapes that could match. It is not safe for
+	// concurrent modification.
+	RTree struct {
+		root *rtreeNode
+		size int
+	}
+
+	rtreeRect struct {
+		minLat, minLng, maxLat, maxLng float64
+	}
+
+	rtreeNode struct {
+		leaf     bool
+		rect     rtreeRect
+		children []*rtreeNode
+		entries  []*rtreeLeafEntry
+		parent   *rtreeNode
+	}
+
+	rtreeLeafEntry struct {
+		rect  rtreeRect
+		entry *RTreeEntry
+	}
+)
+
+const (
+	rtreeMaxEntries = 16
+	rtreeMinEntries = 4
+)
+
+// Bounds returns b, so that a BoundingBox can be used as a Shape.
+func (b BoundingBox) Bounds() BoundingBox {
+	return b
+}
+
+// NewRTree returns an empty RTree.
+func NewRTree() *RTree {
+	return &RTree{root: &rtreeNode{leaf: true}}
+}
+
+// Len returns the number of shapes in the tree.
+func (t *RTree) Len() int {
+	return t.size
+}
+
+// Insert adds s to the tree.
+func (t *RTree) Insert(s Shape, value interface{}) {
+	e := &RTreeEntry{Shape: s, Value: value}
+	for _, r := range rectsFor(s.Bounds()) {
+		t.insert(&rtreeLeafEntry{rect: r, entry: e})
+	}
+	t.size++
+}
+
+// Remove deletes the first shape stored with value, reporting whether one
+// was found. Values must be comparable.
+func (t *RTree) Remove(value interface{}) bool {
+	var target *RTreeEntry
+	t.walk(t.root, func(n *rtreeNode) {
+		for _, le := range n.entries {
+			if target == nil && le.entry.Value == value {
+				target = le.entry
+			}
+		}
+	})
+	if target == nil {
+		return false
+	}
+	t.walk(t.root, func(n *rtreeNode) {
+		kept := n.entries[:0]
+		for _, le := range n.entries {
+			if le.entry != target {
+				kept = append(kept, le)
+			}
+		}
+		n.entries = kept
+	})
+	t.prune(t.root)
+	t.size--
+	return true
+}
+
+// Containing returns every shape that contains ll.
+func (t *RTree) Containing(ll LatLng) []RTreeEntry {
+	q := []rtreeRect{{ll.Lat, ll.Lng, ll.Lat, ll.Lng}}
+	return t.search(q, func(e *RTreeEntry) bool { return e.Shape.Contains(ll) })
+}
+
+// Intersecting returns every shape whose bounding box overlaps b.
+func (t *RTree) Intersecting(b BoundingBox) []RTreeEntry {
+	return t.search(rectsFor(b), nil)
+}
+
+func (t *RTree) search(qs []rtreeRect, match func(*RTreeEntry) bool) []RTreeEntry {
+	out := []RTreeEntry{}
+	seen := map[*RTreeEntry]bool{}
+	var visit func(n *rtreeNode, q rtreeRect)
+	visit = func(n *rtreeNode, q rtreeRect) {
+		if n.leaf {
+			for _, le := range n.entries {
+				if le.rect.intersects(q) && !seen[le.entry] && (match == nil || match(le.entry)) {
+					seen[le.entry] = true
+					out = append(out, *le.entry)
+				}
+			}
+			return
+		}
+		for _, c := range n.children {
+			if c.rect.intersects(q) {
+				visit(c, q)
+			}
+		}
+	}
+	for _, q := range qs {
+		visit(t.root, q)
+	}
+	return out
+}
+
+func (t *RTree) insert(le *rtreeLeafEntry) {
+	n := t.root
+	for !n.leaf {
+		best := n.children[0]
+		bestGrowth, bestArea := math.Inf(1), math.Inf(1)
+		for _, c := range n.children {
+			area := c.rect.area()
+			growth := c.rect.union(le.rect).area() - area
+			if growth < bestGrowth || (growth == bestGrowth && area < bestArea) {
+				best, bestGrowth, bestArea = c, growth, area
+			}
+		}
+		n = best
+	}
+	n.entries = append(n.entries, le)
+	t.adjust(n)
+}
+
+// adjust recomputes bounds from n up to the root, splitting overfull nodes
+// on the way.
+func (t *RTree) adjust(n *rtreeNode) {
+	for n != nil {
+		var sibling *rtreeNode
+		if len(n.entries) > rtreeMaxEntries || len(n.children) > rtreeMaxEntries {
+			sibling = n.split()
+		}
+		n.recompute()
+		if sibling != nil {
+			sibling.recompute()
+			if n.parent == nil {
+				t.root = &rtreeNode{children: []*rtreeNode{n, sibling}}
+				n.parent, sibling.parent = t.root, t.root
+				t.root.recompute()
+				return
+			}
+			sibling.parent = n.parent
+			n.parent.children = append(n.parent.children, sibling)
+		}
+		n = n.parent
+	}
+}
+
+// split moves roughly half of n's contents into a new node using Guttman's
+// quadratic split.
+func (n *rtreeNode) split() *rtreeNode {
+	rects := []rtreeRect{}
+	if n.leaf {
+		for _, le := range n.entries {
+			rects = append(rects, le.rect)
+		}
+	} else {
+		for _, c := range n.children {
+			rects = append(rects, c.rect)
+		}
+	}
+
+	seedA, seedB, worst := 0, 1, math.Inf(-1)
+	for i := range rects {
+		for j := i + 1; j < len(rects); j++ {
+			if d := rects[i].union(rects[j]).area() - rects[i].area() - rects[j].area(); d > worst {
+				seedA, seedB, worst = i, j, d
+			}
+		}
+	}
+
+	groupA, groupB := []int{seedA}, []int{seedB}
+	rectA, rectB := rects[seedA], rects[seedB]
+	for i := range rects {
+		if i == seedA || i == seedB {
+			continue
+		}
+		remaining := len(rects) - len(groupA) - len(groupB)
+		switch {
+		case len(groupA)+remaining <= rtreeMinEntries:
+			groupA, rectA = append(groupA, i), rectA.union(rects[i])
+		case len(groupB)+remaining <= rtreeMinEntries:
+			groupB, rectB = append(groupB, i), rectB.union(rects[i])
+		case rectA.union(rects[i]).area()-rectA.area() <= rectB.union(rects[i]).area()-rectB.area():
+			groupA, rectA = append(groupA, i), rectA.union(rects[i])
+		default:
+			groupB, rectB = append(groupB, i), rectB.union(rects[i])
+		}
+	}
+
+	sibling := &rtreeNode{leaf: n.leaf}
+	if n.leaf {
+		entries := n.entries
+		n.entries = nil
+		for _, i := range groupA {
+			n.entries = append(n.entries, entries[i])
+		}
+		for _, i := range groupB {
+			sibling.entries = append(sibling.entries, entries[i])
+		}
+	} else {
+		children := n.children
+		n.children = nil
+		for _, i := range groupA {
+			n.children = append(n.children, children[i])
+		}
+		for _, i := range groupB {
+			children[i].parent = sibling
+			sibling.children = append(sibling.children, children[i])
+		}
+	}
+	return sibling
+}
+
+func (n *rtreeNode) recompute() {
+	first := true
+	extend := func(r rtreeRect) {
+		if first {
+			n.rect, first = r, false
+		} else {
+			n.rect = n.rect.union(r)
+		}
+	}
+	for _, le := range n.entries {
+		extend(le.rect)
+	}
+	for _, c := range n.children {
+		extend(c.rect)
+	}
+}
+
+func (t *RTree) walk(n *rtreeNode, visit func(*rtreeNode)) {
+	visit(n)
+	for _, c := range n.children {
+		t.walk(c, visit)
+	}
+}
+
+// prune drops empty nodes below n and recomputes bounds, returning whether n
+// itself is now empty.
+func (t *RTree) prune(n *rtreeNode) bool {
+	if !n.leaf {
+		kept := n.children[:0]
+		for _, c := range n.children {
+			if !t.prune(c) {
+				kept = append(kept, c)
+			}
+		}
+		n.children = kept
+	}
+	n.recompute()
+	empty := len(n.entries) == 0 && len(n.children) == 0
+	if n == t.root && empty {
+		t.root = &rtreeNode{leaf: true}
+	}
+	return empty
+}
+
+// rectsFor converts a BoundingBox into planar rectangles, splitting boxes that
+// cross the antimeridian in two.
+func rectsFor(b BoundingBox) []rtreeRect {
+	out := []rtreeRect{}
+	for _, r := range b.lngRanges() {
+		out = append(out, rtreeRect{b.Southwest.Lat, r[0], b.Northeast.Lat, r[1]})
+	}
+	return out
+}
+
+func (r rtreeRect) intersects(o rtreeRect) bool {
+	return r.minLat <= o.maxLat && o.minLat <= r.maxLat && r.minLng <= o.maxLng && o.minLng <= r.maxLng
+}
+
+func (r rtreeRect) union(o rtreeRect) rtreeRect {
+	return rtreeRect{
+		math.Min(r.minLat, o.minLat), math.Min(r.minLng, o.minLng),
+		math.Max(r.maxLat, o.maxLat), math.Max(r.maxLng, o.maxLng),
+	}
+}
+
+func (r rtreeRect) area() float64 {
+	return (r.maxLat - r.minLat) * (r.maxLng - r.minLng)
+}
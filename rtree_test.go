@@ -0,0 +1,87 @@
+package geo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRTree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := NewRTree()
+	boxes := []BoundingBox{}
+	for i := 0; i < 500; i++ {
+		lat, lng := r.Float64()*160-80, r.Float64()*340-170
+		b := BoundingBox{LatLng{lat, lng}, LatLng{lat + r.Float64()*5, lng + r.Float64()*5}}
+		boxes = append(boxes, b)
+		tree.Insert(b, i)
+	}
+	if tree.Len() != 500 {
+		t.Errorf("Expected: 500, Got: %d", tree.Len())
+	}
+
+	for i := 0; i < 100; i++ {
+		p := LatLng{r.Float64()*160 - 80, r.Float64()*340 - 170}
+		expected := map[int]bool{}
+		for j, b := range boxes {
+			if b.Contains(p) {
+				expected[j] = true
+			}
+		}
+		got := tree.Containing(p)
+		if len(got) != len(expected) {
+			t.Errorf("Expected: %d matches, Got: %d", len(expected), len(got))
+		}
+		for _, e := range got {
+			if !expected[e.Value.(int)] {
+				t.Errorf("Unexpected match %v", e.Value)
+			}
+		}
+	}
+
+	q := BoundingBox{LatLng{0, 0}, LatLng{20, 20}}
+	expected := 0
+	for _, b := range boxes {
+		if b.Intersects(q) {
+			expected++
+		}
+	}
+	if got := tree.Intersecting(q); len(got) != expected {
+		t.Errorf("Expected: %d, Got: %d", expected, len(got))
+	}
+
+	for i := 0; i < 500; i += 2 {
+		if !tree.Remove(i) {
+			t.Fatalf("Expected to remove %d", i)
+		}
+	}
+	if tree.Len() != 250 || tree.Remove(0) {
+		t.Errorf("Expected 250 entries after removal, Got: %d", tree.Len())
+	}
+	for _, e := range tree.Intersecting(BoundingBox{LatLng{-90, -180}, LatLng{90, 180}}) {
+		if e.Value.(int)%2 == 0 {
+			t.Errorf("Expected %v to be removed", e.Value)
+		}
+	}
+}
+
+func TestRTreePolygons(t *testing.T) {
+	tree := NewRTree()
+	triangle := Polygon{{0, 0}, {10, 0}, {0, 10}}
+	tree.Insert(triangle, "triangle")
+	// A fence crossing the antimeridian.
+	tree.Insert(BoundingBox{LatLng{-10, 170}, LatLng{10, -170}}, "pacific")
+
+	if got := tree.Containing(LatLng{2, 2}); len(got) != 1 || got[0].Value != "triangle" {
+		t.Errorf("Expected triangle, Got: %v", got)
+	}
+	// Inside the triangle's bounds but outside the triangle itself.
+	if got := tree.Containing(LatLng{9, 9}); len(got) != 0 {
+		t.Errorf("Expected no match, Got: %v", got)
+	}
+	if got := tree.Containing(LatLng{0, -175}); len(got) != 1 || got[0].Value != "pacific" {
+		t.Errorf("Expected pacific, Got: %v", got)
+	}
+	if got := tree.Intersecting(BoundingBox{LatLng{-1, 160}, LatLng{1, -160}}); len(got) != 1 {
+		t.Errorf("Expected one match, Got: %v", got)
+	}
+}
@@ -0,0 +1,355 @@
+package geo
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// S2CellID identifies a cell in Google's S2 hierarchical decomposition of the
+// sphere. The values match those produced by the reference S2 library and by
+// BigQuery's S2_CELLIDFROMPOINT (as a signed int64).
+type S2CellID uint64
+
+const (
+	S2MaxLevel = 30
+
+	s2FaceBits   = 3
+	s2PosBits    = 2*S2MaxLevel + 1
+	s2MaxSize    = 1 << S2MaxLevel
+	s2LookupBits = 4
+	s2SwapMask   = 0x01
+	s2InvertMask = 0x02
+)
+
+var (
+	s2PosToIJ          = [4][4]int{{0, 1, 3, 2}, {0, 2, 3, 1}, {3, 2, 0, 1}, {3, 1, 0, 2}}
+	s2PosToOrientation = [4]int{s2SwapMask, 0, 0, s2InvertMask | s2SwapMask}
+	s2LookupPos        [1 << (2*s2LookupBits + 2)]int
+	s2LookupIJ         [1 << (2*s2LookupBits + 2)]int
+)
+
+func init() {
+	s2InitLookupCell(0, 0, 0, 0, 0, 0)
+	s2InitLookupCell(0, 0, 0, s2SwapMask, 0, s2SwapMask)
+	s2InitLookupCell(0, 0, 0, s2InvertMask, 0, s2InvertMask)
+	s2InitLookupCell(0, 0, 0, s2SwapMask|s2InvertMask, 0, s2SwapMask|s2InvertMask)
+}
+
+func s2InitLookupCell(level, i, j, origOrientation, pos, orientation int) {
+	if level == s2LookupBits {
+		ij := (i << s2LookupBits) + j
+		s2LookupPos[(ij<<2)+origOrientation] = (pos << 2) + orientation
+		s2LookupIJ[(pos<<2)+origOrientation] = (ij << 2) + orientation
+		return
+	}
+	level++
+	i <<= 1
+	j <<= 1
+	pos <<= 2
+	r := s2PosToIJ[orientation]
+	for k := 0; k < 4; k++ {
+		s2InitLookupCell(level, i+(r[k]>>1), j+(r[k]&1), origOrientation, pos+k, orientation^s2PosToOrientation[k])
+	}
+}
+
+// S2CellIDAt returns the cell at the given level (0-30) containing ll.
+func S2CellIDAt(ll LatLng, level int) S2CellID {
+	face, u, v := s2XYZToFaceUV(latLngToXYZ(ll))
+	i := s2STToIJ(s2UVToST(u))
+	j := s2STToIJ(s2UVToST(v))
+	return s2CellIDFromFaceIJ(face, i, j).Parent(level)
+}
+
+// Face returns the cube face (0-5) the cell lies on.
+func (c S2CellID) Face() int {
+	return int(uint64(c) >> s2PosBits)
+}
+
+// Level returns the subdivision level of the cell, from 0 (a cube face) to 30.
+func (c S2CellID) Level() int {
+	return S2MaxLevel - bits.TrailingZeros64(uint64(c))>>1
+}
+
+// IsValid reports whether c is a well-formed cell ID.
+func (c S2CellID) IsValid() bool {
+	return c.Face() < 6 && uint64(c)&s2LSB(uint64(c))&0x1555555555555555 != 0
+}
+
+// Parent returns the ancestor of c at the given level.
+func (c S2CellID) Parent(level int) S2CellID {
+	lsb := uint64(1) << uint(2*(S2MaxLevel-level))
+	return S2CellID((uint64(c) & -lsb) | lsb)
+}
+
+// Children returns the four cells one level below c.
+func (c S2CellID) Children() [4]S2CellID {
+	lsb := s2LSB(uint64(c))
+	child := S2CellID(uint64(c) - lsb + lsb>>2)
+	var out [4]S2CellID
+	for k := range out {
+		out[k] = child
+		child += S2CellID(lsb >> 1)
+	}
+	return out
+}
+
+// Contains reports whether o is c or one of its descendants.
+func (c S2CellID) Contains(o S2CellID) bool {
+	lsb := s2LSB(uint64(c))
+	return uint64(o) >= uint64(c)-(lsb-1) && uint64(o) <= uint64(c)+(lsb-1)
+}
+
+// Token returns the compact hex representation of c used by most S2 tooling.
+func (c S2CellID) Token() string {
+	if c == 0 {
+		return "X"
+	}
+	s := strconv.FormatUint(uint64(c), 16)
+	return strings.TrimRight(strings.Repeat("0", 16-len(s))+s, "0")
+}
+
+// S2CellIDFromToken parses a token produced by Token. Malformed tokens yield
+// the invalid cell ID 0.
+func S2CellIDFromToken(token string) S2CellID {
+	if len(token) > 16 {
+		return 0
+	}
+	id, err := strconv.ParseUint(token+strings.Repeat("0", 16-len(token)), 16, 64)
+	if err != nil {
+		return 0
+	}
+	return S2CellID(id)
+}
+
+// Center returns the centre point of the cell.
+func (c S2CellID) Center() LatLng {
+	face, s0, t0, size := c.faceST()
+	return xyzToLatLng(s2FaceUVToXYZ(face, s2STToUV(s0+size/2), s2STToUV(t0+size/2)))
+}
+
+// Vertices returns the four corners of the cell in counter-clockwise order.
+func (c S2CellID) Vertices() [4]LatLng {
+	face, s0, t0, size := c.faceST()
+	st := [4][2]float64{{s0, t0}, {s0 + size, t0}, {s0 + size, t0 + size}, {s0, t0 + size}}
+	var out [4]LatLng
+	for k, p := range st {
+		out[k] = xyzToLatLng(s2FaceUVToXYZ(face, s2STToUV(p[0]), s2STToUV(p[1])))
+	}
+	return out
+}
+
+// Bounds returns a BoundingBox enclosing the cell.
+func (c S2CellID) Bounds() BoundingBox {
+	face, s0, t0, size := c.faceST()
+	const steps = 8
+	lats := []float64{}
+	lngs := []float64{}
+	for k := 0; k <= steps; k++ {
+		d := size * float64(k) / steps
+		for _, p := range [][2]float64{{s0 + d, t0}, {s0 + d, t0 + size}, {s0, t0 + d}, {s0 + size, t0 + d}} {
+			ll := xyzToLatLng(s2FaceUVToXYZ(face, s2STToUV(p[0]), s2STToUV(p[1])))
+			lats = append(lats, ll.Lat)
+			lngs = append(lngs, ll.Lng)
+		}
+	}
+	b := BoundingBox{
+		Southwest: LatLng{Lat: 90, Lng: 180},
+		Northeast: LatLng{Lat: -90, Lng: -180},
+	}
+	for k := range lats {
+		b.Southwest.Lat = math.Min(b.Southwest.Lat, lats[k])
+		b.Northeast.Lat = math.Max(b.Northeast.Lat, lats[k])
+		b.Southwest.Lng = math.Min(b.Southwest.Lng, lngs[k])
+		b.Northeast.Lng = math.Max(b.Northeast.Lng, lngs[k])
+	}
+	// Pad slightly for the curvature of the edges between samples.
+	pad := (b.Northeast.Lat - b.Southwest.Lat) * 0.01
+	b.Southwest.Lat = math.Max(-90, b.Southwest.Lat-pad)
+	b.Northeast.Lat = math.Min(90, b.Northeast.Lat+pad)
+
+	level := c.Level()
+	if S2CellIDAt(LatLng{Lat: 90}, level) == c {
+		b.Northeast.Lat = 90
+		b.Southwest.Lng, b.Northeast.Lng = -180, 180
+	} else if S2CellIDAt(LatLng{Lat: -90}, level) == c {
+		b.Southwest.Lat = -90
+		b.Southwest.Lng, b.Northeast.Lng = -180, 180
+	} else if b.Northeast.Lng-b.Southwest.Lng > 180 {
+		// The samples straddle the antimeridian.
+		b.Southwest.Lng, b.Northeast.Lng = 180, -180
+		for _, lng := range lngs {
+			if lng >= 0 {
+				b.Southwest.Lng = math.Min(b.Southwest.Lng, lng)
+			} else {
+				b.Northeast.Lng = math.Max(b.Northeast.Lng, lng)
+			}
+		}
+	}
+	return b
+}
+
+// S2CoveringBox returns the cells at the given level that intersect b. The
+// number of cells grows by a factor of four per level, so choose a level
+// appropriate to the size of the box.
+func S2CoveringBox(b BoundingBox, level int) []S2CellID {
+	return s2Covering(level, func(cell BoundingBox) bool {
+		return b.Intersects(cell)
+	})
+}
+
+// S2CoveringPolygon returns the cells at the given level that intersect p.
+func S2CoveringPolygon(p Polygon, level int) []S2CellID {
+	bounds := p.Bounds()
+	return s2Covering(level, func(cell BoundingBox) bool {
+		if !bounds.Intersects(cell) {
+			return false
+		}
+		for _, r := range cell.lngRanges() {
+			part := BoundingBox{
+				Southwest: LatLng{Lat: cell.Southwest.Lat, Lng: r[0]},
+				Northeast: LatLng{Lat: cell.Northeast.Lat, Lng: r[1]},
+			}
+			if p.intersectsBox(part) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func s2Covering(level int, intersects func(BoundingBox) bool) []S2CellID {
+	out := []S2CellID{}
+	queue := []S2CellID{}
+	for face := 0; face < 6; face++ {
+		queue = append(queue, S2CellID(uint64(face)<<s2PosBits|1<<(s2PosBits-1)))
+	}
+	for len(queue) > 0 {
+		c := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if !intersects(c.Bounds()) {
+			continue
+		}
+		if c.Level() >= level {
+			out = append(out, c)
+			continue
+		}
+		children := c.Children()
+		for k := len(children) - 1; k >= 0; k-- {
+			queue = append(queue, children[k])
+		}
+	}
+	return out
+}
+
+func s2LSB(id uint64) uint64 {
+	return id & -id
+}
+
+func s2CellIDFromFaceIJ(face, i, j int) S2CellID {
+	n := uint64(face) << (s2PosBits - 1)
+	b := face & s2SwapMask
+	for k := 7; k >= 0; k-- {
+		mask := (1 << s2LookupBits) - 1
+		b += ((i >> uint(k*s2LookupBits)) & mask) << (s2LookupBits + 2)
+		b += ((j >> uint(k*s2LookupBits)) & mask) << 2
+		b = s2LookupPos[b]
+		n |= uint64(b>>2) << (uint(k) * 2 * s2LookupBits)
+		b &= s2SwapMask | s2InvertMask
+	}
+	return S2CellID(n*2 + 1)
+}
+
+func (c S2CellID) faceIJ() (face, i, j int) {
+	face = c.Face()
+	orientation := face & s2SwapMask
+	nbits := S2MaxLevel - 7*s2LookupBits
+	for k := 7; k >= 0; k-- {
+		orientation += (int(uint64(c)>>uint(k*2*s2LookupBits+1)) & ((1 << uint(2*nbits)) - 1)) << 2
+		orientation = s2LookupIJ[orientation]
+		i += (orientation >> (s2LookupBits + 2)) << uint(k*s2LookupBits)
+		j += ((orientation >> 2) & ((1 << s2LookupBits) - 1)) << uint(k*s2LookupBits)
+		orientation &= s2SwapMask | s2InvertMask
+		nbits = s2LookupBits
+	}
+	return face, i, j
+}
+
+// faceST returns the face of the cell along with its lower-left corner and
+// edge length in (s, t) space.
+func (c S2CellID) faceST() (face int, s, t, size float64) {
+	face, i, j := c.faceIJ()
+	sizeIJ := 1 << uint(S2MaxLevel-c.Level())
+	i &= -sizeIJ
+	j &= -sizeIJ
+	return face, float64(i) / s2MaxSize, float64(j) / s2MaxSize, float64(sizeIJ) / s2MaxSize
+}
+
+func s2XYZToFaceUV(p [3]float64) (int, float64, float64) {
+	face := 0
+	if math.Abs(p[1]) > math.Abs(p[face]) {
+		face = 1
+	}
+	if math.Abs(p[2]) > math.Abs(p[face]) {
+		face = 2
+	}
+	if p[face] < 0 {
+		face += 3
+	}
+	x, y, z := p[0], p[1], p[2]
+	switch face {
+	case 0:
+		return face, y / x, z / x
+	case 1:
+		return face, -x / y, z / y
+	case 2:
+		return face, -x / z, -y / z
+	case 3:
+		return face, z / x, y / x
+	case 4:
+		return face, z / y, -x / y
+	}
+	return face, -y / z, -x / z
+}
+
+func s2FaceUVToXYZ(face int, u, v float64) [3]float64 {
+	switch face {
+	case 0:
+		return [3]float64{1, u, v}
+	case 1:
+		return [3]float64{-u, 1, v}
+	case 2:
+		return [3]float64{-u, -v, 1}
+	case 3:
+		return [3]float64{-1, -v, -u}
+	case 4:
+		return [3]float64{v, -1, -u}
+	}
+	return [3]float64{v, u, -1}
+}
+
+func s2UVToST(u float64) float64 {
+	if u >= 0 {
+		return 0.5 * math.Sqrt(1+3*u)
+	}
+	return 1 - 0.5*math.Sqrt(1-3*u)
+}
+
+func s2STToUV(s float64) float64 {
+	if s >= 0.5 {
+		return (4*s*s - 1) / 3
+	}
+	return (1 - 4*(1-s)*(1-s)) / 3
+}
+
+func s2STToIJ(s float64) int {
+	i := int(math.Floor(s2MaxSize * s))
+	if i < 0 {
+		return 0
+	}
+	if i > s2MaxSize-1 {
+		return s2MaxSize - 1
+	}
+	return i
+}
@@ -0,0 +1,70 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestS2CellIDAt(t *testing.T) {
+	tests := []struct {
+		LatLng LatLng
+		Level  int
+		Token  string
+	}{
+		{LatLng{40.7453721, -74.0078293}, 0, "9"},
+		{LatLng{40.7453721, -74.0078293}, 13, "89c259c4"},
+		{LatLng{40.7453721, -74.0078293}, 30, "89c259c76c8507bd"},
+		{LatLng{-33.86, 151.2}, 13, "6b12ae44"},
+		{LatLng{89.9, 10}, 13, "4555563c"},
+		{LatLng{-89.9, 10}, 30, "b000076c98f8a719"},
+		{LatLng{0, -179.99}, 5, "7004"},
+		{LatLng{51.5, -0.12}, 30, "487604c72662a817"},
+	}
+	for _, test := range tests {
+		c := S2CellIDAt(test.LatLng, test.Level)
+		if got := c.Token(); got != test.Token {
+			t.Errorf("%v@%d Expected: %s, Got: %s", test.LatLng, test.Level, test.Token, got)
+		}
+		if c.Level() != test.Level || !c.IsValid() {
+			t.Errorf("Expected valid cell at level %d, Got: %d", test.Level, c.Level())
+		}
+		if S2CellIDFromToken(test.Token) != c {
+			t.Errorf("Token %s did not round trip", test.Token)
+		}
+		if !c.Bounds().Contains(test.LatLng) {
+			t.Errorf("Expected %s bounds %v to contain %v", test.Token, c.Bounds(), test.LatLng)
+		}
+	}
+	if uint64(S2CellIDAt(LatLng{40.7453721, -74.0078293}, 30)) != 9926595241732016061 {
+		t.Errorf("Unexpected cell id")
+	}
+}
+
+func TestS2CellCenter(t *testing.T) {
+	c := S2CellIDFromToken("89c25c").Center()
+	if math.Abs(c.Lat-40.70888048980456) > 1e-9 || math.Abs(c.Lng+73.93598211433742) > 1e-9 {
+		t.Errorf("Unexpected center: %v", c)
+	}
+}
+
+func TestS2Covering(t *testing.T) {
+	box := BoundingBox{LatLng{40.70, -74.02}, LatLng{40.80, -73.93}}
+	got := map[string]bool{}
+	for _, c := range S2CoveringBox(box, 10) {
+		got[c.Token()] = true
+	}
+	for _, token := range []string{"89c259", "89c25b", "89c25d", "89c25f", "89c2f5", "89c2f7"} {
+		if !got[token] {
+			t.Errorf("Expected covering to include %s, Got: %v", token, got)
+		}
+	}
+	if len(got) > 8 {
+		t.Errorf("Expected a tight covering, Got: %v", got)
+	}
+
+	triangle := Polygon{{40.70, -74.02}, {40.80, -74.02}, {40.70, -73.93}}
+	cells := S2CoveringPolygon(triangle, 10)
+	if len(cells) == 0 || len(cells) > len(got) {
+		t.Errorf("Expected polygon covering to be a subset of the box covering, Got: %v", cells)
+	}
+}
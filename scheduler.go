@@ -0,0 +1,274 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxThrottleAttempts bounds how often a throttled query is retried before
+// its error is reported.
+const maxThrottleAttempts = 5
+
+type (
+	// BatchResult is the outcome of one query in a batch. Index is its
+	// position in the input; Query is set for forward geocoding and LatLng
+	// for reverse geocoding.
+	BatchResult struct {
+		Index   int
+		Query   string
+		LatLng  LatLng
+		Address *Address
+		Err     error
+	}
+
+	// BatchProgress reports how far a batch has got. ETA extrapolates from
+	// the rate so far.
+	BatchProgress struct {
+		Total       int
+		Done        int
+		Failed      int
+		Concurrency int
+		Elapsed     time.Duration
+		ETA         time.Duration
+	}
+
+	// Scheduler geocodes batches concurrently, adapting to the upstream
+	// quota: a throttled request (OVER_QUERY_LIMIT or HTTP 429) halves the
+	// concurrency and is retried after ThrottleDelay, and each run of
+	// successes as long as the current concurrency raises it by one again,
+	// up to MaxConcurrency. OnResult and OnProgress, if set, are called
+	// after each query finishes, one call at a time. A Scheduler runs one
+	// batch at a time.
+	Scheduler struct {
+		Geocoder       Geocoder
+		MaxConcurrency int
+		ThrottleDelay  time.Duration
+		OnResult       func(BatchResult)
+		OnProgress     func(BatchProgress)
+
+		mu       sync.Mutex
+		cond     *sync.Cond
+		paused   bool
+		limit    int
+		active   int
+		streak   int
+		progress BatchProgress
+		start    time.Time
+	}
+
+	// PartialBatchError is returned with the results of a batch whose
+	// context ended before every query was answered. The results of queries
+	// that finished are valid; the rest fail with Err. NotAttempted lists, in
+	// input order, the indices of the queries that were never sent, so the
+	// caller can retry just those.
+	PartialBatchError struct {
+		Err          error
+		NotAttempted []int
+	}
+
+	batchJob struct {
+		index    int
+		attempts int
+	}
+)
+
+func (e *PartialBatchError) Error() string {
+	return fmt.Sprintf("Batch stopped with %d queries not attempted: %v", len(e.NotAttempted), e.Err)
+}
+
+func (e *PartialBatchError) Unwrap() error {
+	return e.Err
+}
+
+// NewScheduler returns a Scheduler running up to maxConcurrency requests to
+// g at once.
+func NewScheduler(g Geocoder, maxConcurrency int) *Scheduler {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	return &Scheduler{Geocoder: g, MaxConcurrency: maxConcurrency, ThrottleDelay: time.Second}
+}
+
+// Pause stops new requests from starting until Resume is called. Requests
+// already under way finish normally.
+func (s *Scheduler) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = true
+}
+
+// Resume undoes Pause.
+func (s *Scheduler) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = false
+	if s.cond != nil {
+		s.cond.Broadcast()
+	}
+}
+
+// Progress returns the progress of the current or last batch.
+func (s *Scheduler) Progress() BatchProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshot()
+}
+
+func (s *Scheduler) snapshot() BatchProgress {
+	p := s.progress
+	p.Concurrency = s.limit
+	if !s.start.IsZero() {
+		p.Elapsed = time.Since(s.start)
+	}
+	if finished := p.Done + p.Failed; finished > 0 {
+		p.ETA = p.Elapsed / time.Duration(finished) * time.Duration(p.Total-finished)
+	}
+	return p
+}
+
+// Run geocodes queries and returns their results in input order. If ctx is
+// done first, the queries not yet answered fail with its error and Run
+// returns a *PartialBatchError alongside the results.
+func (s *Scheduler) Run(ctx context.Context, queries []string, opts ...RequestOption) ([]BatchResult, error) {
+	results := make([]BatchResult, len(queries))
+	for i, q := range queries {
+		results[i] = BatchResult{Index: i, Query: q}
+	}
+	return s.run(ctx, results, func(ctx context.Context, i int) (*Address, error) {
+		return s.Geocoder.Geocode(ctx, queries[i], opts...)
+	})
+}
+
+// RunReverse is Run for reverse geocoding points.
+func (s *Scheduler) RunReverse(ctx context.Context, points []LatLng, opts ...RequestOption) ([]BatchResult, error) {
+	results := make([]BatchResult, len(points))
+	for i, ll := range points {
+		results[i] = BatchResult{Index: i, LatLng: ll}
+	}
+	return s.run(ctx, results, func(ctx context.Context, i int) (*Address, error) {
+		return s.Geocoder.ReverseGeocode(ctx, points[i], opts...)
+	})
+}
+
+// run fills in results by calling call for each index.
+func (s *Scheduler) run(ctx context.Context, results []BatchResult, call func(context.Context, int) (*Address, error)) ([]BatchResult, error) {
+	finished := make([]bool, len(results))
+	attempted := make([]bool, len(results))
+	queue := make([]batchJob, len(results))
+	for i := range queue {
+		queue[i] = batchJob{index: i}
+	}
+
+	s.mu.Lock()
+	s.cond = sync.NewCond(&s.mu)
+	s.limit, s.active, s.streak = s.MaxConcurrency, 0, 0
+	s.progress = BatchProgress{Total: len(results)}
+	s.start = time.Now()
+	s.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		s.mu.Lock()
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	})
+	defer stop()
+
+	var (
+		wg       sync.WaitGroup
+		reportMu sync.Mutex
+	)
+	outstanding := len(results)
+	work := func(job batchJob) {
+		defer wg.Done()
+		a, err := call(ctx, job.index)
+
+		s.mu.Lock()
+		s.active--
+		if isThrottled(err) && job.attempts+1 < maxThrottleAttempts && ctx.Err() == nil {
+			if s.limit > 1 {
+				s.limit /= 2
+			}
+			s.streak = 0
+			s.cond.Broadcast()
+			s.mu.Unlock()
+			sleep(ctx, s.ThrottleDelay*time.Duration(job.attempts+1))
+			s.mu.Lock()
+			queue = append(queue, batchJob{index: job.index, attempts: job.attempts + 1})
+			s.cond.Broadcast()
+			s.mu.Unlock()
+			return
+		}
+		results[job.index].Address, results[job.index].Err = a, err
+		finished[job.index] = true
+		outstanding--
+		if err != nil {
+			s.progress.Failed++
+		} else {
+			s.progress.Done++
+			if s.streak++; s.streak >= s.limit && s.limit < s.MaxConcurrency {
+				s.limit++
+				s.streak = 0
+			}
+		}
+		r, p := results[job.index], s.snapshot()
+		s.cond.Broadcast()
+		s.mu.Unlock()
+
+		reportMu.Lock()
+		defer reportMu.Unlock()
+		if s.OnResult != nil {
+			s.OnResult(r)
+		}
+		if s.OnProgress != nil {
+			s.OnProgress(p)
+		}
+	}
+
+	s.mu.Lock()
+	for outstanding > 0 && ctx.Err() == nil {
+		if s.paused || s.active >= s.limit || len(queue) == 0 {
+			s.cond.Wait()
+			continue
+		}
+		job := queue[0]
+		queue = queue[1:]
+		attempted[job.index] = true
+		s.active++
+		wg.Add(1)
+		go work(job)
+	}
+	s.mu.Unlock()
+	wg.Wait()
+
+	var partial *PartialBatchError
+	for i := range results {
+		if finished[i] {
+			continue
+		}
+		if partial == nil {
+			partial = &PartialBatchError{Err: ctx.Err()}
+		}
+		results[i].Err = ctx.Err()
+		if !attempted[i] {
+			partial.NotAttempted = append(partial.NotAttempted, i)
+		}
+	}
+	if partial != nil {
+		return results, partial
+	}
+	return results, nil
+}
+
+// isThrottled reports whether err means the upstream quota was exceeded.
+func isThrottled(err error) bool {
+	var he *HTTPError
+	if errors.As(err, &he) && he.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	var ge *GeocoderError
+	return errors.As(err, &ge) && (ge.Status == StatusOverQueryLimit || ge.Status == "RESOURCE_EXHAUSTED")
+}
@@ -0,0 +1,183 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSchedulerAdaptsToThrottling(t *testing.T) {
+	var inFlight, throttled int32
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		time.Sleep(2 * time.Millisecond)
+		if n > 2 {
+			atomic.AddInt32(&throttled, 1)
+			return nil, &GeocoderError{Status: StatusOverQueryLimit}
+		}
+		return &Address{Address: q}, nil
+	})
+	s := NewScheduler(g, 8)
+	s.ThrottleDelay = time.Millisecond
+	var mu sync.Mutex
+	progress := []BatchProgress{}
+	s.OnProgress = func(p BatchProgress) {
+		mu.Lock()
+		progress = append(progress, p)
+		mu.Unlock()
+	}
+	queries := make([]string, 40)
+	for i := range queries {
+		queries[i] = fmt.Sprint(i)
+	}
+	results, err := s.Run(context.Background(), queries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.Err != nil || r.Index != i || r.Address.Address != queries[i] {
+			t.Errorf("Unexpected result %d: %+v", i, r)
+		}
+	}
+	if throttled == 0 {
+		t.Error("Expected: some requests throttled")
+	}
+	last := progress[len(progress)-1]
+	if len(progress) != 40 || last.Done != 40 || last.Failed != 0 || last.ETA != 0 {
+		t.Errorf("Unexpected progress: %+v", last)
+	}
+	if p := s.Progress(); p.Concurrency >= 8 {
+		t.Errorf("Expected: concurrency to have backed off, Got: %d", p.Concurrency)
+	}
+}
+
+func TestSchedulerPauseResume(t *testing.T) {
+	var calls int32
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		atomic.AddInt32(&calls, 1)
+		return &Address{}, nil
+	})
+	s := NewScheduler(g, 2)
+	s.Pause()
+	done := make(chan []BatchResult)
+	go func() {
+		results, _ := s.Run(context.Background(), []string{"a", "b", "c"})
+		done <- results
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if n := atomic.LoadInt32(&calls); n != 0 {
+		t.Errorf("Expected: no calls while paused, Got: %d", n)
+	}
+	s.Resume()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected: batch to finish after Resume")
+	}
+	if calls != 3 {
+		t.Errorf("Expected: 3, Got: %d", calls)
+	}
+}
+
+func TestSchedulerCanceled(t *testing.T) {
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		return &Address{}, nil
+	})
+	s := NewScheduler(g, 1)
+	s.Pause()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	results, err := s.Run(ctx, []string{"a", "b"})
+	for _, r := range results {
+		if r.Err != context.DeadlineExceeded {
+			t.Errorf("Expected: %v, Got: %v", context.DeadlineExceeded, r.Err)
+		}
+	}
+	var pe *PartialBatchError
+	if !errors.As(err, &pe) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected: a PartialBatchError, Got: %v", err)
+	}
+	if len(pe.NotAttempted) != 2 {
+		t.Errorf("Unexpected partial batch: %+v", pe)
+	}
+}
+
+func TestSchedulerPartialResults(t *testing.T) {
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		if q == "slow" {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return &Address{Address: q}, nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	results, err := NewScheduler(g, 1).Run(ctx, []string{"a", "b", "slow", "c", "d"})
+	var pe *PartialBatchError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected: a PartialBatchError, Got: %v", err)
+	}
+	if !reflect.DeepEqual(pe.NotAttempted, []int{3, 4}) {
+		t.Errorf("Unexpected partial batch: %+v", pe)
+	}
+	if results[0].Address.Address != "a" || results[1].Address.Address != "b" || results[2].Err != context.DeadlineExceeded {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+}
+
+func TestReverseGeocodeBatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("latlng") == "0,0" {
+			w.Write([]byte(`{"status": "ZERO_RESULTS", "results": []}`))
+			return
+		}
+		w.Write([]byte(`{"status": "OK", "results": [{"formatted_address": "` + r.URL.Query().Get("latlng") + `"}]}`))
+	}))
+	defer srv.Close()
+	c := NewClient("test-key", WithBaseURL(srv.URL))
+	points := []LatLng{{1, 2}, {0, 0}, {91, 0}, {3, 4}}
+	results, err := c.ReverseGeocodeBatch(context.Background(), points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("Expected: 4 results, Got: %d", len(results))
+	}
+	if results[0].Address.Address != "1,2" || results[3].Address.Address != "3,4" || results[3].LatLng != points[3] {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+	if ge, ok := results[1].Err.(*GeocoderError); !ok || ge.Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, results[1].Err)
+	}
+	if _, ok := results[2].Err.(*InvalidInputError); !ok {
+		t.Errorf("Expected: InvalidInputError, Got: %v", results[2].Err)
+	}
+}
+
+func BenchmarkSchedulerRun(b *testing.B) {
+	a := &Address{Address: "x"}
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		return a, nil
+	})
+	queries := make([]string, 1000)
+	for i := range queries {
+		queries[i] = fmt.Sprint(i)
+	}
+	s := NewScheduler(g, DefaultBatchConcurrency)
+	ctx := context.Background()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.Run(ctx, queries); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(queries)), "ns/query")
+}
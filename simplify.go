@@ -0,0 +1,99 @@
+package geo
+
+import "math"
+
+// Simplify reduces the number of points in a polyline while keeping it within
+// tolerance of the original. A cheap radial-distance pass is run first,
+// followed by Douglas-Peucker on what remains.
+func Simplify(points []LatLng, tolerance Distance) []LatLng {
+	if len(points) <= 2 || tolerance <= 0 {
+		return points
+	}
+	return SimplifyDouglasPeucker(SimplifyRadial(points, tolerance), tolerance)
+}
+
+// SimplifyRadial drops every point that lies within tolerance of the
+// last kept point. The first and last points are always kept.
+func SimplifyRadial(points []LatLng, tolerance Distance) []LatLng {
+	if len(points) <= 2 || tolerance <= 0 {
+		return points
+	}
+	last := points[0]
+	out := []LatLng{last}
+	for _, p := range points[1 : len(points)-1] {
+		if last.Distance(p) > tolerance {
+			out = append(out, p)
+			last = p
+		}
+	}
+	return append(out, points[len(points)-1])
+}
+
+// SimplifyDouglasPeucker simplifies a polyline using the Douglas-Peucker
+// algorithm, keeping every point that deviates more than tolerance from
+// the simplified line.
+func SimplifyDouglasPeucker(points []LatLng, tolerance Distance) []LatLng {
+	if len(points) <= 2 || tolerance <= 0 {
+		return points
+	}
+	xy := projectLocal(points)
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	stack := [][2]int{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		first, last := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+
+		index, maxDist := -1, tolerance.Meters()
+		for i := first + 1; i < last; i++ {
+			if d := segmentDistance(xy[i], xy[first], xy[last]); d > maxDist {
+				index, maxDist = i, d
+			}
+		}
+		if index != -1 {
+			keep[index] = true
+			stack = append(stack, [2]int{first, index}, [2]int{index, last})
+		}
+	}
+
+	out := []LatLng{}
+	for i, p := range points {
+		if keep[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// projectLocal maps points onto a plane in meters using an equirectangular
+// projection centred on the mean latitude, which is accurate enough for
+// comparing short distances.
+func projectLocal(points []LatLng) [][2]float64 {
+	var lat0 float64
+	for _, p := range points {
+		lat0 += p.Lat
+	}
+	k := math.Cos(toRadians(lat0 / float64(len(points))))
+	xy := make([][2]float64, len(points))
+	for i, p := range points {
+		xy[i] = [2]float64{toRadians(p.Lng) * k * EarthRadius, toRadians(p.Lat) * EarthRadius}
+	}
+	return xy
+}
+
+// segmentDistance returns the distance from p to the segment a-b.
+func segmentDistance(p, a, b [2]float64) float64 {
+	x, y := a[0], a[1]
+	dx, dy := b[0]-x, b[1]-y
+	if dx != 0 || dy != 0 {
+		t := ((p[0]-x)*dx + (p[1]-y)*dy) / (dx*dx + dy*dy)
+		if t > 1 {
+			x, y = b[0], b[1]
+		} else if t > 0 {
+			x += dx * t
+			y += dy * t
+		}
+	}
+	return math.Hypot(p[0]-x, p[1]-y)
+}
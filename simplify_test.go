@@ -0,0 +1,49 @@
+package geo
+
+import "testing"
+
+func TestSimplify(t *testing.T) {
+	// A straight line along a meridian with a single 500m detour in the middle.
+	line := []LatLng{}
+	for i := 0; i <= 100; i++ {
+		line = append(line, LatLng{Lat: 40 + float64(i)*0.0001, Lng: -74})
+	}
+	line[50].Lng = -74 + 0.0059
+
+	got := Simplify(line, 10)
+	if len(got) != 5 {
+		t.Fatalf("Expected: 5 points, Got: %d (%v)", len(got), got)
+	}
+	if got[0] != line[0] || got[len(got)-1] != line[100] {
+		t.Errorf("Expected endpoints to be kept, Got: %v", got)
+	}
+	if got[2] != line[50] {
+		t.Errorf("Expected: %v, Got: %v", line[50], got[2])
+	}
+
+	if got := Simplify(line[:2], 10); len(got) != 2 {
+		t.Errorf("Expected: 2 points, Got: %d", len(got))
+	}
+}
+
+func TestSimplifyRadial(t *testing.T) {
+	line := []LatLng{{0, 0}, {0, 0.00001}, {0, 0.00002}, {0, 0.001}, {0, 0.00101}}
+	got := SimplifyRadial(line, 10)
+	expected := []LatLng{{0, 0}, {0, 0.001}, {0, 0.00101}}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected: %v, Got: %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected: %v, Got: %v", expected, got)
+		}
+	}
+}
+
+func TestDistanceTo(t *testing.T) {
+	// One degree of longitude at the equator.
+	d := LatLng{0, 0}.DistanceTo(LatLng{0, 1})
+	if d < 111190 || d > 111200 {
+		t.Errorf("Expected: ~111195m, Got: %f", d)
+	}
+}
@@ -0,0 +1,367 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	smartyUSHost            = "https://us-street.api.smarty.com"
+	smartyInternationalHost = "https://international-street.api.smarty.com"
+	smartyReverseHost       = "https://us-reverse-geo.api.smarty.com"
+)
+
+// DPV match codes, saying how much of a US address the USPS confirmed as
+// deliverable.
+const (
+	DPVConfirmed        = "Y" // the whole address
+	DPVSecondaryDropped = "S" // the primary address, ignoring an unneeded secondary
+	DPVSecondaryMissing = "D" // the primary address, but a required secondary is missing
+	DPVNotConfirmed     = "N"
+)
+
+// smartyPrecisions maps Smarty's US precision and international
+// geocode_precision values to precisions.
+var smartyPrecisions = map[string]Precision{
+	"Rooftop":            PrecisionRooftop,
+	"Parcel":             PrecisionRooftop,
+	"DeliveryPoint":      PrecisionRooftop,
+	"Premise":            PrecisionRooftop,
+	"Zip9":               PrecisionCentroid,
+	"Zip8":               PrecisionCentroid,
+	"Zip7":               PrecisionCentroid,
+	"Zip6":               PrecisionCentroid,
+	"Thoroughfare":       PrecisionCentroid,
+	"Zip5":               PrecisionApproximate,
+	"Locality":           PrecisionApproximate,
+	"AdministrativeArea": PrecisionApproximate,
+}
+
+type (
+	// SmartyGeocoder verifies addresses with Smarty, using AuthID and
+	// AuthToken (a secret key pair) or an EmbeddedKey. The Client's own
+	// APIKey, key pool and quota are never used. US addresses go to the US
+	// Street API and others to the International Street API, chosen by a
+	// country component (WithCountries); ReverseGeocode is US only.
+	// Unverified candidates have their Confidence halved.
+	SmartyGeocoder struct {
+		Client      *Client
+		AuthID      string
+		AuthToken   string
+		EmbeddedKey string
+	}
+
+	// SmartyAddress is a verified candidate for an address. Result holds
+	// its location and components in the package's structured form, with
+	// the ZIP+4 as the postal code and suffix. DPVMatchCode is one of the
+	// DPV constants for US addresses and empty for international ones,
+	// whose VerificationStatus is set instead.
+	SmartyAddress struct {
+		Result             Result
+		DeliveryLines      []string
+		LastLine           string
+		ZIP4               string
+		DPVMatchCode       string
+		DPVFootnotes       string
+		Vacant             bool
+		CMRA               bool
+		RDI                string
+		VerificationStatus string
+	}
+
+	smartyUSCandidate struct {
+		DeliveryLine1 string `json:"delivery_line_1"`
+		DeliveryLine2 string `json:"delivery_line_2"`
+		LastLine      string `json:"last_line"`
+		Components    struct {
+			PrimaryNumber       string `json:"primary_number"`
+			StreetPredirection  string `json:"street_predirection"`
+			StreetName          string `json:"street_name"`
+			StreetSuffix        string `json:"street_suffix"`
+			StreetPostdirection string `json:"street_postdirection"`
+			SecondaryNumber     string `json:"secondary_number"`
+			SecondaryDesignator string `json:"secondary_designator"`
+			CityName            string `json:"city_name"`
+			StateAbbreviation   string `json:"state_abbreviation"`
+			Zipcode             string `json:"zipcode"`
+			Plus4Code           string `json:"plus4_code"`
+		} `json:"components"`
+		Metadata struct {
+			CountyName string  `json:"county_name"`
+			Latitude   float64 `json:"latitude"`
+			Longitude  float64 `json:"longitude"`
+			Precision  string  `json:"precision"`
+			RDI        string  `json:"rdi"`
+		} `json:"metadata"`
+		Analysis struct {
+			DPVMatchCode string `json:"dpv_match_code"`
+			DPVFootnotes string `json:"dpv_footnotes"`
+			DPVCMRA      string `json:"dpv_cmra"`
+			DPVVacant    string `json:"dpv_vacant"`
+		} `json:"analysis"`
+	}
+
+	smartyInternationalCandidate struct {
+		Address1   string `json:"address1"`
+		Address2   string `json:"address2"`
+		Address3   string `json:"address3"`
+		Address4   string `json:"address4"`
+		Components struct {
+			CountryISO3           string `json:"country_iso_3"`
+			AdministrativeArea    string `json:"administrative_area"`
+			SubAdministrativeArea string `json:"sub_administrative_area"`
+			Locality              string `json:"locality"`
+			DependentLocality     string `json:"dependent_locality"`
+			PostalCode            string `json:"postal_code"`
+			Premise               string `json:"premise"`
+			Thoroughfare          string `json:"thoroughfare"`
+			SubBuilding           string `json:"sub_building"`
+		} `json:"components"`
+		Metadata struct {
+			Latitude         float64 `json:"latitude"`
+			Longitude        float64 `json:"longitude"`
+			GeocodePrecision string  `json:"geocode_precision"`
+		} `json:"metadata"`
+		Analysis struct {
+			VerificationStatus string `json:"verification_status"`
+		} `json:"analysis"`
+	}
+
+	smartyReverseResponse struct {
+		Results []struct {
+			Coordinate struct {
+				Latitude  float64 `json:"latitude"`
+				Longitude float64 `json:"longitude"`
+				Accuracy  string  `json:"accuracy"`
+			} `json:"coordinate"`
+			Address struct {
+				Street            string `json:"street"`
+				City              string `json:"city"`
+				StateAbbreviation string `json:"state_abbreviation"`
+				Zipcode           string `json:"zipcode"`
+			} `json:"address"`
+		} `json:"results"`
+	}
+)
+
+// NewSmartyGeocoder returns a SmartyGeocoder that sends requests with c,
+// authenticated by authID and authToken.
+func NewSmartyGeocoder(c *Client, authID, authToken string) *SmartyGeocoder {
+	return &SmartyGeocoder{Client: c, AuthID: authID, AuthToken: authToken}
+}
+
+// Verify looks up q, returning up to five verified candidates, best first.
+// It fails with ZERO_RESULTS if Smarty can't match q to a real address.
+func (s *SmartyGeocoder) Verify(ctx context.Context, q string, opts ...RequestOption) ([]SmartyAddress, error) {
+	q = s.Client.normalizeQuery(q)
+	o := newRequestOptions(opts)
+	if err := validateQuery(q, o); err != nil {
+		return nil, err
+	}
+	params := s.params()
+	params.Set("candidates", "5")
+	var out []SmartyAddress
+	if countries := o.countries(); len(countries) > 0 && countries[0] != "US" {
+		params.Set("country", countries[0])
+		params.Set("freeform", q)
+		var cands []smartyInternationalCandidate
+		if err := s.Client.getJSONKeyless(ctx, smartyInternationalHost, "/verify", params, nil, &cands); err != nil {
+			return nil, err
+		}
+		for _, c := range cands {
+			out = append(out, c.address())
+		}
+	} else {
+		params.Set("street", q)
+		params.Set("match", "enhanced")
+		var cands []smartyUSCandidate
+		if err := s.Client.getJSONKeyless(ctx, smartyUSHost, "/street-address", params, nil, &cands); err != nil {
+			return nil, err
+		}
+		for _, c := range cands {
+			out = append(out, c.address())
+		}
+	}
+	if len(out) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	return out, nil
+}
+
+// Geocode verifies q and returns its candidates as an Address.
+func (s *SmartyGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	cands, err := s.Verify(ctx, q, opts...)
+	if err != nil {
+		return nil, err
+	}
+	g := &Response{Status: StatusOk, Results: make([]Result, len(cands))}
+	for i, c := range cands {
+		g.Results[i] = c.Result
+	}
+	return s.address(g), nil
+}
+
+// ReverseGeocode returns the US addresses nearest ll.
+func (s *SmartyGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	if err := validateLatLng("latlng", ll); err != nil {
+		return nil, err
+	}
+	params := s.params()
+	lat, lng, _ := strings.Cut(latLngParam(ll), ",")
+	params.Set("latitude", lat)
+	params.Set("longitude", lng)
+	var r smartyReverseResponse
+	if err := s.Client.getJSONKeyless(ctx, smartyReverseHost, "/lookup", params, nil, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	g := &Response{Status: StatusOk, Results: make([]Result, len(r.Results))}
+	for i, sr := range r.Results {
+		a := sr.Address
+		res := &g.Results[i]
+		res.FormattedAddress = a.Street + ", " + strings.TrimSpace(a.City+" "+a.StateAbbreviation+" "+a.Zipcode)
+		res.AddressComponents = compactComponents([]AddressComponent{
+			{LongName: a.Street, Types: []string{"route"}},
+			{LongName: a.City, Types: []string{"locality", "political"}},
+			{LongName: a.StateAbbreviation, Types: []string{"administrative_area_level_1", "political"}},
+			{LongName: a.Zipcode, Types: []string{"postal_code"}},
+			{LongName: "US", Types: []string{"country", "political"}},
+		})
+		res.Geometry.Location = LatLng{sr.Coordinate.Latitude, sr.Coordinate.Longitude}
+		res.Types = []string{"street_address"}
+		res.scoreSmarty(sr.Coordinate.Accuracy, true)
+	}
+	return s.address(g), nil
+}
+
+func (s *SmartyGeocoder) params() url.Values {
+	params := url.Values{}
+	if s.AuthID != "" {
+		params.Set("auth-id", s.AuthID)
+		params.Set("auth-token", s.AuthToken)
+	}
+	if s.EmbeddedKey != "" {
+		params.Set("key", s.EmbeddedKey)
+	}
+	return params
+}
+
+func (s *SmartyGeocoder) address(g *Response) *Address {
+	first := g.Results[0]
+	a := &Address{Lat: first.Geometry.Location.Lat, Lng: first.Geometry.Location.Lng, Address: first.FormattedAddress, Response: g}
+	a.stamp(time.Now(), s.Client.Validity)
+	return a
+}
+
+func (c smartyUSCandidate) address() SmartyAddress {
+	cp, md, an := c.Components, c.Metadata, c.Analysis
+	sa := SmartyAddress{
+		LastLine:     c.LastLine,
+		ZIP4:         cp.Zipcode,
+		DPVMatchCode: an.DPVMatchCode,
+		DPVFootnotes: an.DPVFootnotes,
+		Vacant:       an.DPVVacant == "Y",
+		CMRA:         an.DPVCMRA == "Y",
+		RDI:          md.RDI,
+	}
+	if cp.Plus4Code != "" {
+		sa.ZIP4 += "-" + cp.Plus4Code
+	}
+	for _, l := range []string{c.DeliveryLine1, c.DeliveryLine2} {
+		if l != "" {
+			sa.DeliveryLines = append(sa.DeliveryLines, l)
+		}
+	}
+	route := strings.Join(strings.Fields(cp.StreetPredirection+" "+cp.StreetName+" "+cp.StreetSuffix+" "+cp.StreetPostdirection), " ")
+	res := &sa.Result
+	res.FormattedAddress = strings.Join(append(append([]string{}, sa.DeliveryLines...), c.LastLine), ", ")
+	res.AddressComponents = compactComponents([]AddressComponent{
+		{LongName: cp.PrimaryNumber, Types: []string{"street_number"}},
+		{LongName: route, Types: []string{"route"}},
+		{LongName: strings.TrimSpace(cp.SecondaryDesignator + " " + cp.SecondaryNumber), Types: []string{"subpremise"}},
+		{LongName: cp.CityName, Types: []string{"locality", "political"}},
+		{LongName: md.CountyName, Types: []string{"administrative_area_level_2", "political"}},
+		{LongName: cp.StateAbbreviation, Types: []string{"administrative_area_level_1", "political"}},
+		{LongName: cp.Zipcode, Types: []string{"postal_code"}},
+		{LongName: cp.Plus4Code, Types: []string{"postal_code_suffix"}},
+		{LongName: "US", Types: []string{"country", "political"}},
+	})
+	res.Geometry.Location = LatLng{md.Latitude, md.Longitude}
+	res.Types = []string{"street_address"}
+	if cp.PrimaryNumber == "" {
+		res.Types = []string{"route"}
+	}
+	res.scoreSmarty(md.Precision, an.DPVMatchCode != "" && an.DPVMatchCode != DPVNotConfirmed)
+	return sa
+}
+
+func (c smartyInternationalCandidate) address() SmartyAddress {
+	cp, md := c.Components, c.Metadata
+	sa := SmartyAddress{VerificationStatus: c.Analysis.VerificationStatus}
+	for _, l := range []string{c.Address1, c.Address2, c.Address3, c.Address4} {
+		if l != "" {
+			sa.DeliveryLines = append(sa.DeliveryLines, l)
+		}
+	}
+	country := cp.CountryISO3
+	if ct, ok := LookupCountry(country); ok {
+		country = ct.Alpha2
+	}
+	res := &sa.Result
+	res.FormattedAddress = strings.Join(sa.DeliveryLines, ", ")
+	res.AddressComponents = compactComponents([]AddressComponent{
+		{LongName: cp.Premise, Types: []string{"street_number"}},
+		{LongName: cp.Thoroughfare, Types: []string{"route"}},
+		{LongName: cp.SubBuilding, Types: []string{"subpremise"}},
+		{LongName: cp.DependentLocality, Types: []string{"sublocality", "political"}},
+		{LongName: cp.Locality, Types: []string{"locality", "political"}},
+		{LongName: cp.SubAdministrativeArea, Types: []string{"administrative_area_level_2", "political"}},
+		{LongName: cp.AdministrativeArea, Types: []string{"administrative_area_level_1", "political"}},
+		{LongName: cp.PostalCode, Types: []string{"postal_code"}},
+		{LongName: country, Types: []string{"country", "political"}},
+	})
+	res.Geometry.Location = LatLng{md.Latitude, md.Longitude}
+	if len(res.AddressComponents) > 0 {
+		res.Types = res.AddressComponents[0].Types
+		if hasType(res.Types, "street_number") {
+			res.Types = []string{"street_address"}
+		}
+	}
+	res.scoreSmarty(md.GeocodePrecision, sa.VerificationStatus == "Verified" || sa.VerificationStatus == "Partial")
+	return sa
+}
+
+// compactComponents drops components without a name and gives the rest
+// their long name as a short name if they lack one.
+func compactComponents(cs []AddressComponent) []AddressComponent {
+	out := []AddressComponent{}
+	for _, c := range cs {
+		if c.LongName == "" {
+			continue
+		}
+		if c.ShortName == "" {
+			c.ShortName = c.LongName
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// scoreSmarty sets Precision and Confidence from a Smarty precision,
+// halving the Confidence of addresses Smarty couldn't verify.
+func (r *Result) scoreSmarty(precision string, verified bool) {
+	p, ok := smartyPrecisions[precision]
+	if !ok {
+		p = typePrecision(r.Types)
+	}
+	r.scorePrecision(p)
+	if !verified {
+		r.Confidence /= 2
+	}
+}
+
+var _ Geocoder = (*SmartyGeocoder)(nil)
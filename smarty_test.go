@@ -0,0 +1,120 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const smartyUSBody = `[{"input_index": 0, "candidate_index": 0,
+	"delivery_line_1": "1 Santa Claus Ln", "last_line": "North Pole AK 99705-9901",
+	"components": {"primary_number": "1", "street_name": "Santa Claus", "street_suffix": "Ln",
+	 "city_name": "North Pole", "state_abbreviation": "AK", "zipcode": "99705", "plus4_code": "9901"},
+	"metadata": {"county_name": "Fairbanks North Star", "latitude": 64.75233, "longitude": -147.35297, "precision": "Zip9", "rdi": "Commercial"},
+	"analysis": {"dpv_match_code": "Y", "dpv_footnotes": "AABB", "dpv_cmra": "N", "dpv_vacant": "N"}}]`
+
+func newSmartyTestGeocoder(t *testing.T, body string) (*SmartyGeocoder, **http.Request) {
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewSmartyGeocoder(NewClient("", WithBaseURL(srv.URL)), "id", "token"), &last
+}
+
+func TestSmartyVerifyUS(t *testing.T) {
+	s, last := newSmartyTestGeocoder(t, smartyUSBody)
+	cands, err := s.Verify(context.Background(), "1 santa claus north pole ak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if (*last).URL.Path != "/street-address" || q.Get("auth-id") != "id" || q.Get("auth-token") != "token" || q.Get("street") != "1 santa claus north pole ak" || q.Has("key") {
+		t.Errorf("Unexpected request: %s", (*last).URL)
+	}
+	c := cands[0]
+	if c.ZIP4 != "99705-9901" || c.DPVMatchCode != DPVConfirmed || c.Vacant || c.RDI != "Commercial" {
+		t.Errorf("Unexpected candidate: %+v", c)
+	}
+	p := c.Result.ParsedAddress()
+	if p.StreetNumber != "1" || p.Street != "Santa Claus Ln" || p.City != "North Pole" || p.StateCode != "AK" || p.PostalCode != "99705-9901" || p.County != "Fairbanks North Star" {
+		t.Errorf("Unexpected components: %+v", p)
+	}
+	if c.Result.FormattedAddress != "1 Santa Claus Ln, North Pole AK 99705-9901" || c.Result.Precision != PrecisionCentroid || c.Result.Confidence != 0.6 {
+		t.Errorf("Unexpected result: %+v", c.Result)
+	}
+}
+
+func TestSmartyGeocodeInternational(t *testing.T) {
+	s, last := newSmartyTestGeocoder(t, `[{"address1": "24 Sussex Dr", "address2": "Ottawa ON K1M 1M4",
+		"components": {"country_iso_3": "CAN", "administrative_area": "ON", "locality": "Ottawa", "postal_code": "K1M 1M4", "premise": "24", "thoroughfare": "Sussex Dr"},
+		"metadata": {"latitude": 45.4443, "longitude": -75.6939, "geocode_precision": "Premise"},
+		"analysis": {"verification_status": "Ambiguous"}}]`)
+	a, err := s.Geocode(context.Background(), "24 Sussex Dr Ottawa", WithCountries("ca"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*last).URL.Path != "/verify" || (*last).URL.Query().Get("country") != "CA" || (*last).URL.Query().Get("freeform") != "24 Sussex Dr Ottawa" {
+		t.Errorf("Unexpected request: %s", (*last).URL)
+	}
+	if a.Address != "24 Sussex Dr, Ottawa ON K1M 1M4" || a.CountryCode() != "CA" || a.Precision() != PrecisionRooftop {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+	if c := a.Response.Results[0].Confidence; c != 0.5 {
+		t.Errorf("Expected: an unverified match to be discounted to 0.5, Got: %v", c)
+	}
+}
+
+func TestSmartyReverseGeocode(t *testing.T) {
+	s, last := newSmartyTestGeocoder(t, `{"results": [{"coordinate": {"latitude": 40.2, "longitude": -111.6, "accuracy": "Rooftop"},
+		"address": {"street": "2335 S State St", "city": "Provo", "state_abbreviation": "UT", "zipcode": "84606"}}]}`)
+	a, err := s.ReverseGeocode(context.Background(), LatLng{40.2, -111.6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*last).URL.Path != "/lookup" || (*last).URL.Query().Get("latitude") != "40.2" {
+		t.Errorf("Unexpected request: %s", (*last).URL)
+	}
+	if a.Address != "2335 S State St, Provo UT 84606" || a.Precision() != PrecisionRooftop {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+
+	s, _ = newSmartyTestGeocoder(t, `[]`)
+	if _, err := s.Verify(context.Background(), "nowhere"); err == nil || err.(*GeocoderError).Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, err)
+	}
+}
+
+func TestSmartyOmitsClientKey(t *testing.T) {
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.Write([]byte(smartyUSBody))
+	}))
+	defer srv.Close()
+	quota := NewQuotaTracker()
+	for _, c := range []*Client{
+		NewClient("google-key", WithBaseURL(srv.URL), WithQuota(quota)),
+		NewClient("", WithBaseURL(srv.URL), WithKeyPool(NewKeyPool(RotateRoundRobin, "pooled-key"))),
+	} {
+		if _, err := NewSmartyGeocoder(c, "id", "token").Geocode(context.Background(), "1 santa claus north pole ak"); err != nil {
+			t.Fatal(err)
+		}
+		if q := last.URL.Query(); q.Has("key") {
+			t.Errorf("Expected: no key parameter, Got: %s", q.Get("key"))
+		}
+	}
+	if u := quota.Snapshot(); len(u) != 0 {
+		t.Errorf("Expected: no quota charged, Got: %+v", u)
+	}
+
+	s := &SmartyGeocoder{Client: NewClient("google-key", WithBaseURL(srv.URL)), EmbeddedKey: "embedded"}
+	if _, err := s.Geocode(context.Background(), "1 santa claus north pole ak"); err != nil {
+		t.Fatal(err)
+	}
+	if k := last.URL.Query().Get("key"); k != "embedded" {
+		t.Errorf("Expected: embedded, Got: %s", k)
+	}
+}
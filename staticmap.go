@@ -0,0 +1,142 @@
+package geo
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	MapTypeRoadmap   = "roadmap"
+	MapTypeSatellite = "satellite"
+	MapTypeTerrain   = "terrain"
+	MapTypeHybrid    = "hybrid"
+)
+
+var InvalidSigningSecretError = errors.New("Invalid URL signing secret.")
+
+type (
+	// StaticMap describes a Google Static Maps image. When Center is nil the
+	// map is framed automatically around its markers, paths and Visible
+	// points.
+	StaticMap struct {
+		Center   *LatLng
+		Zoom     int
+		Width    int
+		Height   int
+		Scale    int
+		MapType  string
+		Format   string
+		Language string
+		Region   string
+		Markers  []StaticMapMarkers
+		Paths    []StaticMapPath
+		Visible  []LatLng
+	}
+
+	// StaticMapMarkers is a group of markers sharing one style. Size is
+	// "tiny", "mid" or "small", and Label a single uppercase letter or digit.
+	StaticMapMarkers struct {
+		Color  string
+		Size   string
+		Label  string
+		Points []LatLng
+	}
+
+	// StaticMapPath is a line through Points, encoded as a polyline. Setting
+	// FillColor closes it into a polygon.
+	StaticMapPath struct {
+		Color     string
+		Weight    int
+		FillColor string
+		Points    []LatLng
+	}
+)
+
+// Fit centers the map on b and picks the highest zoom at which b is fully
+// visible. Width and Height must already be set.
+func (m *StaticMap) Fit(b BoundingBox) {
+	c := b.Center()
+	m.Center = &c
+	m.Zoom = FitZoom(b, m.Width, m.Height)
+}
+
+// URL returns the image URL for m. If signingSecret is set the URL is
+// signed with it, as Google requires for most keys.
+func (m *StaticMap) URL(apiKey, signingSecret string) (string, error) {
+	params := url.Values{}
+	params.Set("size", strconv.Itoa(m.Width)+"x"+strconv.Itoa(m.Height))
+	if m.Center != nil {
+		params.Set("center", latLngParam(*m.Center))
+		params.Set("zoom", strconv.Itoa(m.Zoom))
+	}
+	if m.Scale > 1 {
+		params.Set("scale", strconv.Itoa(m.Scale))
+	}
+	setIf(params, "maptype", m.MapType)
+	setIf(params, "format", m.Format)
+	setIf(params, "language", m.Language)
+	setIf(params, "region", m.Region)
+	for _, mk := range m.Markers {
+		style := styleParts("color", mk.Color, "size", mk.Size, "label", mk.Label)
+		for _, p := range mk.Points {
+			style = append(style, latLngParam(p))
+		}
+		params.Add("markers", strings.Join(style, "|"))
+	}
+	for _, p := range m.Paths {
+		weight := ""
+		if p.Weight > 0 {
+			weight = strconv.Itoa(p.Weight)
+		}
+		style := styleParts("color", p.Color, "weight", weight, "fillcolor", p.FillColor)
+		style = append(style, "enc:"+EncodePolyline(p.Points))
+		params.Add("path", strings.Join(style, "|"))
+	}
+	if len(m.Visible) > 0 {
+		params.Set("visible", latLngsParam(m.Visible))
+	}
+	setIf(params, "key", strings.TrimSpace(apiKey))
+
+	resource := "/maps/api/staticmap?" + params.Encode()
+	if signingSecret != "" {
+		sig, err := signURL(resource, signingSecret)
+		if err != nil {
+			return "", err
+		}
+		resource += "&signature=" + sig
+	}
+	return mapsAPIHost + resource, nil
+}
+
+// signURL computes Google's URL signature for a path and query using a
+// URL-safe base64 signing secret.
+func signURL(resource, secret string) (string, error) {
+	key, err := base64.URLEncoding.DecodeString(secret)
+	if err != nil {
+		return "", InvalidSigningSecretError
+	}
+	mac := hmac.New(sha1.New, key)
+	mac.Write([]byte(resource))
+	return base64.URLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func setIf(params url.Values, key, value string) {
+	if value != "" {
+		params.Set(key, value)
+	}
+}
+
+func styleParts(kv ...string) []string {
+	parts := []string{}
+	for i := 0; i < len(kv); i += 2 {
+		if kv[i+1] != "" {
+			parts = append(parts, kv[i]+":"+kv[i+1])
+		}
+	}
+	return parts
+}
@@ -0,0 +1,61 @@
+package geo
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSignURL(t *testing.T) {
+	// Example from Google's URL signing documentation.
+	got, err := signURL("/maps/api/geocode/json?address=New+York&client=clientID", "vNIXE0xscrmjlyV-12Nj_BvUPaw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "chaRF2hTJKOScPr-RQCEhZbSzIE="; got != expected {
+		t.Errorf("Expected: %s, Got: %s", expected, got)
+	}
+	if _, err := signURL("/", "not base64!"); err != InvalidSigningSecretError {
+		t.Errorf("Expected: %v, Got: %v", InvalidSigningSecretError, err)
+	}
+}
+
+func TestStaticMapURL(t *testing.T) {
+	m := &StaticMap{
+		Width:   600,
+		Height:  300,
+		Scale:   2,
+		MapType: MapTypeRoadmap,
+		Markers: []StaticMapMarkers{{Color: "red", Label: "A", Points: []LatLng{{40.7453, -74.0078}}}},
+		Paths:   []StaticMapPath{{Color: "0x0000ff", Weight: 5, Points: polylinePoints}},
+	}
+	m.Fit(BoundingBox{LatLng{40.70, -74.05}, LatLng{40.80, -73.95}})
+	raw, err := m.URL("key", "vNIXE0xscrmjlyV-12Nj_BvUPaw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(raw, "https://maps.googleapis.com/maps/api/staticmap?") {
+		t.Errorf("Unexpected URL: %s", raw)
+	}
+	u, _ := url.Parse(raw)
+	q := u.Query()
+	for k, v := range map[string]string{
+		"size":    "600x300",
+		"scale":   "2",
+		"center":  "40.75,-74",
+		"zoom":    "11",
+		"maptype": "roadmap",
+		"markers": "color:red|label:A|40.7453,-74.0078",
+		"path":    "color:0x0000ff|weight:5|enc:" + polylineEncoded,
+		"key":     "key",
+	} {
+		if q.Get(k) != v {
+			t.Errorf("%s: Expected: %s, Got: %s", k, v, q.Get(k))
+		}
+	}
+	i := strings.Index(raw, "&signature=")
+	sig, _ := signURL(strings.TrimPrefix(raw[:i], "https://maps.googleapis.com"), "vNIXE0xscrmjlyV-12Nj_BvUPaw=")
+	if q.Get("signature") != sig {
+		t.Errorf("Expected: %s, Got: %s", sig, q.Get("signature"))
+	}
+}
@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"context"
+	"strings"
+)
+
+type (
+	// StructuredQuery is an address already split into fields, as clean
+	// source data usually is. Country is a name or ISO 3166-1 code.
+	StructuredQuery struct {
+		HouseNumber string
+		Street      string
+		City        string
+		State       string
+		PostalCode  string
+		Country     string
+	}
+
+	// StructuredGeocoder is implemented by geocoders that can take a
+	// StructuredQuery directly, either through a structured endpoint or by
+	// mapping fields onto their own parameters.
+	StructuredGeocoder interface {
+		GeocodeStructured(ctx context.Context, sq StructuredQuery, opts ...RequestOption) (*Address, error)
+	}
+)
+
+// String renders sq as a single-line address, e.g.
+// "123 Main St, Springfield, IL 62701, US".
+func (sq StructuredQuery) String() string {
+	parts := []string{}
+	add := func(s string) {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	add(strings.TrimSpace(sq.HouseNumber + " " + sq.Street))
+	add(sq.City)
+	add(strings.TrimSpace(sq.State + " " + sq.PostalCode))
+	add(sq.Country)
+	return strings.Join(parts, ", ")
+}
+
+// GeocodeStructured geocodes sq with g's structured support if it has any,
+// and otherwise as the free-text query sq.String().
+func GeocodeStructured(ctx context.Context, g Geocoder, sq StructuredQuery, opts ...RequestOption) (*Address, error) {
+	if sg, ok := g.(StructuredGeocoder); ok {
+		return sg.GeocodeStructured(ctx, sq, opts...)
+	}
+	return g.Geocode(ctx, sq.String(), opts...)
+}
+
+// GeocodeStructured geocodes sq. The Geocoding API has no structured
+// endpoint, so the street, city, state and postal code are sent as the
+// address, and a country that resolves to an ISO code restricts results
+// with a component filter, which matches better than naming it in the text.
+func (c *Client) GeocodeStructured(ctx context.Context, sq StructuredQuery, opts ...RequestOption) (*Address, error) {
+	if country, ok := LookupCountry(sq.Country); ok {
+		sq.Country = ""
+		opts = append(opts[:len(opts):len(opts)], WithComponents(ComponentFilter{Country: country.Alpha2}))
+	}
+	return c.Geocode(ctx, sq.String(), opts...)
+}
+
+var _ StructuredGeocoder = (*Client)(nil)
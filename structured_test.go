@@ -0,0 +1,46 @@
+package geo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStructuredQueryString(t *testing.T) {
+	for _, c := range []struct {
+		sq   StructuredQuery
+		want string
+	}{
+		{StructuredQuery{HouseNumber: "123", Street: "Main St", City: "Springfield", State: "IL", PostalCode: "62701", Country: "US"}, "123 Main St, Springfield, IL 62701, US"},
+		{StructuredQuery{Street: "Rue de Rivoli", City: "Paris", Country: "France"}, "Rue de Rivoli, Paris, France"},
+		{StructuredQuery{PostalCode: "K1A 0B1"}, "K1A 0B1"},
+		{StructuredQuery{}, ""},
+	} {
+		if got := c.sq.String(); got != c.want {
+			t.Errorf("Expected: %s, Got: %s", c.want, got)
+		}
+	}
+}
+
+func TestClientGeocodeStructured(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	sq := StructuredQuery{HouseNumber: "24", Street: "Sussex Dr", City: "Ottawa", State: "ON", Country: "Canada"}
+	if _, err := GeocodeStructured(context.Background(), c, sq); err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if q.Get("address") != "24 Sussex Dr, Ottawa, ON" || q.Get("components") != "country:CA" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+}
+
+func TestGeocodeStructuredFallback(t *testing.T) {
+	var got string
+	g := funcGeocoder(func(ctx context.Context, q string) (*Address, error) {
+		got = q
+		return &Address{}, nil
+	})
+	GeocodeStructured(context.Background(), g, StructuredQuery{City: "Lyon", Country: "FR"})
+	if got != "Lyon, FR" {
+		t.Errorf("Expected: Lyon, FR, Got: %s", got)
+	}
+}
@@ -0,0 +1,105 @@
+package geo
+
+import "math"
+
+// MaxTileLatitude is the latitude limit of the Web Mercator projection used by
+// slippy-map tiles.
+const MaxTileLatitude = 85.05112878
+
+// Tile identifies an XYZ (slippy-map) tile.
+type Tile struct {
+	X, Y, Z int
+}
+
+// TileAt returns the tile containing ll at zoom level z.
+func TileAt(ll LatLng, z int) Tile {
+	n := float64(uint(1) << uint(z))
+	lat := toRadians(math.Max(-MaxTileLatitude, math.Min(MaxTileLatitude, ll.Lat)))
+	x := int(math.Floor((ll.Lng + 180) / 360 * n))
+	y := int(math.Floor((1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2 * n))
+	return Tile{X: clampTile(x, z), Y: clampTile(y, z), Z: z}
+}
+
+// TileBounds returns the area covered by tile x, y at zoom level z.
+func TileBounds(x, y, z int) BoundingBox {
+	return BoundingBox{
+		Southwest: tileCorner(x, y+1, z),
+		Northeast: tileCorner(x+1, y, z),
+	}
+}
+
+// Bounds returns the area covered by t.
+func (t Tile) Bounds() BoundingBox {
+	return TileBounds(t.X, t.Y, t.Z)
+}
+
+// TilesCovering returns every tile at zoom level z that intersects b.
+func TilesCovering(b BoundingBox, z int) []Tile {
+	sw := TileAt(b.Southwest, z)
+	ne := TileAt(b.Northeast, z)
+	xs := []int{}
+	if b.crossesAntimeridian() {
+		for x := sw.X; x < 1<<uint(z); x++ {
+			xs = append(xs, x)
+		}
+		for x := 0; x <= ne.X; x++ {
+			xs = append(xs, x)
+		}
+	} else {
+		for x := sw.X; x <= ne.X; x++ {
+			xs = append(xs, x)
+		}
+	}
+	tiles := []Tile{}
+	for y := ne.Y; y <= sw.Y; y++ {
+		for _, x := range xs {
+			tiles = append(tiles, Tile{X: x, Y: y, Z: z})
+		}
+	}
+	return tiles
+}
+
+// FitZoom returns the highest zoom level, up to 21, at which b fits within a
+// widthPx by heightPx map of 256-pixel tiles.
+func FitZoom(b BoundingBox, widthPx, heightPx int) int {
+	const maxZoom = 21
+	lngSpan := b.Northeast.Lng - b.Southwest.Lng
+	if b.crossesAntimeridian() {
+		lngSpan += 360
+	}
+	latSpan := mercatorY(b.Northeast.Lat) - mercatorY(b.Southwest.Lat)
+	zoom := maxZoom
+	if lngSpan > 0 {
+		zoom = int(math.Min(float64(zoom), math.Floor(math.Log2(float64(widthPx)/256*360/lngSpan)+1e-9)))
+	}
+	if latSpan > 0 {
+		zoom = int(math.Min(float64(zoom), math.Floor(math.Log2(float64(heightPx)/256*2*math.Pi/latSpan)+1e-9)))
+	}
+	if zoom < 0 {
+		return 0
+	}
+	return zoom
+}
+
+// mercatorY returns the unscaled Web Mercator y coordinate of lat, which
+// spans [-π, π] over the tile pyramid.
+func mercatorY(lat float64) float64 {
+	lat = toRadians(math.Max(-MaxTileLatitude, math.Min(MaxTileLatitude, lat)))
+	return math.Log(math.Tan(math.Pi/4 + lat/2))
+}
+
+func tileCorner(x, y, z int) LatLng {
+	n := float64(uint(1) << uint(z))
+	lat := math.Atan(math.Sinh(math.Pi * (1 - 2*float64(y)/n)))
+	return LatLng{Lat: toDegrees(lat), Lng: float64(x)/n*360 - 180}
+}
+
+func clampTile(v, z int) int {
+	if v < 0 {
+		return 0
+	}
+	if max := 1<<uint(z) - 1; v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTileAt(t *testing.T) {
+	tests := []struct {
+		LatLng   LatLng
+		Z        int
+		Expected Tile
+	}{
+		{LatLng{0, 0}, 0, Tile{0, 0, 0}},
+		{LatLng{40.7453721, -74.0078293}, 12, Tile{1205, 1539, 12}},
+		{LatLng{51.5074, -0.1278}, 10, Tile{511, 340, 10}},
+		{LatLng{89, 180}, 2, Tile{3, 0, 2}},
+	}
+	for _, test := range tests {
+		if got := TileAt(test.LatLng, test.Z); got != test.Expected {
+			t.Errorf("Expected: %v, Got: %v", test.Expected, got)
+		}
+	}
+}
+
+func TestTileBounds(t *testing.T) {
+	b := TileBounds(0, 0, 1)
+	if b.Southwest.Lng != -180 || b.Northeast.Lng != 0 || b.Southwest.Lat != 0 || math.Abs(b.Northeast.Lat-MaxTileLatitude) > 1e-6 {
+		t.Errorf("Unexpected bounds: %v", b)
+	}
+	tile := TileAt(LatLng{40.7453721, -74.0078293}, 15)
+	if !tile.Bounds().Contains(LatLng{40.7453721, -74.0078293}) {
+		t.Errorf("Expected tile %v to contain its point", tile)
+	}
+}
+
+func TestTilesCovering(t *testing.T) {
+	if got := TilesCovering(BoundingBox{LatLng{-10, -10}, LatLng{10, 10}}, 1); len(got) != 4 {
+		t.Errorf("Expected: 4 tiles, Got: %v", got)
+	}
+	// Crossing the antimeridian should wrap around rather than span the globe.
+	if got := TilesCovering(BoundingBox{LatLng{10, 170}, LatLng{20, -170}}, 2); len(got) != 2 {
+		t.Errorf("Expected: 2 tiles, Got: %v", got)
+	}
+}
+
+func TestFitZoom(t *testing.T) {
+	tests := []struct {
+		b        BoundingBox
+		w, h     int
+		expected int
+	}{
+		{TileBounds(0, 0, 0), 256, 256, 0},
+		{TileBounds(301, 385, 10), 256, 256, 10},
+		{TileBounds(301, 385, 10), 512, 512, 11},
+		{TileBounds(301, 385, 10), 512, 256, 10},
+		{BoundingBox{LatLng{40.7, -74.01}, LatLng{40.7, -74.01}}, 640, 640, 21},
+		{BoundingBox{LatLng{-20, 170}, LatLng{-10, -170}}, 256, 256, 4},
+	}
+	for _, test := range tests {
+		if got := FitZoom(test.b, test.w, test.h); got != test.expected {
+			t.Errorf("%v %dx%d: Expected: %d, Got: %d", test.b, test.w, test.h, test.expected, got)
+		}
+	}
+}
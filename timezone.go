@@ -0,0 +1,63 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type (
+	// TimezoneResult is the time zone in effect at a location and instant.
+	TimezoneResult struct {
+		ID        string
+		Name      string
+		Location  *time.Location
+		RawOffset time.Duration
+		DSTOffset time.Duration
+	}
+
+	timezoneResponse struct {
+		Status     string  `json:"status"`
+		TimeZoneID string  `json:"timeZoneId"`
+		Name       string  `json:"timeZoneName"`
+		RawOffset  float64 `json:"rawOffset"`
+		DSTOffset  float64 `json:"dstOffset"`
+	}
+)
+
+// Timezone looks up the time zone at ll as of t using the Google Time Zone
+// API. Location is loaded from the local tz database, falling back to a fixed
+// zone at the reported offset when the zone isn't available.
+func (c *Client) Timezone(ctx context.Context, ll LatLng, t time.Time) (*TimezoneResult, error) {
+	if err := validateLatLng("location", ll); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("location", latLngParam(ll))
+	params.Set("timestamp", strconv.FormatInt(t.Unix(), 10))
+	var r timezoneResponse
+	if err := c.getJSON(ctx, "/maps/api/timezone/json", params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(r.Status); err != nil {
+		return nil, err
+	}
+	res := &TimezoneResult{
+		ID:        r.TimeZoneID,
+		Name:      r.Name,
+		RawOffset: time.Duration(r.RawOffset) * time.Second,
+		DSTOffset: time.Duration(r.DSTOffset) * time.Second,
+	}
+	loc, err := time.LoadLocation(r.TimeZoneID)
+	if err != nil {
+		loc = time.FixedZone(r.TimeZoneID, int(r.RawOffset+r.DSTOffset))
+	}
+	res.Location = loc
+	return res, nil
+}
+
+// Offset returns the total offset from UTC, including daylight saving time.
+func (t *TimezoneResult) Offset() time.Duration {
+	return t.RawOffset + t.DSTOffset
+}
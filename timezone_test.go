@@ -0,0 +1,31 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTimezone(t *testing.T) {
+	c, last := newTestClient(t, `{"dstOffset":3600,"rawOffset":-18000,"status":"OK","timeZoneId":"America/New_York","timeZoneName":"Eastern Daylight Time"}`)
+	at := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	tz, err := c.Timezone(context.Background(), LatLng{40.7128, -74.006}, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if q.Get("location") != "40.7128,-74.006" || q.Get("timestamp") != "1593604800" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+	if tz.ID != "America/New_York" || tz.Offset() != -4*time.Hour {
+		t.Errorf("Expected: America/New_York -4h, Got: %s %v", tz.ID, tz.Offset())
+	}
+	if _, off := at.In(tz.Location).Zone(); off != -4*3600 {
+		t.Errorf("Expected: -14400, Got: %d", off)
+	}
+
+	c, _ = newTestClient(t, `{"status":"ZERO_RESULTS"}`)
+	if _, err := c.Timezone(context.Background(), LatLng{}, at); err == nil {
+		t.Errorf("Expected an error for ZERO_RESULTS")
+	}
+}
@@ -0,0 +1,235 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const tomtomAPIHost = "https://api.tomtom.com"
+
+// tomtomTypes maps TomTom result types, and the entityType of Geography
+// results, to Geocoding API types and precisions.
+var tomtomTypes = map[string]struct {
+	types     []string
+	precision Precision
+}{
+	"Point Address":               {[]string{"street_address", "premise"}, PrecisionRooftop},
+	"Address Range":               {[]string{"street_address"}, PrecisionInterpolated},
+	"Street":                      {[]string{"route"}, PrecisionCentroid},
+	"Cross Street":                {[]string{"intersection"}, PrecisionCentroid},
+	"POI":                         {[]string{"point_of_interest", "establishment"}, PrecisionCentroid},
+	"Country":                     {[]string{"country", "political"}, PrecisionApproximate},
+	"CountrySubdivision":          {[]string{"administrative_area_level_1", "political"}, PrecisionApproximate},
+	"CountrySecondarySubdivision": {[]string{"administrative_area_level_2", "political"}, PrecisionApproximate},
+	"Municipality":                {[]string{"locality", "political"}, PrecisionApproximate},
+	"MunicipalitySubdivision":     {[]string{"sublocality", "political"}, PrecisionApproximate},
+	"Neighbourhood":               {[]string{"neighborhood", "political"}, PrecisionApproximate},
+	"PostalCodeArea":              {[]string{"postal_code"}, PrecisionApproximate},
+
+	// Reverse geocoding reports a matchType instead.
+	"AddressPoint":     {[]string{"street_address", "premise"}, PrecisionRooftop},
+	"HouseNumberRange": {[]string{"street_address"}, PrecisionInterpolated},
+}
+
+type (
+	// TomTomGeocoder geocodes with the TomTom Search API, sending requests
+	// with Client, whose APIKey must be a TomTom key. WithLanguage,
+	// WithCountries (or a country component) and WithLocationBias are
+	// honored. TomTom's result type and entityType decide each result's
+	// Precision, and its match confidence, or failing that its relevance
+	// score relative to the best result, scales the Confidence.
+	TomTomGeocoder struct {
+		Client *Client
+	}
+
+	tomtomLatLng struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	}
+
+	tomtomAddress struct {
+		StreetNumber                string `json:"streetNumber"`
+		StreetName                  string `json:"streetName"`
+		MunicipalitySubdivision     string `json:"municipalitySubdivision"`
+		Municipality                string `json:"municipality"`
+		CountrySecondarySubdivision string `json:"countrySecondarySubdivision"`
+		CountrySubdivision          string `json:"countrySubdivision"`
+		CountrySubdivisionCode      string `json:"countrySubdivisionCode"`
+		PostalCode                  string `json:"postalCode"`
+		CountryCode                 string `json:"countryCode"`
+		Country                     string `json:"country"`
+		FreeformAddress             string `json:"freeformAddress"`
+	}
+
+	tomtomResult struct {
+		Type            string  `json:"type"`
+		ID              string  `json:"id"`
+		Score           float64 `json:"score"`
+		EntityType      string  `json:"entityType"`
+		MatchConfidence *struct {
+			Score float64 `json:"score"`
+		} `json:"matchConfidence"`
+		Address  tomtomAddress `json:"address"`
+		Position tomtomLatLng  `json:"position"`
+		Viewport struct {
+			TopLeftPoint  tomtomLatLng `json:"topLeftPoint"`
+			BtmRightPoint tomtomLatLng `json:"btmRightPoint"`
+		} `json:"viewport"`
+	}
+
+	tomtomGeocodeResponse struct {
+		Results []tomtomResult `json:"results"`
+	}
+
+	tomtomReverseResponse struct {
+		Addresses []struct {
+			Address   tomtomAddress `json:"address"`
+			Position  string        `json:"position"`
+			MatchType string        `json:"matchType"`
+		} `json:"addresses"`
+	}
+)
+
+// NewTomTomGeocoder returns a TomTomGeocoder that sends requests with c.
+func NewTomTomGeocoder(c *Client) *TomTomGeocoder {
+	return &TomTomGeocoder{Client: c}
+}
+
+// Geocode looks up q with the Geocode endpoint.
+func (t *TomTomGeocoder) Geocode(ctx context.Context, q string, opts ...RequestOption) (*Address, error) {
+	q = t.Client.normalizeQuery(q)
+	o := newRequestOptions(opts)
+	if err := validateQuery(q, o); err != nil {
+		return nil, err
+	}
+	params := tomtomParams(o)
+	if loc := o.params.Get("location"); loc != "" {
+		if ll, ok := TryParseCoordinates(loc); ok {
+			params.Set("lat", strconv.FormatFloat(ll.Lat, 'f', -1, 64))
+			params.Set("lon", strconv.FormatFloat(ll.Lng, 'f', -1, 64))
+			if r := o.params.Get("radius"); r != "" {
+				params.Set("radius", r)
+			}
+		}
+	}
+	var r tomtomGeocodeResponse
+	if err := t.Client.getJSONFrom(ctx, tomtomAPIHost, "/search/2/geocode/"+url.PathEscape(q)+".json", params, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	g := &Response{Status: StatusOk, Results: make([]Result, len(r.Results))}
+	best := r.Results[0].Score
+	for i, tr := range r.Results {
+		res := &g.Results[i]
+		*res = tr.Address.result()
+		res.PlaceID = tr.ID
+		res.Geometry.Location = LatLng{tr.Position.Lat, tr.Position.Lon}
+		res.Geometry.Viewport = BoundingBox{
+			Southwest: LatLng{tr.Viewport.BtmRightPoint.Lat, tr.Viewport.TopLeftPoint.Lon},
+			Northeast: LatLng{tr.Viewport.TopLeftPoint.Lat, tr.Viewport.BtmRightPoint.Lon},
+		}
+		kind := tr.Type
+		if kind == "Geography" {
+			kind = tr.EntityType
+		}
+		res.scoreTomTom(kind)
+		switch {
+		case tr.MatchConfidence != nil:
+			res.Confidence *= tr.MatchConfidence.Score
+		case best > 0:
+			res.Confidence *= tr.Score / best
+		}
+	}
+	return t.address(g), nil
+}
+
+// ReverseGeocode looks up the address at ll.
+func (t *TomTomGeocoder) ReverseGeocode(ctx context.Context, ll LatLng, opts ...RequestOption) (*Address, error) {
+	if err := validateLatLng("latlng", ll); err != nil {
+		return nil, err
+	}
+	params := tomtomParams(newRequestOptions(opts))
+	var r tomtomReverseResponse
+	if err := t.Client.getJSONFrom(ctx, tomtomAPIHost, "/search/2/reverseGeocode/"+latLngParam(ll)+".json", params, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Addresses) == 0 {
+		return nil, &GeocoderError{Status: StatusZeroResults}
+	}
+	g := &Response{Status: StatusOk, Results: make([]Result, len(r.Addresses))}
+	for i, ta := range r.Addresses {
+		res := &g.Results[i]
+		*res = ta.Address.result()
+		if pos, ok := TryParseCoordinates(ta.Position); ok {
+			res.Geometry.Location = pos
+		}
+		res.scoreTomTom(ta.MatchType)
+	}
+	return t.address(g), nil
+}
+
+func tomtomParams(o *requestOptions) url.Values {
+	params := url.Values{}
+	if lang := o.params.Get("language"); lang != "" {
+		params.Set("language", lang)
+	}
+	if countries := o.countries(); len(countries) > 0 {
+		params.Set("countrySet", strings.Join(countries, ","))
+	}
+	return params
+}
+
+// result converts a TomTom address to a Result's components.
+func (a tomtomAddress) result() Result {
+	res := Result{FormattedAddress: a.FreeformAddress}
+	for _, c := range []AddressComponent{
+		{a.StreetNumber, a.StreetNumber, []string{"street_number"}},
+		{a.StreetName, a.StreetName, []string{"route"}},
+		{a.MunicipalitySubdivision, a.MunicipalitySubdivision, []string{"sublocality", "political"}},
+		{a.Municipality, a.Municipality, []string{"locality", "political"}},
+		{a.CountrySecondarySubdivision, a.CountrySecondarySubdivision, []string{"administrative_area_level_2", "political"}},
+		{a.CountrySubdivision, a.CountrySubdivisionCode, []string{"administrative_area_level_1", "political"}},
+		{a.PostalCode, a.PostalCode, []string{"postal_code"}},
+		{a.Country, a.CountryCode, []string{"country", "political"}},
+	} {
+		if c.LongName != "" || c.ShortName != "" {
+			if c.ShortName == "" {
+				c.ShortName = c.LongName
+			}
+			res.AddressComponents = append(res.AddressComponents, c)
+		}
+	}
+	return res
+}
+
+// scoreTomTom sets Types, Precision and Confidence from a TomTom result
+// type, entityType or matchType, falling back to the components present.
+func (r *Result) scoreTomTom(kind string) {
+	if t, ok := tomtomTypes[kind]; ok {
+		r.Types = t.types
+		r.scorePrecision(t.precision)
+		return
+	}
+	// Components run from the most specific to the least.
+	if len(r.AddressComponents) > 0 {
+		r.Types = r.AddressComponents[0].Types
+		if hasType(r.Types, "street_number") {
+			r.Types = []string{"street_address"}
+		}
+	}
+	r.scorePrecision(typePrecision(r.Types))
+}
+
+func (t *TomTomGeocoder) address(g *Response) *Address {
+	first := g.Results[0]
+	a := &Address{Lat: first.Geometry.Location.Lat, Lng: first.Geometry.Location.Lng, Address: first.FormattedAddress, Response: g}
+	a.stamp(time.Now(), t.Client.Validity)
+	return a
+}
+
+var _ Geocoder = (*TomTomGeocoder)(nil)
@@ -0,0 +1,80 @@
+package geo
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const tomtomGeocodeBody = `{"summary": {"numResults": 2}, "results": [
+	{"type": "Point Address", "id": "CA/PAD/p0/1", "score": 12.5, "matchConfidence": {"score": 0.9},
+	 "address": {"streetNumber": "24", "streetName": "Sussex Drive", "municipality": "Ottawa", "countrySubdivision": "Ontario",
+	  "countrySubdivisionCode": "ON", "postalCode": "K1M 1M4", "countryCode": "CA", "country": "Canada",
+	  "freeformAddress": "24 Sussex Drive, Ottawa ON K1M 1M4"},
+	 "position": {"lat": 45.4443, "lon": -75.6939},
+	 "viewport": {"topLeftPoint": {"lat": 45.4452, "lon": -75.6952}, "btmRightPoint": {"lat": 45.4434, "lon": -75.6926}}},
+	{"type": "Geography", "entityType": "Municipality", "id": "CA/GEO/p0/2", "score": 5,
+	 "address": {"municipality": "Ottawa", "countryCode": "CA", "country": "Canada", "freeformAddress": "Ottawa ON"},
+	 "position": {"lat": 45.4215, "lon": -75.6972}}
+]}`
+
+func newTomTomTestGeocoder(t *testing.T, body string) (*TomTomGeocoder, **http.Request) {
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewTomTomGeocoder(NewClient("tomtom-key", WithBaseURL(srv.URL))), &last
+}
+
+func TestTomTomGeocode(t *testing.T) {
+	tt, last := newTomTomTestGeocoder(t, tomtomGeocodeBody)
+	a, err := tt.Geocode(context.Background(), "24 Sussex Dr, Ottawa", WithCountries("ca"), WithLanguage("en-GB"), WithLocationBias(LatLng{45.4, -75.7}, 10*Kilometer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := (*last).URL.Query()
+	if (*last).URL.Path != "/search/2/geocode/24 Sussex Dr, Ottawa.json" || q.Get("key") != "tomtom-key" {
+		t.Errorf("Unexpected request: %s", (*last).URL)
+	}
+	if q.Get("countrySet") != "CA" || q.Get("language") != "en-GB" || q.Get("lat") != "45.4" || q.Get("radius") != "10000" {
+		t.Errorf("Unexpected params: %v", q)
+	}
+	if a.Address != "24 Sussex Drive, Ottawa ON K1M 1M4" || a.Lat != 45.4443 || a.CountryCode() != "CA" {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+	r := a.Response.Results
+	if r[0].Precision != PrecisionRooftop || math.Abs(r[0].Confidence-0.9) > 1e-9 || r[0].ParsedAddress().StateCode != "ON" {
+		t.Errorf("Unexpected first result: %+v", r[0])
+	}
+	if !r[0].Geometry.Viewport.Contains(LatLng{a.Lat, a.Lng}) {
+		t.Errorf("Expected: the viewport to contain the point, Got: %+v", r[0].Geometry.Viewport)
+	}
+	// A locality match, discounted by its score relative to the best.
+	if r[1].Precision != PrecisionApproximate || !hasType(r[1].Types, "locality") || math.Abs(r[1].Confidence-0.3*5/12.5) > 1e-9 {
+		t.Errorf("Unexpected second result: %+v", r[1])
+	}
+}
+
+func TestTomTomReverseGeocode(t *testing.T) {
+	tt, last := newTomTomTestGeocoder(t, `{"addresses": [{"matchType": "HouseNumberRange", "position": "45.444300,-75.693900",
+		"address": {"streetNumber": "24", "streetName": "Sussex Drive", "municipality": "Ottawa", "freeformAddress": "24 Sussex Drive, Ottawa"}}]}`)
+	a, err := tt.ReverseGeocode(context.Background(), LatLng{45.4443, -75.6939})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*last).URL.Path != "/search/2/reverseGeocode/45.4443,-75.6939.json" {
+		t.Errorf("Unexpected request: %s", (*last).URL)
+	}
+	if a.Lat != 45.4443 || a.Lng != -75.6939 || a.Precision() != PrecisionInterpolated {
+		t.Errorf("Unexpected address: %+v", a)
+	}
+
+	tt, _ = newTomTomTestGeocoder(t, `{"addresses": []}`)
+	if _, err := tt.ReverseGeocode(context.Background(), LatLng{0, 0}); err == nil || err.(*GeocoderError).Status != StatusZeroResults {
+		t.Errorf("Expected: %s, Got: %v", StatusZeroResults, err)
+	}
+}
@@ -0,0 +1,80 @@
+package geo
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// TransportOptions sizes the connection pool of a transport built by
+// NewTransport. Zero fields take the value in DefaultTransportOptions.
+type TransportOptions struct {
+	MaxIdleConns          int
+	MaxIdleConnsPerHost   int
+	MaxConnsPerHost       int
+	IdleConnTimeout       time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration
+}
+
+// DefaultTransportOptions suit a process sending many concurrent requests to
+// a few API hosts. http.DefaultTransport keeps only two idle connections per
+// host, so under batch loads most connections are closed after one request
+// and the process runs out of ephemeral ports.
+var DefaultTransportOptions = TransportOptions{
+	MaxIdleConns:          256,
+	MaxIdleConnsPerHost:   64,
+	IdleConnTimeout:       90 * time.Second,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ResponseHeaderTimeout: 30 * time.Second,
+}
+
+// sharedHTTPClient is used by Clients that aren't given their own, so that
+// they all share one connection pool.
+var sharedHTTPClient = &http.Client{Transport: NewTransport(DefaultTransportOptions)}
+
+// NewTransport returns a keep-alive, HTTP/2-capable transport tuned by o.
+func NewTransport(o TransportOptions) *http.Transport {
+	d := DefaultTransportOptions
+	if o.MaxIdleConns == 0 {
+		o.MaxIdleConns = d.MaxIdleConns
+	}
+	if o.MaxIdleConnsPerHost == 0 {
+		o.MaxIdleConnsPerHost = d.MaxIdleConnsPerHost
+	}
+	if o.MaxConnsPerHost == 0 {
+		o.MaxConnsPerHost = d.MaxConnsPerHost
+	}
+	if o.IdleConnTimeout == 0 {
+		o.IdleConnTimeout = d.IdleConnTimeout
+	}
+	if o.TLSHandshakeTimeout == 0 {
+		o.TLSHandshakeTimeout = d.TLSHandshakeTimeout
+	}
+	if o.ResponseHeaderTimeout == 0 {
+		o.ResponseHeaderTimeout = d.ResponseHeaderTimeout
+	}
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          o.MaxIdleConns,
+		MaxIdleConnsPerHost:   o.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       o.MaxConnsPerHost,
+		IdleConnTimeout:       o.IdleConnTimeout,
+		TLSHandshakeTimeout:   o.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: o.ResponseHeaderTimeout,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+// WithTransportOptions gives the Client its own connection pool tuned by o,
+// instead of the one shared by all Clients.
+func WithTransportOptions(o TransportOptions) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = &http.Client{Transport: NewTransport(o)}
+	}
+}
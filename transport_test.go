@@ -0,0 +1,32 @@
+package geo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewTransport(t *testing.T) {
+	tr := NewTransport(TransportOptions{MaxIdleConnsPerHost: 8, IdleConnTimeout: time.Minute})
+	if tr.MaxIdleConnsPerHost != 8 || tr.IdleConnTimeout != time.Minute {
+		t.Errorf("Expected: the given options, Got: %d %v", tr.MaxIdleConnsPerHost, tr.IdleConnTimeout)
+	}
+	if tr.MaxIdleConns != DefaultTransportOptions.MaxIdleConns || !tr.ForceAttemptHTTP2 {
+		t.Errorf("Expected: defaults for unset options, Got: %d", tr.MaxIdleConns)
+	}
+}
+
+func TestClientTransportOptions(t *testing.T) {
+	if NewClient("k").HTTPClient != sharedHTTPClient {
+		t.Error("Expected: clients to share a transport by default")
+	}
+	c, _ := newTestClient(t, `{}`)
+	WithTransportOptions(TransportOptions{MaxConnsPerHost: 4})(c)
+	if c.HTTPClient == sharedHTTPClient {
+		t.Error("Expected: a dedicated transport")
+	}
+	var out struct{}
+	if err := c.getJSON(context.Background(), "/", map[string][]string{}, &out); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,120 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var InvalidDistanceError = errors.New("Invalid distance.")
+
+// Distance is a length in meters. Radius and distance parameters take a
+// Distance so that the unit is explicit at the call site, e.g.
+// 2*Kilometer or 0.5*Mile.
+type Distance float64
+
+const (
+	Meter        Distance = 1
+	Kilometer    Distance = 1000
+	Foot         Distance = 0.3048
+	Mile         Distance = 1609.344
+	NauticalMile Distance = 1852
+)
+
+// Speed is a rate of travel in meters per second.
+type Speed float64
+
+const (
+	MeterPerSecond   Speed = 1
+	KilometerPerHour Speed = 1000.0 / 3600
+	MilePerHour      Speed = 1609.344 / 3600
+	Knot             Speed = 1852.0 / 3600
+)
+
+// distanceUnits are the suffixes ParseDistance accepts.
+var distanceUnits = map[string]Distance{
+	"m": Meter, "meter": Meter, "meters": Meter, "metre": Meter, "metres": Meter,
+	"km": Kilometer, "kilometer": Kilometer, "kilometers": Kilometer, "kilometre": Kilometer, "kilometres": Kilometer,
+	"ft": Foot, "foot": Foot, "feet": Foot,
+	"mi": Mile, "mile": Mile, "miles": Mile,
+	"nm": NauticalMile, "nmi": NauticalMile,
+}
+
+// ParseDistance parses a number followed by a unit, such as "200m",
+// "1.5 km", "3 mi", "500ft" or "2nm". A bare number is in meters.
+func ParseDistance(s string) (Distance, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	num, unit := s, "m"
+	if i >= 0 {
+		num, unit = s[:i], strings.TrimSpace(s[i:])
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	u, ok := distanceUnits[unit]
+	if err != nil || !ok || math.IsInf(v, 0) {
+		return 0, InvalidDistanceError
+	}
+	return Distance(v) * u, nil
+}
+
+// Distance returns the great-circle distance between l and o.
+func (l LatLng) Distance(o LatLng) Distance {
+	return Distance(l.DistanceTo(o))
+}
+
+func (d Distance) Meters() float64        { return float64(d) }
+func (d Distance) Kilometers() float64    { return float64(d / Kilometer) }
+func (d Distance) Feet() float64          { return float64(d / Foot) }
+func (d Distance) Miles() float64         { return float64(d / Mile) }
+func (d Distance) NauticalMiles() float64 { return float64(d / NauticalMile) }
+
+// String formats d in meters below a kilometer and in kilometers above,
+// e.g. "850 m" or "12.3 km".
+func (d Distance) String() string {
+	if math.Abs(float64(d)) < float64(Kilometer) {
+		return strconv.FormatFloat(math.Round(float64(d)), 'f', -1, 64) + " m"
+	}
+	return formatUnits(d.Kilometers()) + " km"
+}
+
+// Imperial formats d in feet below a tenth of a mile and in miles above,
+// e.g. "300 ft" or "2.5 mi".
+func (d Distance) Imperial() string {
+	if math.Abs(float64(d)) < float64(Mile/10) {
+		return strconv.FormatFloat(math.Round(d.Feet()), 'f', -1, 64) + " ft"
+	}
+	return formatUnits(d.Miles()) + " mi"
+}
+
+// formatUnits rounds v to one decimal place below 100 and to a whole number
+// above.
+func formatUnits(v float64) string {
+	if math.Abs(v) >= 100 {
+		return strconv.FormatFloat(math.Round(v), 'f', -1, 64)
+	}
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
+
+// SpeedOf returns the speed of covering d in t.
+func SpeedOf(d Distance, t time.Duration) Speed {
+	return Speed(float64(d) / t.Seconds())
+}
+
+// Duration returns how long covering d takes at s.
+func (d Distance) Duration(s Speed) time.Duration {
+	return time.Duration(float64(d) / float64(s) * float64(time.Second))
+}
+
+func (s Speed) MetersPerSecond() float64   { return float64(s) }
+func (s Speed) KilometersPerHour() float64 { return float64(s / KilometerPerHour) }
+func (s Speed) MilesPerHour() float64      { return float64(s / MilePerHour) }
+func (s Speed) Knots() float64             { return float64(s / Knot) }
+
+// String formats s in kilometers per hour, e.g. "42.5 km/h".
+func (s Speed) String() string {
+	return formatUnits(s.KilometersPerHour()) + " km/h"
+}
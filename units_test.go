@@ -0,0 +1,75 @@
+package geo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseDistance(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected Distance
+	}{
+		{"200m", 200},
+		{"1.5 km", 1500},
+		{"3 mi", 3 * Mile},
+		{"500ft", 152.4},
+		{"2nm", 3704},
+		{" 42 ", 42},
+		{"10 Miles", 10 * Mile},
+	}
+	for _, test := range tests {
+		d, err := ParseDistance(test.s)
+		if err != nil || math.Abs(float64(d-test.expected)) > 1e-9 {
+			t.Errorf("%q: Expected: %v, Got: %v (%v)", test.s, test.expected, d, err)
+		}
+	}
+	for _, bad := range []string{"", "km", "5 parsecs", "1e999m"} {
+		if _, err := ParseDistance(bad); err != InvalidDistanceError {
+			t.Errorf("%q: Expected: %v, Got: %v", bad, InvalidDistanceError, err)
+		}
+	}
+}
+
+func TestDistanceFormatting(t *testing.T) {
+	tests := []struct {
+		d                Distance
+		metric, imperial string
+	}{
+		{849.6, "850 m", "0.5 mi"},
+		{12345, "12.3 km", "7.7 mi"},
+		{150 * Kilometer, "150 km", "93.2 mi"},
+		{100 * Foot, "30 m", "100 ft"},
+	}
+	for _, test := range tests {
+		if s := test.d.String(); s != test.metric {
+			t.Errorf("Expected: %s, Got: %s", test.metric, s)
+		}
+		if s := test.d.Imperial(); s != test.imperial {
+			t.Errorf("Expected: %s, Got: %s", test.imperial, s)
+		}
+	}
+	if mi := (5 * Kilometer).Miles(); math.Abs(mi-3.10686) > 1e-5 {
+		t.Errorf("Expected: 3.10686, Got: %v", mi)
+	}
+	if nm := NauticalMile.Kilometers(); nm != 1.852 {
+		t.Errorf("Expected: 1.852, Got: %v", nm)
+	}
+}
+
+func TestSpeed(t *testing.T) {
+	s := SpeedOf(10*Kilometer, 30*time.Minute)
+	if kph := s.KilometersPerHour(); math.Abs(kph-20) > 1e-9 {
+		t.Errorf("Expected: 20, Got: %v", kph)
+	}
+	if s.String() != "20 km/h" {
+		t.Errorf("Expected: 20 km/h, Got: %s", s)
+	}
+	if d := (60 * Mile).Duration(60 * MilePerHour); d != time.Hour {
+		t.Errorf("Expected: %v, Got: %v", time.Hour, d)
+	}
+	if k := (10 * Knot).MilesPerHour(); math.Abs(k-11.5078) > 1e-4 {
+		t.Errorf("Expected: 11.5078, Got: %v", k)
+	}
+}
@@ -0,0 +1,259 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	UTMRangeError    = errors.New("Latitude is outside the UTM range (80S to 84N).")
+	InvalidUTMError  = errors.New("Invalid UTM coordinate.")
+	InvalidMGRSError = errors.New("Invalid MGRS grid reference.")
+)
+
+// UTM is a Universal Transverse Mercator coordinate on the WGS84 ellipsoid.
+type UTM struct {
+	Zone     int
+	North    bool
+	Easting  float64
+	Northing float64
+}
+
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+
+	utmK0            = 0.9996
+	utmFalseEasting  = 500e3
+	utmFalseNorthing = 10000e3
+
+	mgrsLatBands = "CDEFGHJKLMNPQRSTUVWXX"
+)
+
+var (
+	mgrsE100kLetters = [3]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+	mgrsN100kLetters = [2]string{"ABCDEFGHJKLMNPQRSTUV", "FGHJKLMNPQRSTUVABCDE"}
+)
+
+func (u UTM) String() string {
+	h := "S"
+	if u.North {
+		h = "N"
+	}
+	return fmt.Sprintf("%d%s %.0f %.0f", u.Zone, h, u.Easting, u.Northing)
+}
+
+// UTM converts l to UTM, applying the Norway and Svalbard zone exceptions.
+func (l LatLng) UTM() (UTM, error) {
+	if l.Lat < -80 || l.Lat > 84 {
+		return UTM{}, UTMRangeError
+	}
+	zone := int(math.Floor((l.Lng+180)/6)) + 1
+	if zone > 60 {
+		zone = 60
+	}
+	band := mgrsLatBands[int(math.Floor(l.Lat/8+10))]
+	if zone == 31 && band == 'V' && l.Lng >= 3 {
+		zone++
+	}
+	if band == 'X' {
+		switch {
+		case zone == 32 && l.Lng < 9, zone == 34 && l.Lng < 21, zone == 36 && l.Lng < 33:
+			zone--
+		case zone == 32, zone == 34, zone == 36:
+			zone++
+		}
+	}
+	return utmFromLatLng(l, zone), nil
+}
+
+func utmFromLatLng(l LatLng, zone int) UTM {
+	lng0 := toRadians(float64((zone-1)*6 - 180 + 3))
+	phi := toRadians(l.Lat)
+	lambda := toRadians(l.Lng) - lng0
+
+	e := math.Sqrt(wgs84F * (2 - wgs84F))
+	n := wgs84F / (2 - wgs84F)
+
+	tau := math.Tan(phi)
+	sigma := math.Sinh(e * math.Atanh(e*tau/math.Sqrt(1+tau*tau)))
+	tauP := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+
+	xiP := math.Atan2(tauP, math.Cos(lambda))
+	etaP := math.Asinh(math.Sin(lambda) / math.Sqrt(tauP*tauP+math.Cos(lambda)*math.Cos(lambda)))
+
+	alpha := utmAlpha(n)
+	xi, eta := xiP, etaP
+	for j := 1; j <= 6; j++ {
+		xi += alpha[j] * math.Sin(2*float64(j)*xiP) * math.Cosh(2*float64(j)*etaP)
+		eta += alpha[j] * math.Cos(2*float64(j)*xiP) * math.Sinh(2*float64(j)*etaP)
+	}
+
+	a := utmRectifyingRadius(n)
+	u := UTM{
+		Zone:     zone,
+		North:    l.Lat >= 0,
+		Easting:  utmK0*a*eta + utmFalseEasting,
+		Northing: utmK0 * a * xi,
+	}
+	if u.Northing < 0 {
+		u.Northing += utmFalseNorthing
+	}
+	return u
+}
+
+// LatLng converts u back to WGS84 latitude and longitude.
+func (u UTM) LatLng() (LatLng, error) {
+	if u.Zone < 1 || u.Zone > 60 || u.Northing < 0 || u.Northing > utmFalseNorthing {
+		return LatLng{}, InvalidUTMError
+	}
+	e := math.Sqrt(wgs84F * (2 - wgs84F))
+	n := wgs84F / (2 - wgs84F)
+	a := utmRectifyingRadius(n)
+
+	x := u.Easting - utmFalseEasting
+	y := u.Northing
+	if !u.North {
+		y -= utmFalseNorthing
+	}
+	eta := x / (utmK0 * a)
+	xi := y / (utmK0 * a)
+
+	beta := utmBeta(n)
+	xiP, etaP := xi, eta
+	for j := 1; j <= 6; j++ {
+		xiP -= beta[j] * math.Sin(2*float64(j)*xi) * math.Cosh(2*float64(j)*eta)
+		etaP -= beta[j] * math.Cos(2*float64(j)*xi) * math.Sinh(2*float64(j)*eta)
+	}
+
+	sinhEtaP := math.Sinh(etaP)
+	sinXiP, cosXiP := math.Sin(xiP), math.Cos(xiP)
+	tauP := sinXiP / math.Sqrt(sinhEtaP*sinhEtaP+cosXiP*cosXiP)
+
+	tau := tauP
+	for i := 0; i < 10; i++ {
+		sigma := math.Sinh(e * math.Atanh(e*tau/math.Sqrt(1+tau*tau)))
+		tauI := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+		delta := (tauP - tauI) / math.Sqrt(1+tauI*tauI) *
+			(1 + (1-e*e)*tau*tau) / ((1 - e*e) * math.Sqrt(1+tau*tau))
+		tau += delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+
+	lng0 := float64((u.Zone-1)*6 - 180 + 3)
+	return LatLng{
+		Lat: toDegrees(math.Atan(tau)),
+		Lng: lng0 + toDegrees(math.Atan2(sinhEtaP, cosXiP)),
+	}, nil
+}
+
+// MGRS returns the Military Grid Reference System reference for l, with
+// precision digits (1-5) for each of the easting and northing. Five digits
+// resolves to one metre.
+func (l LatLng) MGRS(precision int) (string, error) {
+	if precision < 1 || precision > 5 {
+		precision = 5
+	}
+	u, err := l.UTM()
+	if err != nil {
+		return "", err
+	}
+	band := mgrsLatBands[int(math.Floor(l.Lat/8+10))]
+	col := int(math.Floor(u.Easting / 100e3))
+	row := int(math.Floor(u.Northing/100e3)) % 20
+	e100k := mgrsE100kLetters[(u.Zone-1)%3][col-1]
+	n100k := mgrsN100kLetters[(u.Zone-1)%2][row]
+
+	scale := math.Pow(10, float64(5-precision))
+	easting := int(math.Floor(math.Mod(u.Easting, 100e3) / scale))
+	northing := int(math.Floor(math.Mod(u.Northing, 100e3) / scale))
+	return fmt.Sprintf("%02d%c %c%c %0*d %0*d", u.Zone, band, e100k, n100k, precision, easting, precision, northing), nil
+}
+
+// ParseMGRS parses an MGRS reference such as "31U DQ 48251 11932" (spaces
+// optional) and returns the south-west corner of the referenced square.
+func ParseMGRS(s string) (LatLng, error) {
+	s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	i := 0
+	for i < len(s) && i < 2 && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 || len(s) < i+3 {
+		return LatLng{}, InvalidMGRSError
+	}
+	zone, _ := strconv.Atoi(s[:i])
+	band := s[i]
+	bandIndex := strings.IndexByte(mgrsLatBands[:20], band)
+	if zone < 1 || zone > 60 || bandIndex < 0 {
+		return LatLng{}, InvalidMGRSError
+	}
+	col := strings.IndexByte(mgrsE100kLetters[(zone-1)%3], s[i+1])
+	row := strings.IndexByte(mgrsN100kLetters[(zone-1)%2], s[i+2])
+	digits := s[i+3:]
+	if col < 0 || row < 0 || len(digits)%2 != 0 || len(digits) > 10 {
+		return LatLng{}, InvalidMGRSError
+	}
+
+	var easting, northing float64
+	if half := len(digits) / 2; half > 0 {
+		e, err1 := strconv.Atoi(digits[:half])
+		n, err2 := strconv.Atoi(digits[half:])
+		if err1 != nil || err2 != nil {
+			return LatLng{}, InvalidMGRSError
+		}
+		scale := math.Pow(10, float64(5-half))
+		easting, northing = float64(e)*scale, float64(n)*scale
+	}
+
+	// The row letters repeat every 2000km, so pick the cycle that places the
+	// northing inside the latitude band.
+	bandLat := float64(bandIndex-10) * 8
+	bottom := utmFromLatLng(LatLng{Lat: bandLat, Lng: float64((zone-1)*6 - 180 + 3)}, zone)
+	bandNorthing := math.Floor(bottom.Northing/100e3) * 100e3
+	n100k := float64(row) * 100e3
+	n2M := 0.0
+	for n2M+n100k+northing < bandNorthing {
+		n2M += 2000e3
+	}
+
+	return UTM{
+		Zone:     zone,
+		North:    band >= 'N',
+		Easting:  float64(col+1)*100e3 + easting,
+		Northing: n2M + n100k + northing,
+	}.LatLng()
+}
+
+func utmRectifyingRadius(n float64) float64 {
+	n2 := n * n
+	return wgs84A / (1 + n) * (1 + n2/4 + n2*n2/64 + n2*n2*n2/256)
+}
+
+func utmAlpha(n float64) [7]float64 {
+	n2, n3, n4, n5, n6 := n*n, n*n*n, n*n*n*n, n*n*n*n*n, n*n*n*n*n*n
+	return [7]float64{0,
+		1.0/2*n - 2.0/3*n2 + 5.0/16*n3 + 41.0/180*n4 - 127.0/288*n5 + 7891.0/37800*n6,
+		13.0/48*n2 - 3.0/5*n3 + 557.0/1440*n4 + 281.0/630*n5 - 1983433.0/1935360*n6,
+		61.0/240*n3 - 103.0/140*n4 + 15061.0/26880*n5 + 167603.0/181440*n6,
+		49561.0/161280*n4 - 179.0/168*n5 + 6601661.0/7257600*n6,
+		34729.0/80640*n5 - 3418889.0/1995840*n6,
+		212378941.0 / 319334400 * n6,
+	}
+}
+
+func utmBeta(n float64) [7]float64 {
+	n2, n3, n4, n5, n6 := n*n, n*n*n, n*n*n*n, n*n*n*n*n, n*n*n*n*n*n
+	return [7]float64{0,
+		1.0/2*n - 2.0/3*n2 + 37.0/96*n3 - 1.0/360*n4 - 81.0/512*n5 + 96199.0/604800*n6,
+		1.0/48*n2 + 1.0/15*n3 - 437.0/1440*n4 + 46.0/105*n5 - 1118711.0/3870720*n6,
+		17.0/480*n3 - 37.0/840*n4 - 209.0/4480*n5 + 5569.0/90720*n6,
+		4397.0/161280*n4 - 11.0/504*n5 - 830251.0/7257600*n6,
+		4583.0/161280*n5 - 108847.0/3991680*n6,
+		20648693.0 / 638668800 * n6,
+	}
+}
@@ -0,0 +1,66 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUTM(t *testing.T) {
+	tests := []struct {
+		LatLng LatLng
+		UTM    string
+		MGRS   string
+	}{
+		{LatLng{48.8582, 2.2945}, "31N 448252 5411933", "31U DQ 48251 11932"},
+		{LatLng{40.7453721, -74.0078293}, "18N 583764 4510965", ""},
+		{LatLng{-33.8568, 151.2153}, "56S 334901 6252289", ""},
+		{LatLng{60.5, 4}, "32N 225510 6717531", ""},
+	}
+	for _, test := range tests {
+		u, err := test.LatLng.UTM()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.String() != test.UTM {
+			t.Errorf("Expected: %s, Got: %s", test.UTM, u)
+		}
+		back, err := u.LatLng()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(back.Lat-test.LatLng.Lat) > 1e-9 || math.Abs(back.Lng-test.LatLng.Lng) > 1e-9 {
+			t.Errorf("Expected: %v, Got: %v", test.LatLng, back)
+		}
+		if test.MGRS != "" {
+			m, err := test.LatLng.MGRS(5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m != test.MGRS {
+				t.Errorf("Expected: %s, Got: %s", test.MGRS, m)
+			}
+		}
+	}
+	if _, err := (LatLng{85, 0}).UTM(); err != UTMRangeError {
+		t.Errorf("Expected: %v, Got: %v", UTMRangeError, err)
+	}
+}
+
+func TestParseMGRS(t *testing.T) {
+	for _, ll := range []LatLng{{48.8582, 2.2945}, {-33.8568, 151.2153}, {40.7453721, -74.0078293}, {-79.5, -170.2}, {83.9, 20}} {
+		m, err := ll.MGRS(5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ParseMGRS(m)
+		if err != nil {
+			t.Fatalf("%s: %v", m, err)
+		}
+		if d := got.DistanceTo(ll); d > 1.5 {
+			t.Errorf("%s Expected: %v, Got: %v (%fm)", m, ll, got, d)
+		}
+	}
+	if _, err := ParseMGRS("31U DQ 4825 119"); err != InvalidMGRSError {
+		t.Errorf("Expected: %v, Got: %v", InvalidMGRSError, err)
+	}
+}
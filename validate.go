@@ -0,0 +1,92 @@
+package geo
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MaxQueryLength is the longest free-text query accepted, in bytes. Longer
+// queries would push the request URL past the API's limit.
+const MaxQueryLength = 2048
+
+// InvalidInputError reports a request rejected before it was sent. Field
+// names the offending argument or option and Reason says what is wrong with
+// it, in words suitable for showing to an end user.
+type InvalidInputError struct {
+	Field  string
+	Reason string
+}
+
+func (e *InvalidInputError) Error() string {
+	return "Invalid " + e.Field + ": " + e.Reason + "."
+}
+
+// Valid reports whether l is a real coordinate: a latitude within ±90 and a
+// longitude within ±180.
+func (l LatLng) Valid() bool {
+	return !math.IsNaN(l.Lat) && !math.IsNaN(l.Lng) && math.Abs(l.Lat) <= 90 && math.Abs(l.Lng) <= 180
+}
+
+func validateLatLng(field string, ll LatLng) error {
+	if !ll.Valid() {
+		return &InvalidInputError{Field: field, Reason: "coordinates must be within ±90 latitude and ±180 longitude"}
+	}
+	return nil
+}
+
+func validateLatLngs(field string, points []LatLng) error {
+	for _, p := range points {
+		if err := validateLatLng(field, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePoints checks a list of at most max coordinates.
+func validatePoints(field string, points []LatLng, max int) error {
+	if len(points) > max {
+		return &InvalidInputError{Field: field, Reason: "must have at most " + strconv.Itoa(max) + " points"}
+	}
+	return validateLatLngs(field, points)
+}
+
+// validateQuery checks a free-text query. An empty query is allowed when
+// component filters say what to look for.
+func validateQuery(q string, o *requestOptions) error {
+	if strings.TrimSpace(q) == "" && o.params.Get("components") == "" {
+		return &InvalidInputError{Field: "query", Reason: "must not be empty"}
+	}
+	if len(q) > MaxQueryLength {
+		return &InvalidInputError{Field: "query", Reason: "must be at most 2048 bytes"}
+	}
+	return nil
+}
+
+// validate checks for options that can't be used together.
+func (o *requestOptions) validate() error {
+	if o.params.Get("departure_time") != "" && o.params.Get("arrival_time") != "" {
+		return &InvalidInputError{Field: "arrival_time", Reason: "can't be combined with a departure time"}
+	}
+	if o.params.Get("traffic_model") != "" && o.params.Get("departure_time") == "" {
+		return &InvalidInputError{Field: "traffic_model", Reason: "requires a departure time"}
+	}
+	if o.within != nil {
+		if err := validateLatLngs("bounds", []LatLng{o.within.Southwest, o.within.Northeast}); err != nil {
+			return err
+		}
+		if o.within.Southwest.Lat > o.within.Northeast.Lat {
+			return &InvalidInputError{Field: "bounds", Reason: "southwest corner must be south of the northeast corner"}
+		}
+	}
+	if o.near != nil && !(o.radius >= 0) {
+		return &InvalidInputError{Field: "radius", Reason: "must not be negative"}
+	}
+	if o.components != nil {
+		if err := o.components.Validate(); err != nil {
+			return err
+		}
+	}
+	return validateLatLngs("waypoints", o.waypoints)
+}
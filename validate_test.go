@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInputValidation(t *testing.T) {
+	c, last := newTestClient(t, `{"status": "OK", "results": [{"formatted_address": "x"}]}`)
+	ctx := context.Background()
+	check := func(field string, err error) {
+		t.Helper()
+		ie, ok := err.(*InvalidInputError)
+		if !ok || ie.Field != field {
+			t.Errorf("Expected: invalid %s, Got: %v", field, err)
+		}
+	}
+
+	_, err := c.Geocode(ctx, "  ")
+	check("query", err)
+	_, err = c.Geocode(ctx, strings.Repeat("a", MaxQueryLength+1))
+	check("query", err)
+	_, err = c.ReverseGeocode(ctx, LatLng{91, 0})
+	check("latlng", err)
+	_, err = c.ReverseGeocode(ctx, LatLng{0, math.NaN()})
+	check("latlng", err)
+	_, err = c.Timezone(ctx, LatLng{0, 181}, time.Now())
+	check("location", err)
+	_, err = c.Directions(ctx, LatLng{1, 1}, LatLng{2, 2}, WithDepartureTime(time.Now()), WithArrivalTime(time.Now()))
+	check("arrival_time", err)
+	_, err = c.Directions(ctx, LatLng{1, 1}, LatLng{2, 2}, WithTrafficModel(TrafficModelPessimistic))
+	check("traffic_model", err)
+	_, err = c.Geocode(ctx, "x", WithinBounds(BoundingBox{Southwest: LatLng{10, 0}, Northeast: LatLng{5, 1}}))
+	check("bounds", err)
+	if *last != nil {
+		t.Errorf("Expected: no requests for invalid input, Got: %v", (*last).URL)
+	}
+
+	if _, err := c.Geocode(ctx, "", WithComponents(ComponentFilter{PostalCode: "10011"})); err != nil {
+		t.Errorf("Expected: components-only query to be allowed, Got: %v", err)
+	}
+	if got := (&InvalidInputError{Field: "query", Reason: "must not be empty"}).Error(); got != "Invalid query: must not be empty." {
+		t.Errorf("Expected: Invalid query: must not be empty., Got: %s", got)
+	}
+}
@@ -0,0 +1,26 @@
+package geo
+
+// Viewport returns the recommended viewport of the address's first result.
+func (a *Address) Viewport() (BoundingBox, bool) {
+	if a.Response == nil || len(a.Response.Results) == 0 {
+		return BoundingBox{}, false
+	}
+	return a.Response.Results[0].Geometry.Viewport, true
+}
+
+// RecommendedZoom returns the zoom level that frames the address's viewport
+// in a map of the given size, or 21 centered on the point when there is no
+// viewport.
+func (a *Address) RecommendedZoom(mapWidthPx, mapHeightPx int) int {
+	v, ok := a.Viewport()
+	if !ok {
+		v = BoundingBox{Southwest: LatLng{a.Lat, a.Lng}, Northeast: LatLng{a.Lat, a.Lng}}
+	}
+	return FitZoom(v, mapWidthPx, mapHeightPx)
+}
+
+// ViewportContains reports whether ll is inside the address's viewport.
+func (a *Address) ViewportContains(ll LatLng) bool {
+	v, ok := a.Viewport()
+	return ok && v.Contains(ll)
+}
@@ -0,0 +1,31 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAddressViewport(t *testing.T) {
+	var g Response
+	err := json.Unmarshal([]byte(`{"status": "OK", "results": [{"geometry": {
+		"location": {"lat": 40.7453721, "lng": -74.0078293},
+		"viewport": {"northeast": {"lat": 40.7467210, "lng": -74.0064803}, "southwest": {"lat": 40.7440231, "lng": -74.0091783}},
+		"bounds": {"northeast": {"lat": 40.7460, "lng": -74.0070}, "southwest": {"lat": 40.7450, "lng": -74.0085}}
+	}}]}`), &g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := newAddress(&g)
+	if !addr.ViewportContains(LatLng{40.7453721, -74.0078293}) || addr.ViewportContains(LatLng{40.75, -74.0078}) {
+		t.Errorf("Unexpected viewport containment")
+	}
+	if b := g.Results[0].Geometry.Bounds; !b.Contains(LatLng{40.7455, -74.008}) {
+		t.Errorf("Expected bounds to decode as a BoundingBox, Got: %v", b)
+	}
+	if got := addr.RecommendedZoom(640, 480); got != 17 {
+		t.Errorf("Expected: 17, Got: %d", got)
+	}
+	if got := (&Address{Lat: 1, Lng: 2}).RecommendedZoom(640, 480); got != 21 {
+		t.Errorf("Expected: 21, Got: %d", got)
+	}
+}